@@ -0,0 +1,18 @@
+-- 仅对自己删除：用户隐藏的消息（不影响其他成员）
+CREATE TABLE IF NOT EXISTS message_hidden (
+    user_id         VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    server_msg_id   TEXT NOT NULL REFERENCES messages (server_msg_id) ON DELETE CASCADE,
+    conversation_id VARCHAR(36) NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, server_msg_id)
+);
+CREATE INDEX IF NOT EXISTS idx_message_hidden_user_conv ON message_hidden (user_id, conversation_id);
+
+-- 清空聊天记录：用户在会话中的清空水位，messages.created_at <= cleared_at 的消息对该用户不可见
+CREATE TABLE IF NOT EXISTS conversation_clear (
+    user_id         VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    conversation_id VARCHAR(36) NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    cleared_at      TIMESTAMPTZ NOT NULL,
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, conversation_id)
+);
@@ -0,0 +1,17 @@
+-- 定时消息：到期前保存在此表，由 Message 服务调度器写入 messages（沿用 server_msg_id）并发布 message.created
+CREATE TABLE IF NOT EXISTS scheduled_messages (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    server_msg_id   TEXT NOT NULL UNIQUE,
+    client_msg_id   TEXT NOT NULL DEFAULT '',
+    conversation_id VARCHAR(36) NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    from_user_id    VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    to_user_id      VARCHAR(10) REFERENCES users (id) ON DELETE SET NULL,
+    body_type       TEXT NOT NULL DEFAULT 'text',
+    body_text       TEXT NOT NULL DEFAULT '',
+    send_at         BIGINT NOT NULL,
+    status          TEXT NOT NULL DEFAULT 'pending',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_scheduled_messages_status_send_at ON scheduled_messages (status, send_at);
+CREATE INDEX IF NOT EXISTS idx_scheduled_messages_from_user_status ON scheduled_messages (from_user_id, status);
@@ -0,0 +1,4 @@
+-- 阅后即焚：会话级消息自动销毁时长（秒，0=关闭），消息写入时计算 expires_at，由 Message 服务后台任务清理
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS message_ttl_seconds INT NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_messages_expires_at ON messages (expires_at) WHERE expires_at IS NOT NULL;
@@ -0,0 +1,6 @@
+-- 成员对会话的个人设置：免打扰截止时间、置顶时间、归档、隐藏、备注名（仅本人可见）
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS muted_until TIMESTAMPTZ;
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS pinned_at TIMESTAMPTZ;
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS alias TEXT NOT NULL DEFAULT '';
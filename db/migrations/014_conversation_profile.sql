@@ -0,0 +1,11 @@
+-- 群资料：群头像；群公告历史（每次修改公告记录作者与时间）
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS avatar_url TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS conversation_announcements (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    conversation_id VARCHAR(36) NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    content         TEXT NOT NULL DEFAULT '',
+    author_id       VARCHAR(10) NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_conversation_announcements_conv_created ON conversation_announcements (conversation_id, created_at DESC);
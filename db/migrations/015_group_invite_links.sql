@@ -0,0 +1,17 @@
+-- 群邀请链接：可选过期时间、最大使用次数（0 为不限）、是否免审批；成员与入群申请记录所用链接
+CREATE TABLE IF NOT EXISTS group_invite_links (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    conversation_id VARCHAR(36) NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    token           TEXT NOT NULL UNIQUE,
+    created_by      VARCHAR(10) NOT NULL,
+    expires_at      TIMESTAMPTZ,
+    max_uses        INTEGER NOT NULL DEFAULT 0,
+    use_count       INTEGER NOT NULL DEFAULT 0,
+    bypass_approval BOOLEAN NOT NULL DEFAULT FALSE,
+    revoked_at      TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_group_invite_links_conversation_id ON group_invite_links (conversation_id);
+
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS invite_link_id UUID REFERENCES group_invite_links (id) ON DELETE SET NULL;
+ALTER TABLE group_join_requests ADD COLUMN IF NOT EXISTS invite_link_id UUID REFERENCES group_invite_links (id) ON DELETE SET NULL;
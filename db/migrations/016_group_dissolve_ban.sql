@@ -0,0 +1,15 @@
+-- 群解散：conversations.status 为 active / dissolved，解散后只读且不出现在会话列表
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'active';
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS dissolved_at TIMESTAMPTZ;
+
+-- 群管理审计日志：解散、封禁、解封等操作的操作人、对象与时间
+CREATE TABLE IF NOT EXISTS conversation_audit_logs (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    conversation_id VARCHAR(36) NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    operator_id     VARCHAR(10) NOT NULL,
+    action          TEXT NOT NULL,
+    target_user_id  VARCHAR(10) NOT NULL DEFAULT '',
+    detail          TEXT NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_conversation_audit_logs_conv_created ON conversation_audit_logs (conversation_id, created_at DESC);
@@ -0,0 +1,3 @@
+-- 群禁言：mute_all 开启后仅群主/管理员可发言；speak_muted_until 为单个成员的禁言截止时间（与个人免打扰 muted_until 无关）
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS mute_all BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS speak_muted_until TIMESTAMPTZ;
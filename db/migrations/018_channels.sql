@@ -0,0 +1,16 @@
+-- 广播频道：conversations.type = 'channel'，仅群主/管理员（发布者）可发消息；
+-- 订阅者不写 conversation_members，单独记录在 channel_subscriptions，subscriber_count 随订阅/取消订阅原子增减
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS is_public BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS subscriber_count BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS channel_subscriptions (
+    conversation_id VARCHAR(36) NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    user_id         VARCHAR(10) NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (conversation_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_channel_subscriptions_user_created ON channel_subscriptions (user_id, created_at DESC);
+
+-- 公开频道目录按订阅数排序
+CREATE INDEX IF NOT EXISTS idx_conversations_public_channels ON conversations (subscriber_count DESC, id)
+    WHERE type = 'channel' AND is_public AND status = 'active';
@@ -0,0 +1,23 @@
+-- 会话列表游标分页与增量同步：updated_at 由触发器在每次 UPDATE 时刷新，
+-- 增量同步按 GREATEST(conversations.last_active_at, conversations.updated_at, conversation_members.updated_at) 判断会话是否变化
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_conversations_updated_at ON conversations;
+CREATE TRIGGER trg_conversations_updated_at BEFORE UPDATE ON conversations
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+DROP TRIGGER IF EXISTS trg_conversation_members_updated_at ON conversation_members;
+CREATE TRIGGER trg_conversation_members_updated_at BEFORE UPDATE ON conversation_members
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- 会话列表按 (last_active_at, id) 倒序游标分页
+CREATE INDEX IF NOT EXISTS idx_conversations_last_active_id ON conversations (last_active_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_conversation_members_user_updated ON conversation_members (user_id, updated_at);
@@ -0,0 +1,6 @@
+-- 群昵称：成员在该群内的显示名，为空时客户端回退到用户资料昵称
+ALTER TABLE conversation_members ADD COLUMN IF NOT EXISTS nickname TEXT NOT NULL DEFAULT '';
+
+-- 成员列表按 (joined_at, user_id) 游标分页；Fan-out 按 user_id 流式读取 active 成员
+CREATE INDEX IF NOT EXISTS idx_conversation_members_conv_joined ON conversation_members (conversation_id, joined_at, user_id);
+CREATE INDEX IF NOT EXISTS idx_conversation_members_conv_active_user ON conversation_members (conversation_id, user_id) WHERE status = 'active';
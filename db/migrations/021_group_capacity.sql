@@ -0,0 +1,8 @@
+-- 群人数上限：tier 为档位名，上限由 Conversation 服务配置 GroupTiers 决定；
+-- max_members 为管理员对单个群的覆盖值，0 表示使用档位上限
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS tier TEXT NOT NULL DEFAULT '';
+ALTER TABLE conversations ADD COLUMN IF NOT EXISTS max_members INTEGER NOT NULL DEFAULT 0;
+
+INSERT INTO permissions (code, description)
+VALUES ('admin.conversation.write', 'manage conversations (capacity etc.)')
+ON CONFLICT (code) DO NOTHING;
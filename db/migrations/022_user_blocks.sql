@@ -0,0 +1,9 @@
+-- 黑名单：blocker_id 拉黑 blocked_id。被拉黑方不能向拉黑方发送单聊消息、好友申请、发起新单聊，也看不到其在线状态
+CREATE TABLE IF NOT EXISTS user_blocks (
+    blocker_id VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    blocked_id VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CONSTRAINT chk_user_block_not_self CHECK (blocker_id != blocked_id)
+);
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocker_created ON user_blocks (blocker_id, created_at DESC, blocked_id);
@@ -0,0 +1,7 @@
+-- 用户搜索：按用户名、昵称前缀/模糊匹配（pg_trgm）；discoverable 为 false 的用户不出现在搜索结果中
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS discoverable BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE INDEX IF NOT EXISTS idx_users_username_trgm ON users USING gin (username gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_user_profiles_nickname_trgm ON user_profiles USING gin (nickname gin_trgm_ops);
@@ -0,0 +1,13 @@
+-- 用户隐私设置：没有记录的用户按全部 everyone 处理
+-- find_by：谁能按用户名/账号查到我，everyone | contacts | nobody
+-- contact_request：谁能向我发好友申请，everyone | contacts_of_contacts | nobody
+-- group_add：谁能直接把我拉进群（不经邀请链接/入群申请），everyone | contacts | nobody
+-- presence_visibility：谁能看到我的在线状态与最后在线时间，everyone | contacts | nobody
+CREATE TABLE IF NOT EXISTS user_privacy_settings (
+    user_id             VARCHAR(10) PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    find_by             TEXT NOT NULL DEFAULT 'everyone',
+    contact_request     TEXT NOT NULL DEFAULT 'everyone',
+    group_add           TEXT NOT NULL DEFAULT 'everyone',
+    presence_visibility TEXT NOT NULL DEFAULT 'everyone',
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
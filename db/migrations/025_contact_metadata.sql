@@ -0,0 +1,15 @@
+-- 联系人备注、标签、星标与备忘；删除联系人改为软删除（status = 'removed'），增量同步据此下发删除
+-- updated_at 由 019 中的 set_updated_at 触发器在每次 UPDATE 时刷新
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS remark TEXT NOT NULL DEFAULT '';
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS starred BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS note TEXT NOT NULL DEFAULT '';
+ALTER TABLE contacts ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+DROP TRIGGER IF EXISTS trg_contacts_updated_at ON contacts;
+CREATE TRIGGER trg_contacts_updated_at BEFORE UPDATE ON contacts
+    FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- 增量同步按 (updated_at, contact_user_id) 正序
+CREATE INDEX IF NOT EXISTS idx_contacts_owner_updated ON contacts (owner_id, updated_at, contact_user_id);
+CREATE INDEX IF NOT EXISTS idx_contacts_tags ON contacts USING gin (tags);
@@ -0,0 +1,9 @@
+-- 好友申请生命周期：过期、撤回与重新申请
+-- 去掉 (from_user_id, to_user_id) 唯一约束，每次申请一行，历史申请保留；同一对用户同时只允许一条 pending
+-- status: pending / accepted / declined / withdrawn / expired；pending 且 expires_at 已过视为过期，查询侧过滤
+ALTER TABLE contact_requests DROP CONSTRAINT IF EXISTS contact_requests_from_user_id_to_user_id_key;
+ALTER TABLE contact_requests ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NOT NULL DEFAULT NOW() + INTERVAL '7 days';
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_contact_requests_pending_pair ON contact_requests (from_user_id, to_user_id) WHERE status = 'pending';
+-- 冷却期校验按 (from, to) 取最近一次申请
+CREATE INDEX IF NOT EXISTS idx_contact_requests_pair_updated ON contact_requests (from_user_id, to_user_id, updated_at DESC);
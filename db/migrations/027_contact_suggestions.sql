@@ -0,0 +1,22 @@
+-- 好友推荐：按共同好友数、共同群数排序的非好友用户，由 User 服务后台定期为近期请求过推荐的用户重新计算
+-- 拉黑、待处理申请、隐私设置在读取时过滤，变化后无需等待重算
+CREATE TABLE IF NOT EXISTS contact_suggestions (
+    user_id           VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    suggested_user_id VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    mutual_contacts   INTEGER NOT NULL DEFAULT 0,
+    shared_groups     INTEGER NOT NULL DEFAULT 0,
+    score             INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, suggested_user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_contact_suggestions_user_score ON contact_suggestions (user_id, score DESC, suggested_user_id);
+
+-- 每个用户推荐的上次计算时间与最近一次请求时间；后台只重算近期请求过且已过期的用户
+CREATE TABLE IF NOT EXISTS contact_suggestion_state (
+    user_id      VARCHAR(10) PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    computed_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_contact_suggestion_state_computed ON contact_suggestion_state (computed_at);
+
+-- 计算共同群时按用户查成员所在群
+CREATE INDEX IF NOT EXISTS idx_conversation_members_user_status ON conversation_members (user_id, status);
@@ -0,0 +1,7 @@
+-- 最后在线时间与自定义状态
+-- last_seen_at 由 Presence 服务在用户最后一个会话结束（主动断开或心跳超时被回收）时写入，NULL 表示从未记录
+-- custom_status_*：用户自行设置的表情与文字状态，custom_status_expires_at 为 NULL 表示不过期；过期后查询侧不再返回
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ;
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS custom_status_emoji TEXT NOT NULL DEFAULT '';
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS custom_status_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS custom_status_expires_at TIMESTAMPTZ;
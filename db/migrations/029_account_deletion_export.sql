@@ -0,0 +1,42 @@
+-- 账号注销：用户或管理员申请后进入宽限期，到期由 User 服务后台清除资料、联系人、token，
+-- 其发送的消息改挂到占位用户 0000000000 名下；users 行保留为 status = 'deleted' 的墓碑，用户名改为 deleted_<id> 以释放原用户名
+INSERT INTO users (id, username, password_hash, status)
+VALUES ('0000000000', 'deleted_user', '', 'deleted')
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO user_profiles (user_id, nickname, avatar_url, bio, status, discoverable)
+VALUES ('0000000000', '已注销用户', '', '', 'deleted', FALSE)
+ON CONFLICT (user_id) DO NOTHING;
+
+-- requested_by：发起人用户 ID，用户本人申请时等于 user_id
+CREATE TABLE IF NOT EXISTS account_deletions (
+    user_id      VARCHAR(10) PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    requested_by VARCHAR(10) NOT NULL DEFAULT '',
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    purge_at     TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_account_deletions_purge_at ON account_deletions (purge_at);
+
+-- 个人数据导出：异步任务 pending → running → ready | failed，ready 后 file_path 指向 User 服务本地的 zip 归档，expires_at 后清理
+CREATE TABLE IF NOT EXISTS user_data_exports (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    requested_by VARCHAR(10) NOT NULL DEFAULT '',
+    status       TEXT NOT NULL DEFAULT 'pending',
+    file_path    TEXT NOT NULL DEFAULT '',
+    size_bytes   BIGINT NOT NULL DEFAULT 0,
+    error        TEXT NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at   TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    expires_at   TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_user_data_exports_user_created ON user_data_exports (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_user_data_exports_status_created ON user_data_exports (status, created_at);
+
+-- 导出与清除时按发送者查消息
+CREATE INDEX IF NOT EXISTS idx_messages_from_user_server_time ON messages (from_user_id, server_time, id);
+
+INSERT INTO permissions (code, description)
+VALUES ('admin.user.data', 'manage user account deletion and data export')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,6 @@
+-- 资料版本号：昵称、头像、签名每次修改加一，客户端据此（类似 ETag）只刷新有变化的资料
+-- 最后在线时间与自定义状态按隐私设置对查询者隐藏，不计入版本
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- user.updated 推送对象：通讯录中有该用户的人
+CREATE INDEX IF NOT EXISTS idx_contacts_contact_user_owner ON contacts (contact_user_id, owner_id) WHERE status = 'accepted';
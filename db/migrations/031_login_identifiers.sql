@@ -0,0 +1,19 @@
+-- 备用登录标识：邮箱与手机号，仅保存验证通过的值（验证码与待验证的值在 Redis 中），可与用户名一样用于 AuthService.Login
+-- email 统一小写保存；phone 为 E.164 格式（+ 开头）
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone TEXT;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS phone_verified_at TIMESTAMPTZ;
+-- 最近一次修改用户名的时间，用于限制修改频率
+ALTER TABLE users ADD COLUMN IF NOT EXISTS username_changed_at TIMESTAMPTZ;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_users_email ON users (email) WHERE email IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_users_phone ON users (phone) WHERE phone IS NOT NULL;
+
+-- 修改用户名后旧用户名的保留期：expires_at 前只有原主人 user_id 能重新使用，其他人注册或改名时视为已占用
+CREATE TABLE IF NOT EXISTS username_reservations (
+    username   TEXT PRIMARY KEY,
+    user_id    VARCHAR(10) NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_username_reservations_user ON username_reservations (user_id);
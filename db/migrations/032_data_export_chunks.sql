@@ -0,0 +1,10 @@
+-- 个人数据导出归档改存 PostgreSQL：按分块保存，任意 User 服务副本都能下载与清理；删除导出任务时级联删除分块
+CREATE TABLE IF NOT EXISTS user_data_export_chunks (
+    export_id UUID NOT NULL REFERENCES user_data_exports (id) ON DELETE CASCADE,
+    seq       INT NOT NULL,
+    data      BYTEA NOT NULL,
+    PRIMARY KEY (export_id, seq)
+);
+
+ALTER TABLE user_data_exports ADD COLUMN IF NOT EXISTS chunk_count INT NOT NULL DEFAULT 0;
+ALTER TABLE user_data_exports DROP COLUMN IF EXISTS file_path;
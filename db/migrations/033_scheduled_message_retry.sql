@@ -0,0 +1,5 @@
+-- 定时消息发送重试：临时错误时保持 pending，按 retry_at 退避重试，attempts 记录已领取次数；
+-- 领取时 retry_at 同时作为租约，副本崩溃后租约到期自动重新领取。failed 为最终失败，fail_reason 记录原因
+ALTER TABLE scheduled_messages ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE scheduled_messages ADD COLUMN IF NOT EXISTS retry_at BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE scheduled_messages ADD COLUMN IF NOT EXISTS fail_reason TEXT NOT NULL DEFAULT '';
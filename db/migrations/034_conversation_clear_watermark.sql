@@ -0,0 +1,7 @@
+-- 清空聊天记录的水位改为服务端消息序：server_time 早于 cleared_server_time 的消息不可见，
+-- 清空时与水位同一秒的已有消息逐条写入 message_hidden；cleared_server_msg_id 为清空时的最后一条消息
+ALTER TABLE conversation_clear ADD COLUMN IF NOT EXISTS cleared_server_time BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE conversation_clear ADD COLUMN IF NOT EXISTS cleared_server_msg_id TEXT NOT NULL DEFAULT '';
+-- 旧水位按时间戳比较，迁移为其后一秒之前的消息均不可见
+UPDATE conversation_clear SET cleared_server_time = FLOOR(EXTRACT(EPOCH FROM cleared_at))::BIGINT + 1
+WHERE cleared_server_time = 0;
@@ -0,0 +1,29 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/logic/admin"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// DownloadUserExportHandler 成功时由 logic 直接把 zip 归档写入响应，失败时返回统一 JSON 响应体
+func DownloadUserExportHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.UserExportReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewDownloadUserExportLogic(r.Context(), svcCtx)
+		if resp := l.DownloadUserExport(&req, w); resp != nil {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -48,6 +48,18 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		}...),
 		rest.WithPrefix("/admin"),
 	)
+	// admin.user.data（账号注销、个人数据导出与下载）
+	server.AddRoutes(
+		rest.WithMiddlewares(authThenPerm("admin.user.data"), []rest.Route{
+			{Method: http.MethodPost, Path: "/users/:id/deletion", Handler: admin.RequestUserDeletionHandler(serverCtx)},
+			{Method: http.MethodGet, Path: "/users/:id/deletion", Handler: admin.GetUserDeletionHandler(serverCtx)},
+			{Method: http.MethodDelete, Path: "/users/:id/deletion", Handler: admin.CancelUserDeletionHandler(serverCtx)},
+			{Method: http.MethodPost, Path: "/users/:id/exports", Handler: admin.RequestUserExportHandler(serverCtx)},
+			{Method: http.MethodGet, Path: "/users/:id/exports/:exportId", Handler: admin.GetUserExportHandler(serverCtx)},
+			{Method: http.MethodGet, Path: "/users/:id/exports/:exportId/download", Handler: admin.DownloadUserExportHandler(serverCtx)},
+		}...),
+		rest.WithPrefix("/admin"),
+	)
 	// admin.conversation.read
 	server.AddRoutes(
 		rest.WithMiddlewares(authThenPerm("admin.conversation.read"), []rest.Route{
@@ -57,6 +69,13 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		}...),
 		rest.WithPrefix("/admin"),
 	)
+	// admin.conversation.write（群人数上限等会话管控）
+	server.AddRoutes(
+		rest.WithMiddlewares(authThenPerm("admin.conversation.write"), []rest.Route{
+			{Method: http.MethodPut, Path: "/conversations/:id/capacity", Handler: admin.SetConversationCapacityHandler(serverCtx)},
+		}...),
+		rest.WithPrefix("/admin"),
+	)
 	// admin.message.read
 	server.AddRoutes(
 		rest.WithMiddlewares(authThenPerm("admin.message.read"), []rest.Route{
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelUserDeletionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelUserDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelUserDeletionLogic {
+	return &CancelUserDeletionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CancelUserDeletion 在宽限期内撤销注销申请
+func (l *CancelUserDeletionLogic) CancelUserDeletion(req *types.GetUserReq) (resp *types.AdminEmptyResp, err error) {
+	if req.Id == "" {
+		return &types.AdminEmptyResp{Code: 2001, Message: "参数错误"}, nil
+	}
+	if _, err := l.svcCtx.UserSvc.CancelAccountDeletion(l.ctx, &userservice.CancelAccountDeletionRequest{UserId: req.Id}); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &types.AdminEmptyResp{Code: 3001, Message: "无待处理的注销申请"}, nil
+		}
+		return &types.AdminEmptyResp{Code: 5000, Message: err.Error()}, nil
+	}
+	return &types.AdminEmptyResp{Code: 0, Message: "ok"}, nil
+}
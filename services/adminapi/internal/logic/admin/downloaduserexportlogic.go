@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DownloadUserExportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDownloadUserExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DownloadUserExportLogic {
+	return &DownloadUserExportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// DownloadUserExport 从 UserService 分块读取归档写入 w；第一个分块到达前出错时返回统一响应体由 handler 输出，
+// 开始写入后出错只能中断响应，返回 nil
+func (l *DownloadUserExportLogic) DownloadUserExport(req *types.UserExportReq, w http.ResponseWriter) *types.AdminEmptyResp {
+	if req.Id == "" || req.ExportId == "" {
+		return &types.AdminEmptyResp{Code: 2001, Message: "参数错误"}
+	}
+	stream, err := l.svcCtx.UserSvc.DownloadDataExport(l.ctx, &userservice.DownloadDataExportRequest{
+		UserId:   req.Id,
+		ExportId: req.ExportId,
+	})
+	if err != nil {
+		return downloadErrorResp(err)
+	}
+	chunk, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return downloadErrorResp(err)
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="beehive-export-%s-%s.zip"`, req.Id, req.ExportId))
+	w.WriteHeader(http.StatusOK)
+	for chunk != nil {
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return nil
+		}
+		chunk, err = stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				l.Errorf("download user export %s interrupted: %v", req.ExportId, err)
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func downloadErrorResp(err error) *types.AdminEmptyResp {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return &types.AdminEmptyResp{Code: 2001, Message: status.Convert(err).Message()}
+	case codes.NotFound:
+		return &types.AdminEmptyResp{Code: 3001, Message: "导出任务不存在"}
+	}
+	return &types.AdminEmptyResp{Code: 5000, Message: err.Error()}
+}
@@ -49,6 +49,8 @@ func (l *GetConversationLogic) GetConversation(req *types.GetConversationReq) (r
 			MemberCount:  int(c.MemberCount),
 			CreatedAt:    formatUnixTime(c.CreatedAt),
 			LastActiveAt: formatUnixTime(c.LastActiveAt),
+			Tier:         c.Tier,
+			MaxMembers:   int(c.MaxMembers),
 		},
 	}, nil
 }
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserDeletionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetUserDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserDeletionLogic {
+	return &GetUserDeletionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetUserDeletion 查询待处理的注销申请，没有时返回 3001
+func (l *GetUserDeletionLogic) GetUserDeletion(req *types.GetUserReq) (resp *types.UserDeletionResp, err error) {
+	if req.Id == "" {
+		return &types.UserDeletionResp{Code: 2001, Message: "参数错误"}, nil
+	}
+	rpcResp, err := l.svcCtx.UserSvc.GetAccountDeletion(l.ctx, &userservice.GetAccountDeletionRequest{UserId: req.Id})
+	if err != nil {
+		return &types.UserDeletionResp{Code: 5000, Message: err.Error()}, nil
+	}
+	if rpcResp.Deletion == nil {
+		return &types.UserDeletionResp{Code: 3001, Message: "无待处理的注销申请"}, nil
+	}
+	return &types.UserDeletionResp{Code: 0, Message: "ok", Data: toUserDeletionData(rpcResp.Deletion)}, nil
+}
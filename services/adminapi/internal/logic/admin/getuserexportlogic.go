@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserExportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetUserExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserExportLogic {
+	return &GetUserExportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetUserExportLogic) GetUserExport(req *types.UserExportReq) (resp *types.UserExportResp, err error) {
+	if req.Id == "" || req.ExportId == "" {
+		return &types.UserExportResp{Code: 2001, Message: "参数错误"}, nil
+	}
+	rpcResp, err := l.svcCtx.UserSvc.GetDataExport(l.ctx, &userservice.GetDataExportRequest{
+		UserId:   req.Id,
+		ExportId: req.ExportId,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &types.UserExportResp{Code: 3001, Message: "导出任务不存在"}, nil
+		}
+		return &types.UserExportResp{Code: 5000, Message: err.Error()}, nil
+	}
+	return &types.UserExportResp{Code: 0, Message: "ok", Data: toUserExportData(rpcResp.Export)}, nil
+}
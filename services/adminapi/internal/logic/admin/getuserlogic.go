@@ -8,6 +8,7 @@ import (
 
 	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/auth/authservice"
 	"github.com/HappyLadySauce/Beehive/services/user/userservice"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -43,16 +44,23 @@ func (l *GetUserLogic) GetUser(req *types.GetUserReq) (resp *types.GetUserResp,
 	if status == "" {
 		status = "active"
 	}
-	// Email, CreatedAt, LastLoginAt 当前不在 user.proto 的 User 中，需扩展 RPC 后再回填
+	// 用户名、邮箱、手机号、注册时间来自 AuthService 的账号信息；LastLoginAt 暂无数据来源
+	accResp, err := l.svcCtx.AuthSvc.GetAccount(l.ctx, &authservice.GetAccountRequest{UserId: u.Id})
+	if err != nil {
+		return &types.GetUserResp{Code: 5000, Message: err.Error()}, nil
+	}
+	acc := accResp.GetAccount()
 	return &types.GetUserResp{
 		Code:    0,
 		Message: "ok",
 		Data: types.GetUserData{
 			Id:          u.Id,
+			Username:    acc.GetUsername(),
 			Nickname:    u.Nickname,
-			Email:       "",
+			Email:       acc.GetEmail(),
+			Phone:       acc.GetPhone(),
 			Status:      status,
-			CreatedAt:   "",
+			CreatedAt:   formatUnixTime(acc.GetCreatedAt()),
 			LastLoginAt: "",
 			Profile:     types.UserProfile{AvatarUrl: u.AvatarUrl, Bio: u.Bio},
 		},
@@ -27,28 +27,43 @@ func NewListConversationMembersLogic(ctx context.Context, svcCtx *svc.ServiceCon
 	}
 }
 
-func (l *ListConversationMembersLogic) ListConversationMembers(req *types.GetConversationReq) (resp *types.ListMembersResp, err error) {
+func (l *ListConversationMembersLogic) ListConversationMembers(req *types.ListMembersReq) (resp *types.ListMembersResp, err error) {
 	if req.Id == "" {
 		return &types.ListMembersResp{Code: 2001, Message: "参数错误"}, nil
 	}
-	rpcResp, err := l.svcCtx.ConversationSvc.ListMembers(l.ctx, &conversationservice.ListMembersRequest{ConversationId: req.Id})
+	in := &conversationservice.ListMembersRequest{
+		ConversationId: req.Id,
+		Cursor:         req.Cursor,
+		Limit:          int32(req.Limit),
+		Query:          req.Query,
+	}
+	if req.Role != "" {
+		in.Roles = []string{req.Role}
+	}
+	if req.Status != "" {
+		in.Statuses = []string{req.Status}
+	}
+	rpcResp, err := l.svcCtx.ConversationSvc.ListMembers(l.ctx, in)
 	if err != nil {
 		return &types.ListMembersResp{Code: 5000, Message: err.Error()}, nil
 	}
 	items := make([]types.MemberItem, 0)
+	var nextCursor string
 	if rpcResp != nil {
+		nextCursor = rpcResp.NextCursor
 		for _, m := range rpcResp.Items {
 			items = append(items, types.MemberItem{
 				UserId:   m.UserId,
 				Role:     m.Role,
 				JoinedAt: formatUnixTime(m.JoinedAt),
 				Status:   m.Status,
+				Nickname: m.Nickname,
 			})
 		}
 	}
 	return &types.ListMembersResp{
 		Code:    0,
 		Message: "ok",
-		Data:    types.ListMembersData{Items: items},
+		Data:    types.ListMembersData{Items: items, NextCursor: nextCursor},
 	}, nil
 }
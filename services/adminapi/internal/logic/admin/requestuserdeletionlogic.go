@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/middleware"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RequestUserDeletionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRequestUserDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestUserDeletionLogic {
+	return &RequestUserDeletionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RequestUserDeletion 代用户申请注销账号；graceSeconds 不传或 <=0 时使用 User 服务默认宽限期，已有申请时只能提前不能推迟
+func (l *RequestUserDeletionLogic) RequestUserDeletion(req *types.UserDeletionReq) (resp *types.UserDeletionResp, err error) {
+	if req.Id == "" || req.GraceSeconds < 0 {
+		return &types.UserDeletionResp{Code: 2001, Message: "参数错误"}, nil
+	}
+	operatorID, _ := l.ctx.Value(middleware.AdminUserIDKey).(string)
+	rpcResp, err := l.svcCtx.UserSvc.RequestAccountDeletion(l.ctx, &userservice.RequestAccountDeletionRequest{
+		UserId:       req.Id,
+		OperatorId:   operatorID,
+		GraceSeconds: req.GraceSeconds,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			return &types.UserDeletionResp{Code: 2001, Message: status.Convert(err).Message()}, nil
+		case codes.NotFound:
+			return &types.UserDeletionResp{Code: 3001, Message: "用户不存在"}, nil
+		}
+		return &types.UserDeletionResp{Code: 5000, Message: err.Error()}, nil
+	}
+	return &types.UserDeletionResp{Code: 0, Message: "ok", Data: toUserDeletionData(rpcResp.Deletion)}, nil
+}
+
+func toUserDeletionData(d *userservice.AccountDeletion) types.UserDeletionData {
+	return types.UserDeletionData{
+		UserId:      d.GetUserId(),
+		RequestedBy: d.GetRequestedBy(),
+		RequestedAt: formatUnixTime(d.GetRequestedAt()),
+		PurgeAt:     formatUnixTime(d.GetPurgeAt()),
+	}
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/middleware"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RequestUserExportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRequestUserExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestUserExportLogic {
+	return &RequestUserExportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RequestUserExport 代用户申请个人数据导出，归档异步生成；已有进行中的导出时返回该任务
+func (l *RequestUserExportLogic) RequestUserExport(req *types.GetUserReq) (resp *types.UserExportResp, err error) {
+	if req.Id == "" {
+		return &types.UserExportResp{Code: 2001, Message: "参数错误"}, nil
+	}
+	operatorID, _ := l.ctx.Value(middleware.AdminUserIDKey).(string)
+	rpcResp, err := l.svcCtx.UserSvc.RequestDataExport(l.ctx, &userservice.RequestDataExportRequest{
+		UserId:     req.Id,
+		OperatorId: operatorID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &types.UserExportResp{Code: 3001, Message: "用户不存在"}, nil
+		}
+		return &types.UserExportResp{Code: 5000, Message: err.Error()}, nil
+	}
+	return &types.UserExportResp{Code: 0, Message: "ok", Data: toUserExportData(rpcResp.Export)}, nil
+}
+
+func toUserExportData(e *userservice.DataExport) types.UserExportData {
+	return types.UserExportData{
+		Id:          e.GetId(),
+		UserId:      e.GetUserId(),
+		Status:      e.GetStatus(),
+		SizeBytes:   e.GetSizeBytes(),
+		Error:       e.GetError(),
+		CreatedAt:   formatUnixTime(e.GetCreatedAt()),
+		CompletedAt: formatUnixTime(e.GetCompletedAt()),
+		ExpiresAt:   formatUnixTime(e.GetExpiresAt()),
+	}
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/adminapi/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/conversation/conversationservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetConversationCapacityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSetConversationCapacityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetConversationCapacityLogic {
+	return &SetConversationCapacityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// SetConversationCapacity 调整群人数档位或为单个群设置成员上限；maxMembers 不传时不修改，0 清除覆盖值
+func (l *SetConversationCapacityLogic) SetConversationCapacity(req *types.SetCapacityReq) (resp *types.SetCapacityResp, err error) {
+	if req.Id == "" || (req.Tier == "" && req.MaxMembers < 0) {
+		return &types.SetCapacityResp{Code: 2001, Message: "参数错误"}, nil
+	}
+	in := &conversationservice.SetConversationCapacityRequest{ConversationId: req.Id}
+	if req.Tier != "" {
+		in.Tier = &req.Tier
+	}
+	if req.MaxMembers >= 0 {
+		maxMembers := int32(req.MaxMembers)
+		in.MaxMembers = &maxMembers
+	}
+	rpcResp, err := l.svcCtx.ConversationSvc.SetConversationCapacity(l.ctx, in)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			return &types.SetCapacityResp{Code: 2001, Message: status.Convert(err).Message()}, nil
+		case codes.NotFound:
+			return &types.SetCapacityResp{Code: 3001, Message: "会话不存在"}, nil
+		}
+		return &types.SetCapacityResp{Code: 5000, Message: err.Error()}, nil
+	}
+	return &types.SetCapacityResp{
+		Code:    0,
+		Message: "ok",
+		Data: types.SetCapacityData{
+			Tier:       rpcResp.Tier,
+			MaxMembers: int(rpcResp.MaxMembers),
+		},
+	}, nil
+}
@@ -42,6 +42,8 @@ type GetConversationData struct {
 	MemberCount  int    `json:"memberCount"`
 	CreatedAt    string `json:"createdAt"`
 	LastActiveAt string `json:"lastActiveAt"`
+	Tier         string `json:"tier"`
+	MaxMembers   int    `json:"maxMembers"`
 }
 
 type GetConversationReq struct {
@@ -56,8 +58,10 @@ type GetConversationResp struct {
 
 type GetUserData struct {
 	Id          string      `json:"id"`
+	Username    string      `json:"username"`
 	Nickname    string      `json:"nickname"`
 	Email       string      `json:"email"`
+	Phone       string      `json:"phone"`
 	Status      string      `json:"status"`
 	CreatedAt   string      `json:"createdAt"`
 	LastLoginAt string      `json:"lastLoginAt"`
@@ -131,7 +135,17 @@ type ListConversationsResp struct {
 }
 
 type ListMembersData struct {
-	Items []MemberItem `json:"items"`
+	Items      []MemberItem `json:"items"`
+	NextCursor string       `json:"nextCursor"`
+}
+
+type ListMembersReq struct {
+	Id     string `path:"id"`
+	Cursor string `form:"cursor,optional"`
+	Limit  int    `form:"limit,optional"`
+	Query  string `form:"query,optional"`
+	Role   string `form:"role,optional"`
+	Status string `form:"status,optional"`
 }
 
 type ListMembersResp struct {
@@ -189,6 +203,7 @@ type MemberItem struct {
 	Role     string `json:"role"`
 	JoinedAt string `json:"joinedAt"`
 	Status   string `json:"status"`
+	Nickname string `json:"nickname"`
 }
 
 type MessageBody struct {
@@ -220,10 +235,67 @@ type SessionItem struct {
 	LastPingAt string `json:"lastPingAt"`
 }
 
+type SetCapacityData struct {
+	Tier       string `json:"tier"`
+	MaxMembers int    `json:"maxMembers"`
+}
+
+type SetCapacityReq struct {
+	Id         string `path:"id"`
+	Tier       string `json:"tier,optional"`
+	MaxMembers int    `json:"maxMembers,default=-1"`
+}
+
+type SetCapacityResp struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    SetCapacityData `json:"data"`
+}
+
 type UnbanReq struct {
 	Id string `path:"id"`
 }
 
+type UserDeletionData struct {
+	UserId      string `json:"userId"`
+	RequestedBy string `json:"requestedBy"`
+	RequestedAt string `json:"requestedAt"`
+	PurgeAt     string `json:"purgeAt"`
+}
+
+type UserDeletionReq struct {
+	Id           string `path:"id"`
+	GraceSeconds int64  `json:"graceSeconds,optional"`
+}
+
+type UserDeletionResp struct {
+	Code    int              `json:"code"`
+	Message string           `json:"message"`
+	Data    UserDeletionData `json:"data"`
+}
+
+type UserExportData struct {
+	Id          string `json:"id"`
+	UserId      string `json:"userId"`
+	Status      string `json:"status"`
+	SizeBytes   int64  `json:"sizeBytes"`
+	Error       string `json:"error"`
+	CreatedAt   string `json:"createdAt"`
+	CompletedAt string `json:"completedAt"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+type UserExportReq struct {
+	Id       string `path:"id"`
+	ExportId string `path:"exportId"`
+}
+
+type UserExportResp struct {
+	Code    int            `json:"code"`
+	Message string         `json:"message"`
+	Data    UserExportData `json:"data"`
+}
+
 type UserItem struct {
 	Id          string `json:"id"`
 	Nickname    string `json:"nickname"`
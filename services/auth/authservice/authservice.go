@@ -14,20 +14,31 @@ import (
 )
 
 type (
-	AssignRolesRequest      = pb.AssignRolesRequest
-	AssignRolesResponse     = pb.AssignRolesResponse
-	CheckPermissionRequest  = pb.CheckPermissionRequest
-	CheckPermissionResponse = pb.CheckPermissionResponse
-	GetUserRolesRequest     = pb.GetUserRolesRequest
-	GetUserRolesResponse    = pb.GetUserRolesResponse
-	LoginRequest            = pb.LoginRequest
-	LoginResponse           = pb.LoginResponse
-	LogoutRequest           = pb.LogoutRequest
-	LogoutResponse          = pb.LogoutResponse
-	RegisterRequest         = pb.RegisterRequest
-	TokenLoginRequest       = pb.TokenLoginRequest
-	ValidateTokenRequest    = pb.ValidateTokenRequest
-	ValidateTokenResponse   = pb.ValidateTokenResponse
+	AssignRolesRequest           = pb.AssignRolesRequest
+	AssignRolesResponse          = pb.AssignRolesResponse
+	CheckPermissionRequest       = pb.CheckPermissionRequest
+	CheckPermissionResponse      = pb.CheckPermissionResponse
+	GetUserRolesRequest          = pb.GetUserRolesRequest
+	GetUserRolesResponse         = pb.GetUserRolesResponse
+	LoginRequest                 = pb.LoginRequest
+	LoginResponse                = pb.LoginResponse
+	LogoutRequest                = pb.LogoutRequest
+	LogoutResponse               = pb.LogoutResponse
+	RegisterRequest              = pb.RegisterRequest
+	TokenLoginRequest            = pb.TokenLoginRequest
+	ValidateTokenRequest         = pb.ValidateTokenRequest
+	ValidateTokenResponse        = pb.ValidateTokenResponse
+	RevokeUserTokensRequest      = pb.RevokeUserTokensRequest
+	RevokeUserTokensResponse     = pb.RevokeUserTokensResponse
+	GetAccountRequest            = pb.GetAccountRequest
+	GetAccountResponse           = pb.GetAccountResponse
+	SendVerificationCodeRequest  = pb.SendVerificationCodeRequest
+	SendVerificationCodeResponse = pb.SendVerificationCodeResponse
+	VerifyIdentifierRequest      = pb.VerifyIdentifierRequest
+	VerifyIdentifierResponse     = pb.VerifyIdentifierResponse
+	ChangeUsernameRequest        = pb.ChangeUsernameRequest
+	ChangeUsernameResponse       = pb.ChangeUsernameResponse
+	Account                      = pb.Account
 
 	AuthService interface {
 		Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
@@ -41,6 +52,11 @@ type (
 		CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
 		// RBAC（可选，对内/管理用途）：为用户设置角色
 		AssignRoles(ctx context.Context, in *AssignRolesRequest, opts ...grpc.CallOption) (*AssignRolesResponse, error)
+		RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error)
+		GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+		SendVerificationCode(ctx context.Context, in *SendVerificationCodeRequest, opts ...grpc.CallOption) (*SendVerificationCodeResponse, error)
+		VerifyIdentifier(ctx context.Context, in *VerifyIdentifierRequest, opts ...grpc.CallOption) (*VerifyIdentifierResponse, error)
+		ChangeUsername(ctx context.Context, in *ChangeUsernameRequest, opts ...grpc.CallOption) (*ChangeUsernameResponse, error)
 	}
 
 	defaultAuthService struct {
@@ -96,3 +112,28 @@ func (m *defaultAuthService) AssignRoles(ctx context.Context, in *AssignRolesReq
 	client := pb.NewAuthServiceClient(m.cli.Conn())
 	return client.AssignRoles(ctx, in, opts...)
 }
+
+func (m *defaultAuthService) RevokeUserTokens(ctx context.Context, in *RevokeUserTokensRequest, opts ...grpc.CallOption) (*RevokeUserTokensResponse, error) {
+	client := pb.NewAuthServiceClient(m.cli.Conn())
+	return client.RevokeUserTokens(ctx, in, opts...)
+}
+
+func (m *defaultAuthService) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	client := pb.NewAuthServiceClient(m.cli.Conn())
+	return client.GetAccount(ctx, in, opts...)
+}
+
+func (m *defaultAuthService) SendVerificationCode(ctx context.Context, in *SendVerificationCodeRequest, opts ...grpc.CallOption) (*SendVerificationCodeResponse, error) {
+	client := pb.NewAuthServiceClient(m.cli.Conn())
+	return client.SendVerificationCode(ctx, in, opts...)
+}
+
+func (m *defaultAuthService) VerifyIdentifier(ctx context.Context, in *VerifyIdentifierRequest, opts ...grpc.CallOption) (*VerifyIdentifierResponse, error) {
+	client := pb.NewAuthServiceClient(m.cli.Conn())
+	return client.VerifyIdentifier(ctx, in, opts...)
+}
+
+func (m *defaultAuthService) ChangeUsername(ctx context.Context, in *ChangeUsernameRequest, opts ...grpc.CallOption) (*ChangeUsernameResponse, error) {
+	client := pb.NewAuthServiceClient(m.cli.Conn())
+	return client.ChangeUsername(ctx, in, opts...)
+}
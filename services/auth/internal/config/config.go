@@ -17,4 +17,18 @@ type Config struct {
 	// AccessToken 与 RefreshToken 的有效期（秒），<=0 时使用默认值。
 	AccessTokenTTLSeconds  int
 	RefreshTokenTTLSeconds int
+
+	// 验证码发送方式：log 写入服务日志（默认），file 追加写入 VerificationCodeFile，均用于本地测试；接入邮件、短信网关时实现 verify.Sender
+	VerificationSender string `json:",default=log,options=log|file"`
+	// file 方式的输出文件，每行一条 JSON
+	VerificationCodeFile string `json:",default=data/verification_codes.log"`
+	// 验证码有效期（秒），默认 10 分钟
+	VerificationCodeTTLSeconds int `json:",default=600"`
+	// 同一用户同一渠道重新发送验证码的最小间隔（秒），默认 60 秒
+	VerificationResendSeconds int `json:",default=60"`
+
+	// 修改用户名后旧用户名只保留给原主人的时长（秒），默认 14 天
+	UsernameReservationSeconds int `json:",default=1209600"`
+	// 两次修改用户名的最小间隔（秒），默认 30 天；0 表示不限制
+	UsernameChangeCooldownSeconds int `json:",default=2592000"`
 }
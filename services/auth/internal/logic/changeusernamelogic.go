@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/auth/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// ChangeUsernameLogic 修改用户名：旧用户名保留 UsernameReservationSeconds，期间他人不能注册或改用，本人可以改回；
+// 两次修改之间至少间隔 UsernameChangeCooldownSeconds。
+type ChangeUsernameLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewChangeUsernameLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChangeUsernameLogic {
+	return &ChangeUsernameLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ChangeUsername 新用户名与当前相同时直接返回当前账号，不计入修改频率。
+func (l *ChangeUsernameLogic) ChangeUsername(in *pb.ChangeUsernameRequest) (*pb.ChangeUsernameResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := checkUsername(in.GetUsername()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	u, err := l.svcCtx.UserMod.FindByID(in.GetUserId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	if u.Status != "normal" {
+		return nil, status.Error(codes.FailedPrecondition, "account is not in normal status")
+	}
+	if u.Username == in.GetUsername() {
+		return &pb.ChangeUsernameResponse{Account: toProtoAccount(u)}, nil
+	}
+
+	cooldown := time.Duration(l.svcCtx.Config.UsernameChangeCooldownSeconds) * time.Second
+	reserveUntil := time.Now().Add(time.Duration(l.svcCtx.Config.UsernameReservationSeconds) * time.Second)
+	old, err := l.svcCtx.UserMod.ChangeUsername(u.ID, in.GetUsername(), cooldown, reserveUntil)
+	switch err {
+	case nil:
+	case gorm.ErrRecordNotFound:
+		return nil, status.Error(codes.NotFound, "user not found")
+	case model.ErrUsernameTaken:
+		return nil, status.Error(codes.AlreadyExists, "username already exists")
+	case model.ErrUsernameChangeTooSoon:
+		return nil, status.Error(codes.FailedPrecondition, "username changed too recently")
+	default:
+		return nil, status.Errorf(codes.Internal, "change username failed: %v", err)
+	}
+	l.Infof("username changed userId=%s old=%s new=%s", u.ID, old, in.GetUsername())
+
+	u, err = l.svcCtx.UserMod.FindByID(u.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	return &pb.ChangeUsernameResponse{Account: toProtoAccount(u)}, nil
+}
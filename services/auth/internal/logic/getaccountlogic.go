@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/auth/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// GetAccountLogic 查询账号的用户名与已验证的邮箱、手机号。
+type GetAccountLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAccountLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAccountLogic {
+	return &GetAccountLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetAccountLogic) GetAccount(in *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	u, err := l.svcCtx.UserMod.FindByID(in.GetUserId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	return &pb.GetAccountResponse{Account: toProtoAccount(u)}, nil
+}
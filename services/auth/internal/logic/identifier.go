@@ -0,0 +1,177 @@
+package logic
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/mail"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/verify"
+	"github.com/HappyLadySauce/Beehive/services/auth/pb"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	maxUsernameLen = 64
+	maxEmailLen    = 254
+	// maxVerifyAttempts 同一个验证码允许尝试的次数，用完后验证码作废需重新发送
+	maxVerifyAttempts = 5
+	verifyCodeDigits  = 6
+)
+
+// verifyAttemptScript 验证码存在时原子地把尝试次数加 1 并返回新值，不存在时返回 -1；超过 ARGV[1] 次时删除验证码。
+// 先计数再比对，并发猜测无法绕过次数上限
+var verifyAttemptScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], 'code_hash') == 0 then
+	return -1
+end
+local n = redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+if n > tonumber(ARGV[1]) then
+	redis.call('DEL', KEYS[1])
+end
+return n`)
+
+// phonePattern E.164：+ 与国家码开头，总共不超过 15 位数字
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// checkUsername 校验用户名：不能含 @、不能以 + 开头，与邮箱、手机号登录标识区分；deleted_ 前缀保留给已注销账号的墓碑用户名
+func checkUsername(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("username is required")
+	case utf8.RuneCountInString(name) > maxUsernameLen:
+		return fmt.Errorf("username must be at most %d characters", maxUsernameLen)
+	case strings.HasPrefix(name, "deleted_"):
+		return fmt.Errorf("username is reserved")
+	case strings.Contains(name, "@") || strings.HasPrefix(name, "+"):
+		return fmt.Errorf("username must not contain '@' or start with '+'")
+	case strings.IndexFunc(name, unicode.IsSpace) >= 0:
+		return fmt.Errorf("username must not contain spaces")
+	}
+	return nil
+}
+
+// normalizeEmail 去掉首尾空白并转为小写；不是单纯的邮箱地址（如带显示名）时返回 false
+func normalizeEmail(s string) (string, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" || len(s) > maxEmailLen {
+		return "", false
+	}
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Name != "" || addr.Address != s {
+		return "", false
+	}
+	return s, true
+}
+
+// normalizePhone 去掉空格与连字符后按 E.164 校验
+func normalizePhone(s string) (string, bool) {
+	s = strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(s))
+	if !phonePattern.MatchString(s) {
+		return "", false
+	}
+	return s, true
+}
+
+// normalizeTarget 按渠道规范化邮箱或手机号
+func normalizeTarget(channel, target string) (string, bool) {
+	switch channel {
+	case verify.ChannelEmail:
+		return normalizeEmail(target)
+	case verify.ChannelPhone:
+		return normalizePhone(target)
+	}
+	return "", false
+}
+
+// findByIdentifier 按登录标识查找用户：含 @ 先按邮箱查找，+ 开头先按手机号查找，未命中时再按用户名查找。
+// 旧版本注册时未限制用户名格式，形如邮箱或手机号的存量用户名仍可登录。
+func findByIdentifier(mod *model.UserModel, identifier string) (*model.User, error) {
+	var (
+		u   *model.User
+		err = gorm.ErrRecordNotFound
+	)
+	if strings.Contains(identifier, "@") {
+		if email, ok := normalizeEmail(identifier); ok {
+			u, err = mod.FindByEmail(email)
+		}
+	} else if strings.HasPrefix(strings.TrimSpace(identifier), "+") {
+		if phone, ok := normalizePhone(identifier); ok {
+			u, err = mod.FindByPhone(phone)
+		}
+	}
+	if err != gorm.ErrRecordNotFound {
+		return u, err
+	}
+	return mod.FindByUsername(identifier)
+}
+
+// heldAsUsername 判断 target 是否为其他用户的存量用户名；是则不允许绑定，避免该登录标识指向另一个账号
+func heldAsUsername(mod *model.UserModel, target, userID string) (bool, error) {
+	u, err := mod.FindByUsername(target)
+	if err == gorm.ErrRecordNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return u.ID != userID, nil
+}
+
+// verifyCodeKey 返回待验证信息的 Redis 哈希 "auth:verify:" + channel + ":" + userID，字段 target、code_hash、attempts
+func verifyCodeKey(userID, channel string) string {
+	return "auth:verify:" + channel + ":" + userID
+}
+
+// verifyCooldownKey 返回重新发送验证码的冷却 key
+func verifyCooldownKey(userID, channel string) string {
+	return "auth:verify_cooldown:" + channel + ":" + userID
+}
+
+// newVerifyCode 生成 verifyCodeDigits 位数字验证码
+func newVerifyCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", verifyCodeDigits, n.Int64()), nil
+}
+
+// hashVerifyCode Redis 中只保存验证码的摘要
+func hashVerifyCode(userID, code string) string {
+	sum := sha256.Sum256([]byte(userID + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
+
+func toProtoAccount(u *model.User) *pb.Account {
+	a := &pb.Account{
+		UserId:    u.ID,
+		Username:  u.Username,
+		Status:    u.Status,
+		CreatedAt: u.CreatedAt.Unix(),
+	}
+	if u.Email != nil {
+		a.Email = *u.Email
+	}
+	if u.EmailVerifiedAt != nil {
+		a.EmailVerifiedAt = u.EmailVerifiedAt.Unix()
+	}
+	if u.Phone != nil {
+		a.Phone = *u.Phone
+	}
+	if u.PhoneVerifiedAt != nil {
+		a.PhoneVerifiedAt = u.PhoneVerifiedAt.Unix()
+	}
+	if u.UsernameChangedAt != nil {
+		a.UsernameChangedAt = u.UsernameChangedAt.Unix()
+	}
+	return a
+}
@@ -14,8 +14,8 @@ import (
 	"gorm.io/gorm"
 )
 
-// LoginLogic 负责实现用户名密码登录的业务逻辑。
-// 流程为：校验用户名与密码非空 → 查用户并校验状态与密码 → 拉取用户角色 → 生成 access/refresh token 并写入 Redis → 返回 token 与过期时间。
+// LoginLogic 负责实现账号密码登录的业务逻辑，账号可以是用户名、已验证的邮箱或手机号。
+// 流程为：校验登录标识与密码非空 → 按标识查用户并校验状态与密码 → 拉取用户角色 → 生成 access/refresh token 并写入 Redis → 返回 token 与过期时间。
 type LoginLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -31,9 +31,9 @@ func NewLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginLogic
 	}
 }
 
-// Login 使用用户名与密码完成登录。
-// - 要求用户名、密码均非空；
-// - 查用户并校验状态为 normal、密码通过 bcrypt 校验；
+// Login 使用登录标识与密码完成登录。
+// - 要求登录标识（请求中的 username 字段）、密码均非空；
+// - 含 @ 按已验证邮箱、+ 开头按已验证手机号、否则按用户名查用户，并校验状态为 normal、密码通过 bcrypt 校验；
 // - 拉取用户角色后生成 access 与 refresh token，分别写入 Redis 并设置 TTL；
 // - 返回用户 ID、双 token 及 access token 的过期秒数。
 func (l *LoginLogic) Login(in *pb.LoginRequest) (*pb.LoginResponse, error) {
@@ -45,7 +45,7 @@ func (l *LoginLogic) Login(in *pb.LoginRequest) (*pb.LoginResponse, error) {
 	// 2. 查用户并校验状态：
 	//    - 用户不存在时与密码错误统一返回「用户名或密码错误」防枚举；
 	//    - 仅当用户状态为 normal 时允许登录。
-	user, err := l.svcCtx.UserMod.FindByUsername(in.GetUsername())
+	user, err := findByIdentifier(l.svcCtx.UserMod, in.GetUsername())
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Error(codes.Unauthenticated, "invalid username or password")
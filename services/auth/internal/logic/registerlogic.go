@@ -15,7 +15,7 @@ import (
 	"gorm.io/gorm"
 )
 
-// RegisterLogic 实现用户注册：校验用户名与密码 → 检查用户名未被占用 → 写 users 表 → 生成 token 并返回（与登录一致，注册即登录）。
+// RegisterLogic 实现用户注册：校验用户名与密码 → 检查用户名未被占用且不在他人改名后的保留期内 → 写 users 表 → 生成 token 并返回（与登录一致，注册即登录）。
 type RegisterLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -34,6 +34,9 @@ func (l *RegisterLogic) Register(in *pb.RegisterRequest) (*pb.LoginResponse, err
 	if in.GetUsername() == "" || in.GetPassword() == "" {
 		return nil, status.Error(codes.InvalidArgument, "username and password are required")
 	}
+	if err := checkUsername(in.GetUsername()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	_, err := l.svcCtx.UserMod.FindByUsername(in.GetUsername())
 	if err == nil {
@@ -42,6 +45,13 @@ func (l *RegisterLogic) Register(in *pb.RegisterRequest) (*pb.LoginResponse, err
 	if err != gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
 	}
+	reserved, err := l.svcCtx.UserMod.UsernameReservedByOther(in.GetUsername(), "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query username reservation failed: %v", err)
+	}
+	if reserved {
+		return nil, status.Error(codes.AlreadyExists, "username already exists")
+	}
 
 	hash, err := bcrypt.GenerateFromPassword([]byte(in.GetPassword()), bcrypt.DefaultCost)
 	if err != nil {
@@ -60,6 +70,9 @@ func (l *RegisterLogic) Register(in *pb.RegisterRequest) (*pb.LoginResponse, err
 		Status:       "normal",
 	}
 	if err := l.svcCtx.UserMod.Create(user); err != nil {
+		if model.IsUniqueViolation(err) {
+			return nil, status.Error(codes.AlreadyExists, "username already exists")
+		}
 		return nil, status.Errorf(codes.Internal, "create user failed: %v", err)
 	}
 
@@ -0,0 +1,122 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/verify"
+	"github.com/HappyLadySauce/Beehive/services/auth/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// SendVerificationCodeLogic 为绑定邮箱或手机号发送验证码。
+// 流程为：校验渠道与目标 → 确认目标未被占用 → 冷却期检查 → 生成验证码并把摘要写入 Redis → 通过 Verifier 发送。
+type SendVerificationCodeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendVerificationCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendVerificationCodeLogic {
+	return &SendVerificationCodeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendVerificationCode 同一用户同一渠道只保留最近一次发送的验证码，新发送会使旧验证码失效。
+func (l *SendVerificationCodeLogic) SendVerificationCode(in *pb.SendVerificationCodeRequest) (*pb.SendVerificationCodeResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	channel := in.GetChannel()
+	if channel != verify.ChannelEmail && channel != verify.ChannelPhone {
+		return nil, status.Error(codes.InvalidArgument, "channel must be email or phone")
+	}
+	target, ok := normalizeTarget(channel, in.GetTarget())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s", channel)
+	}
+
+	user, err := l.svcCtx.UserMod.FindByID(in.GetUserId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	if user.Status != "normal" {
+		return nil, status.Error(codes.FailedPrecondition, "account is not in normal status")
+	}
+
+	// 目标已被绑定（本人或他人），或是他人的存量用户名时不发送
+	find := l.svcCtx.UserMod.FindByEmail
+	if channel == verify.ChannelPhone {
+		find = l.svcCtx.UserMod.FindByPhone
+	}
+	owner, err := find(target)
+	if err == nil {
+		if owner.ID == user.ID {
+			return nil, status.Errorf(codes.AlreadyExists, "%s already verified", channel)
+		}
+		return nil, status.Errorf(codes.AlreadyExists, "%s already in use", channel)
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	held, err := heldAsUsername(l.svcCtx.UserMod, target, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	if held {
+		return nil, status.Errorf(codes.AlreadyExists, "%s already in use", channel)
+	}
+
+	ttl := time.Duration(l.svcCtx.Config.VerificationCodeTTLSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = 10 * time.Minute
+	}
+	resend := time.Duration(l.svcCtx.Config.VerificationResendSeconds) * time.Second
+	cooldownKey := verifyCooldownKey(user.ID, channel)
+	if resend > 0 {
+		ok, err := l.svcCtx.Redis.SetNX(l.ctx, cooldownKey, 1, resend).Result()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "redis SETNX failed: %v", err)
+		}
+		if !ok {
+			return nil, status.Error(codes.ResourceExhausted, "verification code sent too frequently")
+		}
+	}
+
+	code, err := newVerifyCode()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate verification code failed: %v", err)
+	}
+	key := verifyCodeKey(user.ID, channel)
+	pipe := l.svcCtx.Redis.TxPipeline()
+	pipe.Del(l.ctx, key)
+	pipe.HSet(l.ctx, key, "target", target, "code_hash", hashVerifyCode(user.ID, code), "attempts", 0)
+	pipe.Expire(l.ctx, key, ttl)
+	if _, err := pipe.Exec(l.ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "store verification code failed: %v", err)
+	}
+
+	if err := l.svcCtx.Verifier.Send(l.ctx, channel, target, code); err != nil {
+		l.Errorf("send verification code failed userId=%s channel=%s: %v", user.ID, channel, err)
+		// 未发送成功时撤销验证码与冷却，允许立即重试
+		if err := l.svcCtx.Redis.Del(l.ctx, key, cooldownKey).Err(); err != nil {
+			l.Errorf("redis DEL %s error: %v", key, err)
+		}
+		return nil, status.Error(codes.Unavailable, "send verification code failed")
+	}
+
+	return &pb.SendVerificationCodeResponse{
+		ExpiresIn:   int64(ttl / time.Second),
+		ResendAfter: int64(resend / time.Second),
+	}, nil
+}
@@ -29,7 +29,14 @@ func tokenKey(token string) string {
 	return "auth:token:" + token
 }
 
+// userTokensKey 返回用户已签发 token 的索引集合 "auth:user_tokens:" + userID，用于一次性吊销该用户全部 token。
+// 集合中可能残留已过期的 token，吊销时一并删除即可。
+func userTokensKey(userID string) string {
+	return "auth:user_tokens:" + userID
+}
+
 // storeToken 将 userID、roles 序列化为 JSON 写入 Redis，并设置 ttl；rdb 为 nil 时返回错误。
+// 同时把 token 记入用户 token 索引，索引的过期时间不短于其中最晚过期的 token。
 func storeToken(ctx context.Context, rdb *redis.Client, token string, userID string, roles []string, ttl time.Duration) error {
 	if rdb == nil {
 		return errors.New("redis client is nil")
@@ -42,7 +49,17 @@ func storeToken(ctx context.Context, rdb *redis.Client, token string, userID str
 	if err != nil {
 		return fmt.Errorf("marshal token payload failed: %w", err)
 	}
-	return rdb.Set(ctx, tokenKey(token), b, ttl).Err()
+	if err := rdb.Set(ctx, tokenKey(token), b, ttl).Err(); err != nil {
+		return err
+	}
+	idx := userTokensKey(userID)
+	if err := rdb.SAdd(ctx, idx, token).Err(); err != nil {
+		return err
+	}
+	if cur, err := rdb.TTL(ctx, idx).Result(); err == nil && cur < ttl {
+		return rdb.Expire(ctx, idx, ttl).Err()
+	}
+	return nil
 }
 
 // loadToken 从 Redis 读取 token 并解析出 userID、roles，同时返回剩余 TTL。
@@ -0,0 +1,95 @@
+package logic
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/verify"
+	"github.com/HappyLadySauce/Beehive/services/auth/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// VerifyIdentifierLogic 校验验证码，通过后把邮箱或手机号绑定到账号（覆盖原来绑定的值）。
+type VerifyIdentifierLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyIdentifierLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyIdentifierLogic {
+	return &VerifyIdentifierLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// VerifyIdentifier 每个验证码最多尝试 maxVerifyAttempts 次（先原子计数再比对），用完后作废；验证期间目标被他人抢先绑定时返回 AlreadyExists。
+func (l *VerifyIdentifierLogic) VerifyIdentifier(in *pb.VerifyIdentifierRequest) (*pb.VerifyIdentifierResponse, error) {
+	if in.GetUserId() == "" || in.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and code are required")
+	}
+	channel := in.GetChannel()
+	if channel != verify.ChannelEmail && channel != verify.ChannelPhone {
+		return nil, status.Error(codes.InvalidArgument, "channel must be email or phone")
+	}
+
+	key := verifyCodeKey(in.GetUserId(), channel)
+	attempts, err := verifyAttemptScript.Run(l.ctx, l.svcCtx.Redis, []string{key}, maxVerifyAttempts).Int()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "redis count verify attempts failed: %v", err)
+	}
+	if attempts < 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no pending verification code")
+	}
+	if attempts > maxVerifyAttempts {
+		return nil, status.Error(codes.ResourceExhausted, "too many attempts, request a new code")
+	}
+	pending, err := l.svcCtx.Redis.HGetAll(l.ctx, key).Result()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "redis HGETALL failed: %v", err)
+	}
+	if pending["code_hash"] == "" {
+		return nil, status.Error(codes.FailedPrecondition, "no pending verification code")
+	}
+	if subtle.ConstantTimeCompare([]byte(hashVerifyCode(in.GetUserId(), in.GetCode())), []byte(pending["code_hash"])) != 1 {
+		if attempts == maxVerifyAttempts {
+			// 已用完最后一次机会，验证码作废
+			if err := l.svcCtx.Redis.Del(l.ctx, key).Err(); err != nil {
+				l.Errorf("redis DEL %s error: %v", key, err)
+			}
+			return nil, status.Error(codes.ResourceExhausted, "too many attempts, request a new code")
+		}
+		return nil, status.Error(codes.InvalidArgument, "invalid verification code")
+	}
+
+	target := pending["target"]
+	if channel == verify.ChannelEmail {
+		err = l.svcCtx.UserMod.SetEmail(in.GetUserId(), target)
+	} else {
+		err = l.svcCtx.UserMod.SetPhone(in.GetUserId(), target)
+	}
+	if err != nil {
+		if model.IsUniqueViolation(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s already in use", channel)
+		}
+		return nil, status.Errorf(codes.Internal, "bind %s failed: %v", channel, err)
+	}
+	if err := l.svcCtx.Redis.Del(l.ctx, key).Err(); err != nil {
+		l.Errorf("redis DEL %s error: %v", key, err)
+	}
+
+	u, err := l.svcCtx.UserMod.FindByID(in.GetUserId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	return &pb.VerifyIdentifierResponse{Account: toProtoAccount(u)}, nil
+}
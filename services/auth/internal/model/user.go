@@ -1,10 +1,20 @@
 package model
 
 import (
+	"errors"
 	"strconv"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	// ErrUsernameTaken 用户名已被他人使用或处于他人的保留期内
+	ErrUsernameTaken = errors.New("username taken")
+	// ErrUsernameChangeTooSoon 距上次修改用户名未满冷却期
+	ErrUsernameChangeTooSoon = errors.New("username changed too recently")
 )
 
 // User 对应 users 表，供 AuthService 进行账号密码校验使用。id 为 10 位数字字符串。
@@ -15,12 +25,30 @@ type User struct {
 	Status       string    `gorm:"column:status;type:text;not null;default:'normal'"`
 	CreatedAt    time.Time `gorm:"column:created_at;type:timestamptz;not null;default:now()"`
 	UpdatedAt    time.Time `gorm:"column:updated_at;type:timestamptz;not null;default:now()"`
+	// 已验证的备用登录标识，未绑定时为 nil；Email 小写保存，Phone 为 E.164 格式
+	Email             *string    `gorm:"column:email;type:text"`
+	EmailVerifiedAt   *time.Time `gorm:"column:email_verified_at;type:timestamptz"`
+	Phone             *string    `gorm:"column:phone;type:text"`
+	PhoneVerifiedAt   *time.Time `gorm:"column:phone_verified_at;type:timestamptz"`
+	UsernameChangedAt *time.Time `gorm:"column:username_changed_at;type:timestamptz"`
 }
 
 func (User) TableName() string {
 	return "users"
 }
 
+// UsernameReservation 对应 username_reservations 表，改名后旧用户名在 ExpiresAt 前只保留给原主人
+type UsernameReservation struct {
+	Username  string    `gorm:"column:username;type:text;primaryKey"`
+	UserID    string    `gorm:"column:user_id;type:varchar(10);not null"`
+	CreatedAt time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	ExpiresAt time.Time `gorm:"column:expires_at;type:timestamptz;not null"`
+}
+
+func (UsernameReservation) TableName() string {
+	return "username_reservations"
+}
+
 type UserModel struct {
 	db *gorm.DB
 }
@@ -37,6 +65,113 @@ func (m *UserModel) FindByUsername(username string) (*User, error) {
 	return &u, nil
 }
 
+func (m *UserModel) FindByID(id string) (*User, error) {
+	var u User
+	if err := m.db.Where("id = ?", id).First(&u).Error; err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// FindByEmail 按已验证邮箱查找，email 需已转为小写
+func (m *UserModel) FindByEmail(email string) (*User, error) {
+	var u User
+	if err := m.db.Where("email = ?", email).First(&u).Error; err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// FindByPhone 按已验证手机号查找，phone 需已规范为 E.164 格式
+func (m *UserModel) FindByPhone(phone string) (*User, error) {
+	var u User
+	if err := m.db.Where("phone = ?", phone).First(&u).Error; err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// SetEmail 绑定已验证的邮箱（覆盖原值）；已被其他用户绑定时返回唯一约束错误，可用 IsUniqueViolation 判断
+func (m *UserModel) SetEmail(userID, email string) error {
+	return m.db.Model(&User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"email":             email,
+		"email_verified_at": time.Now(),
+		"updated_at":        time.Now(),
+	}).Error
+}
+
+// SetPhone 绑定已验证的手机号（覆盖原值）；已被其他用户绑定时返回唯一约束错误
+func (m *UserModel) SetPhone(userID, phone string) error {
+	return m.db.Model(&User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"phone":             phone,
+		"phone_verified_at": time.Now(),
+		"updated_at":        time.Now(),
+	}).Error
+}
+
+// UsernameReservedByOther 用户名是否处于 userID 以外用户的保留期内；userID 为空（注册）时任何未过期的保留都算
+func (m *UserModel) UsernameReservedByOther(username, userID string) (bool, error) {
+	var n int64
+	err := m.db.Model(&UsernameReservation{}).
+		Where("username = ? AND user_id <> ? AND expires_at > ?", username, userID, time.Now()).
+		Count(&n).Error
+	return n > 0, err
+}
+
+// ChangeUsername 在一个事务内修改用户名并保留旧用户名至 reserveUntil，返回旧用户名。
+// - 距上次修改未满 cooldown 时返回 ErrUsernameChangeTooSoon；
+// - 新用户名已被使用或处于他人保留期时返回 ErrUsernameTaken；改回自己保留中的旧用户名时释放该保留；
+// - 用户不存在时返回 gorm.ErrRecordNotFound。
+func (m *UserModel) ChangeUsername(userID, username string, cooldown time.Duration, reserveUntil time.Time) (string, error) {
+	var old string
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		var u User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", userID).First(&u).Error; err != nil {
+			return err
+		}
+		old = u.Username
+		if u.UsernameChangedAt != nil && time.Since(*u.UsernameChangedAt) < cooldown {
+			return ErrUsernameChangeTooSoon
+		}
+		// 锁住保留行，避免与他人并发改名、注册时同时拿到同一个旧用户名
+		var r UsernameReservation
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("username = ?", username).First(&r).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
+		}
+		if err == nil {
+			if r.UserID != userID && r.ExpiresAt.After(time.Now()) {
+				return ErrUsernameTaken
+			}
+			if err := tx.Delete(&r).Error; err != nil {
+				return err
+			}
+		}
+		now := time.Now()
+		if err := tx.Model(&User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+			"username":            username,
+			"username_changed_at": now,
+			"updated_at":          now,
+		}).Error; err != nil {
+			if IsUniqueViolation(err) {
+				return ErrUsernameTaken
+			}
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "username"}},
+			DoUpdates: clause.AssignmentColumns([]string{"user_id", "created_at", "expires_at"}),
+		}).Create(&UsernameReservation{Username: old, UserID: userID, CreatedAt: now, ExpiresAt: reserveUntil}).Error
+	})
+	return old, err
+}
+
+// IsUniqueViolation 判断是否为唯一约束冲突（PostgreSQL 23505）
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
 // Create 创建用户，用于注册。调用方需保证 ID、Username、PasswordHash、Status 已填。
 func (m *UserModel) Create(user *User) error {
 	return m.db.Create(user).Error
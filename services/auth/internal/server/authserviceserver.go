@@ -65,3 +65,28 @@ func (s *AuthServiceServer) AssignRoles(ctx context.Context, in *pb.AssignRolesR
 	l := logic.NewAssignRolesLogic(ctx, s.svcCtx)
 	return l.AssignRoles(in)
 }
+
+func (s *AuthServiceServer) RevokeUserTokens(ctx context.Context, in *pb.RevokeUserTokensRequest) (*pb.RevokeUserTokensResponse, error) {
+	l := logic.NewRevokeUserTokensLogic(ctx, s.svcCtx)
+	return l.RevokeUserTokens(in)
+}
+
+func (s *AuthServiceServer) GetAccount(ctx context.Context, in *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	l := logic.NewGetAccountLogic(ctx, s.svcCtx)
+	return l.GetAccount(in)
+}
+
+func (s *AuthServiceServer) SendVerificationCode(ctx context.Context, in *pb.SendVerificationCodeRequest) (*pb.SendVerificationCodeResponse, error) {
+	l := logic.NewSendVerificationCodeLogic(ctx, s.svcCtx)
+	return l.SendVerificationCode(in)
+}
+
+func (s *AuthServiceServer) VerifyIdentifier(ctx context.Context, in *pb.VerifyIdentifierRequest) (*pb.VerifyIdentifierResponse, error) {
+	l := logic.NewVerifyIdentifierLogic(ctx, s.svcCtx)
+	return l.VerifyIdentifier(in)
+}
+
+func (s *AuthServiceServer) ChangeUsername(ctx context.Context, in *pb.ChangeUsernameRequest) (*pb.ChangeUsernameResponse, error) {
+	l := logic.NewChangeUsernameLogic(ctx, s.svcCtx)
+	return l.ChangeUsername(in)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/HappyLadySauce/Beehive/services/auth/internal/config"
 	"github.com/HappyLadySauce/Beehive/services/auth/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/auth/internal/verify"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -19,6 +20,8 @@ type ServiceContext struct {
 	Redis   *redis.Client
 	RBACMod *model.RBACModel
 	UserMod *model.UserModel
+	// Verifier 验证码发送实现，由配置 VerificationSender 选择
+	Verifier verify.Sender
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -39,16 +42,22 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		panic(err)
 	}
 
+	verifier, err := verify.NewSender(c.VerificationSender, c.VerificationCodeFile)
+	if err != nil {
+		panic(err)
+	}
+
 	sqlDB, err := db.DB()
 	if err == nil {
 		sqlDB.SetConnMaxLifetime(time.Hour)
 	}
 
 	return &ServiceContext{
-		Config:  c,
-		DB:      db,
-		Redis:   rdb,
-		RBACMod: model.NewRBACModel(db),
-		UserMod: model.NewUserModel(db),
+		Config:   c,
+		DB:       db,
+		Redis:    rdb,
+		RBACMod:  model.NewRBACModel(db),
+		UserMod:  model.NewUserModel(db),
+		Verifier: verifier,
 	}
 }
@@ -0,0 +1,85 @@
+// Package verify 负责把验证码投递给用户：Sender 为投递接口，按配置选择实现。
+// 内置的 log、file 实现只用于本地开发与测试，生产环境接入邮件、短信网关时实现 Sender 并在 NewSender 中注册。
+package verify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 验证渠道，与 AuthService.SendVerificationCode 的 channel 一致
+const (
+	ChannelEmail = "email"
+	ChannelPhone = "phone"
+)
+
+// Sender 将验证码发送到 target（邮箱地址或 E.164 手机号）；返回错误时调用方视为未发送
+type Sender interface {
+	Send(ctx context.Context, channel, target, code string) error
+}
+
+// NewSender 按名称创建 Sender：log（默认）或 file，file 方式写入 path
+func NewSender(name, path string) (Sender, error) {
+	switch name {
+	case "", "log":
+		return LogSender{}, nil
+	case "file":
+		return NewFileSender(path)
+	default:
+		return nil, fmt.Errorf("unknown verification sender %q", name)
+	}
+}
+
+// LogSender 把验证码写入服务日志
+type LogSender struct{}
+
+func (LogSender) Send(ctx context.Context, channel, target, code string) error {
+	logx.WithContext(ctx).Infof("verification code channel=%s target=%s code=%s", channel, target, code)
+	return nil
+}
+
+// FileSender 把验证码按行追加写入文件（JSON），测试脚本可从中读取最新的验证码
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) (*FileSender, error) {
+	if path == "" {
+		return nil, fmt.Errorf("verification code file is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("create verification code dir: %w", err)
+	}
+	return &FileSender{path: path}, nil
+}
+
+func (s *FileSender) Send(_ context.Context, channel, target, code string) error {
+	line, err := json.Marshal(map[string]interface{}{
+		"channel": channel,
+		"target":  target,
+		"code":    code,
+		"sentAt":  time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(line, '\n'))
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
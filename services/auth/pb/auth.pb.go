@@ -22,10 +22,11 @@ const (
 )
 
 type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 登录标识：用户名、已验证的邮箱（含 @）或已验证的手机号（+ 开头的 E.164 格式）
+	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	DeviceId      string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -429,6 +430,95 @@ func (*LogoutResponse) Descriptor() ([]byte, []int) {
 	return file_proto_auth_proto_rawDescGZIP(), []int{7}
 }
 
+type RevokeUserTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserTokensRequest) Reset() {
+	*x = RevokeUserTokensRequest{}
+	mi := &file_proto_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserTokensRequest) ProtoMessage() {}
+
+func (x *RevokeUserTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserTokensRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserTokensRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RevokeUserTokensRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeUserTokensResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 本次删除的 token 数
+	Revoked       int64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserTokensResponse) Reset() {
+	*x = RevokeUserTokensResponse{}
+	mi := &file_proto_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserTokensResponse) ProtoMessage() {}
+
+func (x *RevokeUserTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserTokensResponse.ProtoReflect.Descriptor instead.
+func (*RevokeUserTokensResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RevokeUserTokensResponse) GetRevoked() int64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
 type GetUserRolesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -438,7 +528,7 @@ type GetUserRolesRequest struct {
 
 func (x *GetUserRolesRequest) Reset() {
 	*x = GetUserRolesRequest{}
-	mi := &file_proto_auth_proto_msgTypes[8]
+	mi := &file_proto_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -450,7 +540,7 @@ func (x *GetUserRolesRequest) String() string {
 func (*GetUserRolesRequest) ProtoMessage() {}
 
 func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[8]
+	mi := &file_proto_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +553,7 @@ func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{8}
+	return file_proto_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetUserRolesRequest) GetUserId() string {
@@ -482,7 +572,7 @@ type GetUserRolesResponse struct {
 
 func (x *GetUserRolesResponse) Reset() {
 	*x = GetUserRolesResponse{}
-	mi := &file_proto_auth_proto_msgTypes[9]
+	mi := &file_proto_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -494,7 +584,7 @@ func (x *GetUserRolesResponse) String() string {
 func (*GetUserRolesResponse) ProtoMessage() {}
 
 func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[9]
+	mi := &file_proto_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +597,7 @@ func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesResponse.ProtoReflect.Descriptor instead.
 func (*GetUserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{9}
+	return file_proto_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUserRolesResponse) GetRoles() []string {
@@ -527,7 +617,7 @@ type CheckPermissionRequest struct {
 
 func (x *CheckPermissionRequest) Reset() {
 	*x = CheckPermissionRequest{}
-	mi := &file_proto_auth_proto_msgTypes[10]
+	mi := &file_proto_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +629,7 @@ func (x *CheckPermissionRequest) String() string {
 func (*CheckPermissionRequest) ProtoMessage() {}
 
 func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[10]
+	mi := &file_proto_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +642,7 @@ func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
 func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{10}
+	return file_proto_auth_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CheckPermissionRequest) GetUserId() string {
@@ -578,7 +668,7 @@ type CheckPermissionResponse struct {
 
 func (x *CheckPermissionResponse) Reset() {
 	*x = CheckPermissionResponse{}
-	mi := &file_proto_auth_proto_msgTypes[11]
+	mi := &file_proto_auth_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -590,7 +680,7 @@ func (x *CheckPermissionResponse) String() string {
 func (*CheckPermissionResponse) ProtoMessage() {}
 
 func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[11]
+	mi := &file_proto_auth_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +693,7 @@ func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
 func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{11}
+	return file_proto_auth_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CheckPermissionResponse) GetAllowed() bool {
@@ -624,7 +714,7 @@ type AssignRolesRequest struct {
 
 func (x *AssignRolesRequest) Reset() {
 	*x = AssignRolesRequest{}
-	mi := &file_proto_auth_proto_msgTypes[12]
+	mi := &file_proto_auth_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +726,7 @@ func (x *AssignRolesRequest) String() string {
 func (*AssignRolesRequest) ProtoMessage() {}
 
 func (x *AssignRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[12]
+	mi := &file_proto_auth_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +739,7 @@ func (x *AssignRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRolesRequest.ProtoReflect.Descriptor instead.
 func (*AssignRolesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{12}
+	return file_proto_auth_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AssignRolesRequest) GetUserId() string {
@@ -674,7 +764,7 @@ type AssignRolesResponse struct {
 
 func (x *AssignRolesResponse) Reset() {
 	*x = AssignRolesResponse{}
-	mi := &file_proto_auth_proto_msgTypes[13]
+	mi := &file_proto_auth_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -686,7 +776,7 @@ func (x *AssignRolesResponse) String() string {
 func (*AssignRolesResponse) ProtoMessage() {}
 
 func (x *AssignRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_proto_msgTypes[13]
+	mi := &file_proto_auth_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,7 +789,515 @@ func (x *AssignRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRolesResponse.ProtoReflect.Descriptor instead.
 func (*AssignRolesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_proto_rawDescGZIP(), []int{13}
+	return file_proto_auth_proto_rawDescGZIP(), []int{15}
+}
+
+type Account struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username          string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Status            string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Email             string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                                               // 未绑定时为空
+	EmailVerifiedAt   int64                  `protobuf:"varint,5,opt,name=email_verified_at,json=emailVerifiedAt,proto3" json:"email_verified_at,omitempty"` // Unix 秒，未绑定时为 0
+	Phone             string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone,omitempty"`
+	PhoneVerifiedAt   int64                  `protobuf:"varint,7,opt,name=phone_verified_at,json=phoneVerifiedAt,proto3" json:"phone_verified_at,omitempty"`
+	UsernameChangedAt int64                  `protobuf:"varint,8,opt,name=username_changed_at,json=usernameChangedAt,proto3" json:"username_changed_at,omitempty"` // 最近一次修改用户名的时间，从未修改为 0
+	CreatedAt         int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_proto_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Account) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Account) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Account) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Account) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Account) GetEmailVerifiedAt() int64 {
+	if x != nil {
+		return x.EmailVerifiedAt
+	}
+	return 0
+}
+
+func (x *Account) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Account) GetPhoneVerifiedAt() int64 {
+	if x != nil {
+		return x.PhoneVerifiedAt
+	}
+	return 0
+}
+
+func (x *Account) GetUsernameChangedAt() int64 {
+	if x != nil {
+		return x.UsernameChangedAt
+	}
+	return 0
+}
+
+func (x *Account) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRequest) Reset() {
+	*x = GetAccountRequest{}
+	mi := &file_proto_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRequest) ProtoMessage() {}
+
+func (x *GetAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountResponse) Reset() {
+	*x = GetAccountResponse{}
+	mi := &file_proto_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountResponse) ProtoMessage() {}
+
+func (x *GetAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type SendVerificationCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"` // email / phone
+	Target        string                 `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`   // 邮箱地址，或 + 开头的 E.164 手机号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationCodeRequest) Reset() {
+	*x = SendVerificationCodeRequest{}
+	mi := &file_proto_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationCodeRequest) ProtoMessage() {}
+
+func (x *SendVerificationCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SendVerificationCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendVerificationCodeRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SendVerificationCodeRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type SendVerificationCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresIn     int64                  `protobuf:"varint,1,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`       // 验证码有效期（秒）
+	ResendAfter   int64                  `protobuf:"varint,2,opt,name=resend_after,json=resendAfter,proto3" json:"resend_after,omitempty"` // 多少秒后可重新发送
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationCodeResponse) Reset() {
+	*x = SendVerificationCodeResponse{}
+	mi := &file_proto_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationCodeResponse) ProtoMessage() {}
+
+func (x *SendVerificationCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationCodeResponse.ProtoReflect.Descriptor instead.
+func (*SendVerificationCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SendVerificationCodeResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *SendVerificationCodeResponse) GetResendAfter() int64 {
+	if x != nil {
+		return x.ResendAfter
+	}
+	return 0
+}
+
+type VerifyIdentifierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyIdentifierRequest) Reset() {
+	*x = VerifyIdentifierRequest{}
+	mi := &file_proto_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyIdentifierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyIdentifierRequest) ProtoMessage() {}
+
+func (x *VerifyIdentifierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyIdentifierRequest.ProtoReflect.Descriptor instead.
+func (*VerifyIdentifierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VerifyIdentifierRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyIdentifierRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *VerifyIdentifierRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyIdentifierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyIdentifierResponse) Reset() {
+	*x = VerifyIdentifierResponse{}
+	mi := &file_proto_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyIdentifierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyIdentifierResponse) ProtoMessage() {}
+
+func (x *VerifyIdentifierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyIdentifierResponse.ProtoReflect.Descriptor instead.
+func (*VerifyIdentifierResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VerifyIdentifierResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ChangeUsernameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUsernameRequest) Reset() {
+	*x = ChangeUsernameRequest{}
+	mi := &file_proto_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUsernameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUsernameRequest) ProtoMessage() {}
+
+func (x *ChangeUsernameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUsernameRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ChangeUsernameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeUsernameRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ChangeUsernameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUsernameResponse) Reset() {
+	*x = ChangeUsernameResponse{}
+	mi := &file_proto_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUsernameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUsernameResponse) ProtoMessage() {}
+
+func (x *ChangeUsernameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUsernameResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUsernameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangeUsernameResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
 }
 
 var File_proto_auth_proto protoreflect.FileDescriptor
@@ -730,7 +1328,11 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"2\n" +
 	"\rLogoutRequest\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"\x10\n" +
-	"\x0eLogoutResponse\".\n" +
+	"\x0eLogoutResponse\"2\n" +
+	"\x17RevokeUserTokensRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
+	"\x18RevokeUserTokensResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x03R\arevoked\".\n" +
 	"\x13GetUserRolesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\",\n" +
 	"\x14GetUserRolesResponse\x12\x14\n" +
@@ -745,17 +1347,57 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\x12AssignRolesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05roles\x18\x02 \x03(\tR\x05roles\"\x15\n" +
-	"\x13AssignRolesResponse2\x8d\x05\n" +
+	"\x13AssignRolesResponse\"\xa9\x02\n" +
+	"\aAccount\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12*\n" +
+	"\x11email_verified_at\x18\x05 \x01(\x03R\x0femailVerifiedAt\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\x12*\n" +
+	"\x11phone_verified_at\x18\a \x01(\x03R\x0fphoneVerifiedAt\x12.\n" +
+	"\x13username_changed_at\x18\b \x01(\x03R\x11usernameChangedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\",\n" +
+	"\x11GetAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"E\n" +
+	"\x12GetAccountResponse\x12/\n" +
+	"\aaccount\x18\x01 \x01(\v2\x15.beehive.auth.AccountR\aaccount\"h\n" +
+	"\x1bSendVerificationCodeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\tR\x06target\"`\n" +
+	"\x1cSendVerificationCodeResponse\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x01 \x01(\x03R\texpiresIn\x12!\n" +
+	"\fresend_after\x18\x02 \x01(\x03R\vresendAfter\"`\n" +
+	"\x17VerifyIdentifierRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"K\n" +
+	"\x18VerifyIdentifierResponse\x12/\n" +
+	"\aaccount\x18\x01 \x01(\v2\x15.beehive.auth.AccountR\aaccount\"L\n" +
+	"\x15ChangeUsernameRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"I\n" +
+	"\x16ChangeUsernameResponse\x12/\n" +
+	"\aaccount\x18\x01 \x01(\v2\x15.beehive.auth.AccountR\aaccount2\xf0\b\n" +
 	"\vAuthService\x12@\n" +
 	"\x05Login\x12\x1a.beehive.auth.LoginRequest\x1a\x1b.beehive.auth.LoginResponse\x12F\n" +
 	"\bRegister\x12\x1d.beehive.auth.RegisterRequest\x1a\x1b.beehive.auth.LoginResponse\x12J\n" +
 	"\n" +
 	"TokenLogin\x12\x1f.beehive.auth.TokenLoginRequest\x1a\x1b.beehive.auth.LoginResponse\x12X\n" +
 	"\rValidateToken\x12\".beehive.auth.ValidateTokenRequest\x1a#.beehive.auth.ValidateTokenResponse\x12C\n" +
-	"\x06Logout\x12\x1b.beehive.auth.LogoutRequest\x1a\x1c.beehive.auth.LogoutResponse\x12U\n" +
+	"\x06Logout\x12\x1b.beehive.auth.LogoutRequest\x1a\x1c.beehive.auth.LogoutResponse\x12a\n" +
+	"\x10RevokeUserTokens\x12%.beehive.auth.RevokeUserTokensRequest\x1a&.beehive.auth.RevokeUserTokensResponse\x12O\n" +
+	"\n" +
+	"GetAccount\x12\x1f.beehive.auth.GetAccountRequest\x1a .beehive.auth.GetAccountResponse\x12m\n" +
+	"\x14SendVerificationCode\x12).beehive.auth.SendVerificationCodeRequest\x1a*.beehive.auth.SendVerificationCodeResponse\x12a\n" +
+	"\x10VerifyIdentifier\x12%.beehive.auth.VerifyIdentifierRequest\x1a&.beehive.auth.VerifyIdentifierResponse\x12[\n" +
+	"\x0eChangeUsername\x12#.beehive.auth.ChangeUsernameRequest\x1a$.beehive.auth.ChangeUsernameResponse\x12U\n" +
 	"\fGetUserRoles\x12!.beehive.auth.GetUserRolesRequest\x1a\".beehive.auth.GetUserRolesResponse\x12^\n" +
 	"\x0fCheckPermission\x12$.beehive.auth.CheckPermissionRequest\x1a%.beehive.auth.CheckPermissionResponse\x12R\n" +
-	"\vAssignRoles\x12 .beehive.auth.AssignRolesRequest\x1a!.beehive.auth.AssignRolesResponseB4Z2github.com/HappyLadySauce/Beehive/services/auth/pbb\x06proto3"
+	"\vAssignRoles\x12 .beehive.auth.AssignRolesRequest\x1a!.beehive.auth.AssignRolesResponseB\x14Z\x12./services/auth/pbb\x06proto3"
 
 var (
 	file_proto_auth_proto_rawDescOnce sync.Once
@@ -769,45 +1411,69 @@ func file_proto_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_proto_rawDescData
 }
 
-var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_proto_auth_proto_goTypes = []any{
-	(*LoginRequest)(nil),            // 0: beehive.auth.LoginRequest
-	(*RegisterRequest)(nil),         // 1: beehive.auth.RegisterRequest
-	(*LoginResponse)(nil),           // 2: beehive.auth.LoginResponse
-	(*TokenLoginRequest)(nil),       // 3: beehive.auth.TokenLoginRequest
-	(*ValidateTokenRequest)(nil),    // 4: beehive.auth.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),   // 5: beehive.auth.ValidateTokenResponse
-	(*LogoutRequest)(nil),           // 6: beehive.auth.LogoutRequest
-	(*LogoutResponse)(nil),          // 7: beehive.auth.LogoutResponse
-	(*GetUserRolesRequest)(nil),     // 8: beehive.auth.GetUserRolesRequest
-	(*GetUserRolesResponse)(nil),    // 9: beehive.auth.GetUserRolesResponse
-	(*CheckPermissionRequest)(nil),  // 10: beehive.auth.CheckPermissionRequest
-	(*CheckPermissionResponse)(nil), // 11: beehive.auth.CheckPermissionResponse
-	(*AssignRolesRequest)(nil),      // 12: beehive.auth.AssignRolesRequest
-	(*AssignRolesResponse)(nil),     // 13: beehive.auth.AssignRolesResponse
+	(*LoginRequest)(nil),                 // 0: beehive.auth.LoginRequest
+	(*RegisterRequest)(nil),              // 1: beehive.auth.RegisterRequest
+	(*LoginResponse)(nil),                // 2: beehive.auth.LoginResponse
+	(*TokenLoginRequest)(nil),            // 3: beehive.auth.TokenLoginRequest
+	(*ValidateTokenRequest)(nil),         // 4: beehive.auth.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),        // 5: beehive.auth.ValidateTokenResponse
+	(*LogoutRequest)(nil),                // 6: beehive.auth.LogoutRequest
+	(*LogoutResponse)(nil),               // 7: beehive.auth.LogoutResponse
+	(*RevokeUserTokensRequest)(nil),      // 8: beehive.auth.RevokeUserTokensRequest
+	(*RevokeUserTokensResponse)(nil),     // 9: beehive.auth.RevokeUserTokensResponse
+	(*GetUserRolesRequest)(nil),          // 10: beehive.auth.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),         // 11: beehive.auth.GetUserRolesResponse
+	(*CheckPermissionRequest)(nil),       // 12: beehive.auth.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),      // 13: beehive.auth.CheckPermissionResponse
+	(*AssignRolesRequest)(nil),           // 14: beehive.auth.AssignRolesRequest
+	(*AssignRolesResponse)(nil),          // 15: beehive.auth.AssignRolesResponse
+	(*Account)(nil),                      // 16: beehive.auth.Account
+	(*GetAccountRequest)(nil),            // 17: beehive.auth.GetAccountRequest
+	(*GetAccountResponse)(nil),           // 18: beehive.auth.GetAccountResponse
+	(*SendVerificationCodeRequest)(nil),  // 19: beehive.auth.SendVerificationCodeRequest
+	(*SendVerificationCodeResponse)(nil), // 20: beehive.auth.SendVerificationCodeResponse
+	(*VerifyIdentifierRequest)(nil),      // 21: beehive.auth.VerifyIdentifierRequest
+	(*VerifyIdentifierResponse)(nil),     // 22: beehive.auth.VerifyIdentifierResponse
+	(*ChangeUsernameRequest)(nil),        // 23: beehive.auth.ChangeUsernameRequest
+	(*ChangeUsernameResponse)(nil),       // 24: beehive.auth.ChangeUsernameResponse
 }
 var file_proto_auth_proto_depIdxs = []int32{
-	0,  // 0: beehive.auth.AuthService.Login:input_type -> beehive.auth.LoginRequest
-	1,  // 1: beehive.auth.AuthService.Register:input_type -> beehive.auth.RegisterRequest
-	3,  // 2: beehive.auth.AuthService.TokenLogin:input_type -> beehive.auth.TokenLoginRequest
-	4,  // 3: beehive.auth.AuthService.ValidateToken:input_type -> beehive.auth.ValidateTokenRequest
-	6,  // 4: beehive.auth.AuthService.Logout:input_type -> beehive.auth.LogoutRequest
-	8,  // 5: beehive.auth.AuthService.GetUserRoles:input_type -> beehive.auth.GetUserRolesRequest
-	10, // 6: beehive.auth.AuthService.CheckPermission:input_type -> beehive.auth.CheckPermissionRequest
-	12, // 7: beehive.auth.AuthService.AssignRoles:input_type -> beehive.auth.AssignRolesRequest
-	2,  // 8: beehive.auth.AuthService.Login:output_type -> beehive.auth.LoginResponse
-	2,  // 9: beehive.auth.AuthService.Register:output_type -> beehive.auth.LoginResponse
-	2,  // 10: beehive.auth.AuthService.TokenLogin:output_type -> beehive.auth.LoginResponse
-	5,  // 11: beehive.auth.AuthService.ValidateToken:output_type -> beehive.auth.ValidateTokenResponse
-	7,  // 12: beehive.auth.AuthService.Logout:output_type -> beehive.auth.LogoutResponse
-	9,  // 13: beehive.auth.AuthService.GetUserRoles:output_type -> beehive.auth.GetUserRolesResponse
-	11, // 14: beehive.auth.AuthService.CheckPermission:output_type -> beehive.auth.CheckPermissionResponse
-	13, // 15: beehive.auth.AuthService.AssignRoles:output_type -> beehive.auth.AssignRolesResponse
-	8,  // [8:16] is the sub-list for method output_type
-	0,  // [0:8] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	16, // 0: beehive.auth.GetAccountResponse.account:type_name -> beehive.auth.Account
+	16, // 1: beehive.auth.VerifyIdentifierResponse.account:type_name -> beehive.auth.Account
+	16, // 2: beehive.auth.ChangeUsernameResponse.account:type_name -> beehive.auth.Account
+	0,  // 3: beehive.auth.AuthService.Login:input_type -> beehive.auth.LoginRequest
+	1,  // 4: beehive.auth.AuthService.Register:input_type -> beehive.auth.RegisterRequest
+	3,  // 5: beehive.auth.AuthService.TokenLogin:input_type -> beehive.auth.TokenLoginRequest
+	4,  // 6: beehive.auth.AuthService.ValidateToken:input_type -> beehive.auth.ValidateTokenRequest
+	6,  // 7: beehive.auth.AuthService.Logout:input_type -> beehive.auth.LogoutRequest
+	8,  // 8: beehive.auth.AuthService.RevokeUserTokens:input_type -> beehive.auth.RevokeUserTokensRequest
+	17, // 9: beehive.auth.AuthService.GetAccount:input_type -> beehive.auth.GetAccountRequest
+	19, // 10: beehive.auth.AuthService.SendVerificationCode:input_type -> beehive.auth.SendVerificationCodeRequest
+	21, // 11: beehive.auth.AuthService.VerifyIdentifier:input_type -> beehive.auth.VerifyIdentifierRequest
+	23, // 12: beehive.auth.AuthService.ChangeUsername:input_type -> beehive.auth.ChangeUsernameRequest
+	10, // 13: beehive.auth.AuthService.GetUserRoles:input_type -> beehive.auth.GetUserRolesRequest
+	12, // 14: beehive.auth.AuthService.CheckPermission:input_type -> beehive.auth.CheckPermissionRequest
+	14, // 15: beehive.auth.AuthService.AssignRoles:input_type -> beehive.auth.AssignRolesRequest
+	2,  // 16: beehive.auth.AuthService.Login:output_type -> beehive.auth.LoginResponse
+	2,  // 17: beehive.auth.AuthService.Register:output_type -> beehive.auth.LoginResponse
+	2,  // 18: beehive.auth.AuthService.TokenLogin:output_type -> beehive.auth.LoginResponse
+	5,  // 19: beehive.auth.AuthService.ValidateToken:output_type -> beehive.auth.ValidateTokenResponse
+	7,  // 20: beehive.auth.AuthService.Logout:output_type -> beehive.auth.LogoutResponse
+	9,  // 21: beehive.auth.AuthService.RevokeUserTokens:output_type -> beehive.auth.RevokeUserTokensResponse
+	18, // 22: beehive.auth.AuthService.GetAccount:output_type -> beehive.auth.GetAccountResponse
+	20, // 23: beehive.auth.AuthService.SendVerificationCode:output_type -> beehive.auth.SendVerificationCodeResponse
+	22, // 24: beehive.auth.AuthService.VerifyIdentifier:output_type -> beehive.auth.VerifyIdentifierResponse
+	24, // 25: beehive.auth.AuthService.ChangeUsername:output_type -> beehive.auth.ChangeUsernameResponse
+	11, // 26: beehive.auth.AuthService.GetUserRoles:output_type -> beehive.auth.GetUserRolesResponse
+	13, // 27: beehive.auth.AuthService.CheckPermission:output_type -> beehive.auth.CheckPermissionResponse
+	15, // 28: beehive.auth.AuthService.AssignRoles:output_type -> beehive.auth.AssignRolesResponse
+	16, // [16:29] is the sub-list for method output_type
+	3,  // [3:16] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_proto_auth_proto_init() }
@@ -821,7 +1487,7 @@ func file_proto_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_proto_rawDesc), len(file_proto_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -14,9 +14,9 @@ import (
 )
 
 type (
-	AddMemberRequest              = pb.AddMemberRequest
-	AddMemberResponse             = pb.AddMemberResponse
-	ConversationInfo              = pb.ConversationInfo
+	AddMemberRequest                       = pb.AddMemberRequest
+	AddMemberResponse                      = pb.AddMemberResponse
+	ConversationInfo                       = pb.ConversationInfo
 	CreateConversationRequest              = pb.CreateConversationRequest
 	CreateConversationResponse             = pb.CreateConversationResponse
 	FindOrCreateSingleConversationRequest  = pb.FindOrCreateSingleConversationRequest
@@ -28,17 +28,75 @@ type (
 	ListUserConversationsRequest           = pb.ListUserConversationsRequest
 	ListUserConversationsResponse          = pb.ListUserConversationsResponse
 	MemberInfo                             = pb.MemberInfo
-	RemoveMemberRequest    = pb.RemoveMemberRequest
-	RemoveMemberResponse   = pb.RemoveMemberResponse
-
-	ApplyJoinGroupRequest    = pb.ApplyJoinGroupRequest
-	ApplyJoinGroupResponse   = pb.ApplyJoinGroupResponse
-	ListJoinRequestsRequest  = pb.ListJoinRequestsRequest
-	ListJoinRequestsResponse = pb.ListJoinRequestsResponse
-	ApproveJoinRequestRequest  = pb.ApproveJoinRequestRequest
-	ApproveJoinRequestResponse = pb.ApproveJoinRequestResponse
-	DeclineJoinRequestRequest  = pb.DeclineJoinRequestRequest
-	DeclineJoinRequestResponse = pb.DeclineJoinRequestResponse
+	RemoveMemberRequest                    = pb.RemoveMemberRequest
+	RemoveMemberResponse                   = pb.RemoveMemberResponse
+
+	ApplyJoinGroupRequest            = pb.ApplyJoinGroupRequest
+	ApplyJoinGroupResponse           = pb.ApplyJoinGroupResponse
+	ListJoinRequestsRequest          = pb.ListJoinRequestsRequest
+	ListJoinRequestsResponse         = pb.ListJoinRequestsResponse
+	ApproveJoinRequestRequest        = pb.ApproveJoinRequestRequest
+	ApproveJoinRequestResponse       = pb.ApproveJoinRequestResponse
+	DeclineJoinRequestRequest        = pb.DeclineJoinRequestRequest
+	DeclineJoinRequestResponse       = pb.DeclineJoinRequestResponse
+	SetMessageTTLRequest             = pb.SetMessageTTLRequest
+	SetMessageTTLResponse            = pb.SetMessageTTLResponse
+	UpdateMemberSettingsRequest      = pb.UpdateMemberSettingsRequest
+	UpdateMemberSettingsResponse     = pb.UpdateMemberSettingsResponse
+	MemberSettings                   = pb.MemberSettings
+	SetMemberRoleRequest             = pb.SetMemberRoleRequest
+	SetMemberRoleResponse            = pb.SetMemberRoleResponse
+	TransferOwnershipRequest         = pb.TransferOwnershipRequest
+	TransferOwnershipResponse        = pb.TransferOwnershipResponse
+	LeaveConversationRequest         = pb.LeaveConversationRequest
+	LeaveConversationResponse        = pb.LeaveConversationResponse
+	UpdateConversationRequest        = pb.UpdateConversationRequest
+	UpdateConversationResponse       = pb.UpdateConversationResponse
+	ListAnnouncementsRequest         = pb.ListAnnouncementsRequest
+	ListAnnouncementsResponse        = pb.ListAnnouncementsResponse
+	AnnouncementInfo                 = pb.AnnouncementInfo
+	CreateInviteLinkRequest          = pb.CreateInviteLinkRequest
+	CreateInviteLinkResponse         = pb.CreateInviteLinkResponse
+	InviteLinkInfo                   = pb.InviteLinkInfo
+	RevokeInviteLinkRequest          = pb.RevokeInviteLinkRequest
+	RevokeInviteLinkResponse         = pb.RevokeInviteLinkResponse
+	ListInviteLinksRequest           = pb.ListInviteLinksRequest
+	ListInviteLinksResponse          = pb.ListInviteLinksResponse
+	PreviewInviteLinkRequest         = pb.PreviewInviteLinkRequest
+	PreviewInviteLinkResponse        = pb.PreviewInviteLinkResponse
+	JoinByInviteRequest              = pb.JoinByInviteRequest
+	JoinByInviteResponse             = pb.JoinByInviteResponse
+	DissolveConversationRequest      = pb.DissolveConversationRequest
+	DissolveConversationResponse     = pb.DissolveConversationResponse
+	BanMemberRequest                 = pb.BanMemberRequest
+	BanMemberResponse                = pb.BanMemberResponse
+	UnbanMemberRequest               = pb.UnbanMemberRequest
+	UnbanMemberResponse              = pb.UnbanMemberResponse
+	ListBannedMembersRequest         = pb.ListBannedMembersRequest
+	ListBannedMembersResponse        = pb.ListBannedMembersResponse
+	SetMuteAllRequest                = pb.SetMuteAllRequest
+	SetMuteAllResponse               = pb.SetMuteAllResponse
+	MuteMemberRequest                = pb.MuteMemberRequest
+	MuteMemberResponse               = pb.MuteMemberResponse
+	UnmuteMemberRequest              = pb.UnmuteMemberRequest
+	UnmuteMemberResponse             = pb.UnmuteMemberResponse
+	SubscribeChannelRequest          = pb.SubscribeChannelRequest
+	SubscribeChannelResponse         = pb.SubscribeChannelResponse
+	UnsubscribeChannelRequest        = pb.UnsubscribeChannelRequest
+	UnsubscribeChannelResponse       = pb.UnsubscribeChannelResponse
+	ListSubscribedChannelsRequest    = pb.ListSubscribedChannelsRequest
+	ListChannelsResponse             = pb.ListChannelsResponse
+	ListPublicChannelsRequest        = pb.ListPublicChannelsRequest
+	FilterChannelSubscribersRequest  = pb.FilterChannelSubscribersRequest
+	FilterChannelSubscribersResponse = pb.FilterChannelSubscribersResponse
+	SetMemberNicknameRequest         = pb.SetMemberNicknameRequest
+	SetMemberNicknameResponse        = pb.SetMemberNicknameResponse
+	StreamMemberIDsRequest           = pb.StreamMemberIDsRequest
+	MemberIDsChunk                   = pb.MemberIDsChunk
+	SetConversationCapacityRequest   = pb.SetConversationCapacityRequest
+	SetConversationCapacityResponse  = pb.SetConversationCapacityResponse
+	RemoveUserEverywhereRequest      = pb.RemoveUserEverywhereRequest
+	RemoveUserEverywhereResponse     = pb.RemoveUserEverywhereResponse
 
 	ConversationService interface {
 		CreateConversation(ctx context.Context, in *CreateConversationRequest, opts ...grpc.CallOption) (*CreateConversationResponse, error)
@@ -52,6 +110,34 @@ type (
 		ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
 		ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*ApproveJoinRequestResponse, error)
 		DeclineJoinRequest(ctx context.Context, in *DeclineJoinRequestRequest, opts ...grpc.CallOption) (*DeclineJoinRequestResponse, error)
+		SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error)
+		UpdateMemberSettings(ctx context.Context, in *UpdateMemberSettingsRequest, opts ...grpc.CallOption) (*UpdateMemberSettingsResponse, error)
+		SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*SetMemberRoleResponse, error)
+		TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+		LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*LeaveConversationResponse, error)
+		UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error)
+		ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error)
+		CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...grpc.CallOption) (*CreateInviteLinkResponse, error)
+		RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...grpc.CallOption) (*RevokeInviteLinkResponse, error)
+		ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, opts ...grpc.CallOption) (*ListInviteLinksResponse, error)
+		PreviewInviteLink(ctx context.Context, in *PreviewInviteLinkRequest, opts ...grpc.CallOption) (*PreviewInviteLinkResponse, error)
+		JoinByInvite(ctx context.Context, in *JoinByInviteRequest, opts ...grpc.CallOption) (*JoinByInviteResponse, error)
+		DissolveConversation(ctx context.Context, in *DissolveConversationRequest, opts ...grpc.CallOption) (*DissolveConversationResponse, error)
+		BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error)
+		UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error)
+		ListBannedMembers(ctx context.Context, in *ListBannedMembersRequest, opts ...grpc.CallOption) (*ListBannedMembersResponse, error)
+		SetMuteAll(ctx context.Context, in *SetMuteAllRequest, opts ...grpc.CallOption) (*SetMuteAllResponse, error)
+		MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error)
+		UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error)
+		SubscribeChannel(ctx context.Context, in *SubscribeChannelRequest, opts ...grpc.CallOption) (*SubscribeChannelResponse, error)
+		UnsubscribeChannel(ctx context.Context, in *UnsubscribeChannelRequest, opts ...grpc.CallOption) (*UnsubscribeChannelResponse, error)
+		ListSubscribedChannels(ctx context.Context, in *ListSubscribedChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+		ListPublicChannels(ctx context.Context, in *ListPublicChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+		FilterChannelSubscribers(ctx context.Context, in *FilterChannelSubscribersRequest, opts ...grpc.CallOption) (*FilterChannelSubscribersResponse, error)
+		StreamMemberIDs(ctx context.Context, in *StreamMemberIDsRequest, opts ...grpc.CallOption) (pb.ConversationService_StreamMemberIDsClient, error)
+		SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*SetMemberNicknameResponse, error)
+		SetConversationCapacity(ctx context.Context, in *SetConversationCapacityRequest, opts ...grpc.CallOption) (*SetConversationCapacityResponse, error)
+		RemoveUserEverywhere(ctx context.Context, in *RemoveUserEverywhereRequest, opts ...grpc.CallOption) (*RemoveUserEverywhereResponse, error)
 	}
 
 	defaultConversationService struct {
@@ -119,3 +205,143 @@ func (m *defaultConversationService) DeclineJoinRequest(ctx context.Context, in
 	client := pb.NewConversationServiceClient(m.cli.Conn())
 	return client.DeclineJoinRequest(ctx, in, opts...)
 }
+
+func (m *defaultConversationService) SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.SetMessageTTL(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) UpdateMemberSettings(ctx context.Context, in *UpdateMemberSettingsRequest, opts ...grpc.CallOption) (*UpdateMemberSettingsResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.UpdateMemberSettings(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*SetMemberRoleResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.SetMemberRole(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.TransferOwnership(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*LeaveConversationResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.LeaveConversation(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.UpdateConversation(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.ListAnnouncements(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...grpc.CallOption) (*CreateInviteLinkResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.CreateInviteLink(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...grpc.CallOption) (*RevokeInviteLinkResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.RevokeInviteLink(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, opts ...grpc.CallOption) (*ListInviteLinksResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.ListInviteLinks(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) PreviewInviteLink(ctx context.Context, in *PreviewInviteLinkRequest, opts ...grpc.CallOption) (*PreviewInviteLinkResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.PreviewInviteLink(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) JoinByInvite(ctx context.Context, in *JoinByInviteRequest, opts ...grpc.CallOption) (*JoinByInviteResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.JoinByInvite(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) DissolveConversation(ctx context.Context, in *DissolveConversationRequest, opts ...grpc.CallOption) (*DissolveConversationResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.DissolveConversation(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.BanMember(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.UnbanMember(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) ListBannedMembers(ctx context.Context, in *ListBannedMembersRequest, opts ...grpc.CallOption) (*ListBannedMembersResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.ListBannedMembers(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) SetMuteAll(ctx context.Context, in *SetMuteAllRequest, opts ...grpc.CallOption) (*SetMuteAllResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.SetMuteAll(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.MuteMember(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.UnmuteMember(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) SubscribeChannel(ctx context.Context, in *SubscribeChannelRequest, opts ...grpc.CallOption) (*SubscribeChannelResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.SubscribeChannel(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) UnsubscribeChannel(ctx context.Context, in *UnsubscribeChannelRequest, opts ...grpc.CallOption) (*UnsubscribeChannelResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.UnsubscribeChannel(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) ListSubscribedChannels(ctx context.Context, in *ListSubscribedChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.ListSubscribedChannels(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) ListPublicChannels(ctx context.Context, in *ListPublicChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.ListPublicChannels(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) FilterChannelSubscribers(ctx context.Context, in *FilterChannelSubscribersRequest, opts ...grpc.CallOption) (*FilterChannelSubscribersResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.FilterChannelSubscribers(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) StreamMemberIDs(ctx context.Context, in *StreamMemberIDsRequest, opts ...grpc.CallOption) (pb.ConversationService_StreamMemberIDsClient, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.StreamMemberIDs(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*SetMemberNicknameResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.SetMemberNickname(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) SetConversationCapacity(ctx context.Context, in *SetConversationCapacityRequest, opts ...grpc.CallOption) (*SetConversationCapacityResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.SetConversationCapacity(ctx, in, opts...)
+}
+
+func (m *defaultConversationService) RemoveUserEverywhere(ctx context.Context, in *RemoveUserEverywhereRequest, opts ...grpc.CallOption) (*RemoveUserEverywhereResponse, error) {
+	client := pb.NewConversationServiceClient(m.cli.Conn())
+	return client.RemoveUserEverywhere(ctx, in, opts...)
+}
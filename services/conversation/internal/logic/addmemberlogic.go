@@ -2,10 +2,12 @@ package logic
 
 import (
 	"context"
+	"time"
 
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -27,6 +29,7 @@ func NewAddMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddMemb
 	}
 }
 
+// AddMember 群主/管理员拉人入群；授予的角色必须低于操作人（管理员只能添加普通成员）。
 func (l *AddMemberLogic) AddMember(in *pb.AddMemberRequest) (*pb.AddMemberResponse, error) {
 	if in.GetConversationId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
@@ -34,13 +37,30 @@ func (l *AddMemberLogic) AddMember(in *pb.AddMemberRequest) (*pb.AddMemberRespon
 	if in.GetUserId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
-	_, err := l.svcCtx.Conv.FindByID(in.GetConversationId())
+	if in.GetOperatorId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "operator_id is required")
+	}
+	role := in.GetRole()
+	if role == "" {
+		role = roleMember
+	}
+	if role != roleAdmin && role != roleMember {
+		return nil, status.Error(codes.InvalidArgument, "role must be admin or member")
+	}
+	conv, err := findGroupOrChannel(l.svcCtx, in.GetConversationId())
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, status.Error(codes.NotFound, "conversation not found")
-		}
-		l.Errorf("find conversation failed: %v", err)
-		return nil, status.Errorf(codes.Internal, "find conversation failed: %v", err)
+		return nil, err
+	}
+	if conv.Type == "channel" && role != roleAdmin {
+		// 频道成员即发布者，订阅者走 SubscribeChannel
+		return nil, status.Error(codes.InvalidArgument, "channel members must be added as admin (publisher)")
+	}
+	operator, err := requireActiveMember(l.svcCtx, in.GetConversationId(), in.GetOperatorId())
+	if err != nil {
+		return nil, err
+	}
+	if !outranks(operator.Role, roleMember) || !outranks(operator.Role, role) {
+		return nil, status.Error(codes.PermissionDenied, "insufficient role to add member")
 	}
 	// 检查用户是否存在，避免依赖外键约束返回 internal_error（GORM Scan 无行时 err 为 nil，故用 Count 判断）
 	var count int64
@@ -52,15 +72,18 @@ func (l *AddMemberLogic) AddMember(in *pb.AddMemberRequest) (*pb.AddMemberRespon
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
-	role := in.GetRole()
-	if role == "" {
-		role = "member"
-	}
-	_, err = l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
-	if err == nil {
+	existing, err := l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
+	if err == nil && existing.Status == "active" {
 		return nil, status.Error(codes.AlreadyExists, "user already in conversation")
 	}
-	if err != gorm.ErrRecordNotFound {
+	// 被拉用户的隐私设置不允许操作人直接拉其入群时拒绝
+	if err := checkGroupAddPrivacy(l.ctx, l.svcCtx, in.GetOperatorId(), []string{in.GetUserId()}); err != nil {
+		return nil, err
+	}
+	if err == nil && existing.Status == model.MemberStatusBanned {
+		return nil, errBanned
+	}
+	if err != nil && err != gorm.ErrRecordNotFound {
 		l.Errorf("get member failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "get member failed: %v", err)
 	}
@@ -70,10 +93,22 @@ func (l *AddMemberLogic) AddMember(in *pb.AddMemberRequest) (*pb.AddMemberRespon
 		UserID:         in.GetUserId(),
 		Role:           role,
 		Status:         "active",
+		JoinedAt:       time.Now(),
 	}
-	if err := l.svcCtx.Conv.AddMember(member); err != nil {
+	if err := addMembersWithinCapacity(l.svcCtx, l.svcCtx.Conv, in.GetConversationId(), member); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		l.Errorf("add member failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "add member failed: %v", err)
 	}
+	if conv.Type == "group" {
+		postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+			Event:      sysMemberJoined,
+			OperatorId: in.GetOperatorId(),
+			UserIds:    []string{in.GetUserId()},
+			Role:       role,
+		})
+	}
 	return &pb.AddMemberResponse{}, nil
 }
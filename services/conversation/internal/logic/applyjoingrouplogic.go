@@ -7,6 +7,7 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -38,17 +39,23 @@ func (l *ApplyJoinGroupLogic) ApplyJoinGroup(in *pb.ApplyJoinGroupRequest) (*pb.
 	if c.Type != "group" {
 		return nil, status.Error(codes.InvalidArgument, "not a group conversation")
 	}
+	if c.Status == model.ConversationStatusDissolved {
+		return nil, errDissolved
+	}
 	member, _ := l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
 	if member != nil && member.Status == "active" {
 		return nil, status.Error(codes.AlreadyExists, "already in group")
 	}
+	if member != nil && member.Status == model.MemberStatusBanned {
+		return nil, errBanned
+	}
 	joinType := c.JoinType
 	if joinType == "" {
 		joinType = "approval"
 	}
 	if joinType == "direct" {
 		now := time.Now()
-		if err := l.svcCtx.Conv.AddMember(&model.ConversationMember{
+		if err := addMembersWithinCapacity(l.svcCtx, l.svcCtx.Conv, in.GetConversationId(), &model.ConversationMember{
 			ID:             uuid.New().String(),
 			ConversationID: in.GetConversationId(),
 			UserID:         in.GetUserId(),
@@ -56,12 +63,21 @@ func (l *ApplyJoinGroupLogic) ApplyJoinGroup(in *pb.ApplyJoinGroupRequest) (*pb.
 			Status:         "active",
 			JoinedAt:       now,
 		}); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
 			l.Errorf("add member for direct join failed: %v", err)
 			return nil, status.Errorf(codes.Internal, "join failed: %v", err)
 		}
+		postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+			Event:      sysMemberJoined,
+			OperatorId: in.GetUserId(),
+			UserIds:    []string{in.GetUserId()},
+			Role:       roleMember,
+		})
 		return &pb.ApplyJoinGroupResponse{Joined: true}, nil
 	}
-	req, err := l.svcCtx.JoinReq.Apply(in.GetConversationId(), in.GetUserId(), in.GetMessage())
+	req, err := l.svcCtx.JoinReq.Apply(in.GetConversationId(), in.GetUserId(), in.GetMessage(), nil)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Error(codes.AlreadyExists, "already in group")
@@ -7,6 +7,7 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -35,25 +36,47 @@ func (l *ApproveJoinRequestLogic) ApproveJoinRequest(in *pb.ApproveJoinRequestRe
 	if member.Role != "owner" && member.Role != "admin" {
 		return nil, status.Error(codes.PermissionDenied, "only owner or admin can approve")
 	}
+	if _, err := findGroup(l.svcCtx, in.GetConversationId()); err != nil {
+		return nil, err
+	}
 	req, err := l.svcCtx.JoinReq.FindByID(in.GetRequestId())
 	if err != nil || req == nil || req.ConversationID != in.GetConversationId() || req.Status != "pending" {
 		return nil, status.Error(codes.NotFound, "request not found or not pending")
 	}
-	if err := l.svcCtx.JoinReq.Approve(in.GetRequestId(), in.GetConversationId(), in.GetUserId()); err != nil {
+	if applicant, _ := l.svcCtx.Conv.GetMember(in.GetConversationId(), req.UserID); applicant != nil && applicant.Status == model.MemberStatusBanned {
+		return nil, errBanned
+	}
+	// 审批与入群在同一事务内完成：群已满时申请保持 pending，扩容后可再次审批
+	now := time.Now()
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		if err := model.NewGroupJoinRequestModel(tx).Approve(in.GetRequestId(), in.GetConversationId(), in.GetUserId()); err != nil {
+			return err
+		}
+		return addMembersWithinCapacity(l.svcCtx, model.NewConversationModel(tx), in.GetConversationId(), &model.ConversationMember{
+			ID:             uuid.New().String(),
+			ConversationID: in.GetConversationId(),
+			UserID:         req.UserID,
+			Role:           "member",
+			Status:         "active",
+			JoinedAt:       now,
+			InviteLinkID:   req.InviteLinkID,
+		})
+	})
+	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Error(codes.NotFound, "request not found or not pending")
 		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("approve join request failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "approve failed: %v", err)
 	}
-	// 将申请人加入群
-	now := time.Now()
-	_ = l.svcCtx.Conv.AddMember(&model.ConversationMember{
-		ID:             uuid.New().String(),
-		ConversationID: in.GetConversationId(),
-		UserID:         req.UserID,
-		Role:           "member",
-		Status:         "active",
-		JoinedAt:       now,
+	postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+		Event:      sysMemberJoined,
+		OperatorId: in.GetUserId(),
+		UserIds:    []string{req.UserID},
+		Role:       roleMember,
 	})
 	return &pb.ApproveJoinRequestResponse{}, nil
 }
@@ -0,0 +1,110 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// 群管理审计动作
+const (
+	auditDissolve  = "dissolve"
+	auditBan       = "ban"
+	auditUnban     = "unban"
+	auditMuteAll   = "muteAll"
+	auditUnmuteAll = "unmuteAll"
+	auditMute      = "mute"
+	auditUnmute    = "unmute"
+	// auditAccountPurged 成员账号被清除后自动退出；转让群主时 Detail 为新群主 ID
+	auditAccountPurged = "accountPurged"
+)
+
+type BanMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBanMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BanMemberLogic {
+	return &BanMemberLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// BanMember 群主/管理员封禁用户：成员状态置为 banned（非成员也可预先封禁），被封禁者离开群且不能再加入，直到解封。
+func (l *BanMemberLogic) BanMember(in *pb.BanMemberRequest) (*pb.BanMemberResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id, operator_id and user_id are required")
+	}
+	if in.GetOperatorId() == in.GetUserId() {
+		return nil, status.Error(codes.InvalidArgument, "cannot ban yourself")
+	}
+	operator, err := requireGroupManager(l.svcCtx, in.GetConversationId(), in.GetOperatorId())
+	if err != nil {
+		return nil, err
+	}
+	target, err := l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
+	if err != nil && err != gorm.ErrRecordNotFound {
+		l.Errorf("get member failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "get member failed: %v", err)
+	}
+	if err == nil {
+		if target.Status == model.MemberStatusBanned {
+			return nil, status.Error(codes.AlreadyExists, "user already banned")
+		}
+		if target.Status == model.MemberStatusActive && !outranks(operator.Role, target.Role) {
+			return nil, status.Error(codes.PermissionDenied, "insufficient role to ban member")
+		}
+	} else {
+		var count int64
+		if err := l.svcCtx.DB.Table("users").Where("id = ?", in.GetUserId()).Count(&count).Error; err != nil {
+			l.Errorf("check user exists failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "check user exists failed: %v", err)
+		}
+		if count == 0 {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+	}
+	now := time.Now()
+	err = l.svcCtx.Conv.BanMember(&model.ConversationMember{
+		ID:             uuid.New().String(),
+		ConversationID: in.GetConversationId(),
+		UserID:         in.GetUserId(),
+		Role:           roleMember,
+		Status:         model.MemberStatusBanned,
+		JoinedAt:       now,
+	}, &model.ConversationAuditLog{
+		ID:             uuid.New().String(),
+		ConversationID: in.GetConversationId(),
+		OperatorID:     in.GetOperatorId(),
+		Action:         auditBan,
+		TargetUserID:   in.GetUserId(),
+		Detail:         in.GetReason(),
+		CreatedAt:      now,
+	})
+	if err != nil {
+		l.Errorf("ban member failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "ban member failed: %v", err)
+	}
+	postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+		Event:      sysMemberBanned,
+		OperatorId: in.GetOperatorId(),
+		UserIds:    []string{in.GetUserId()},
+	})
+	if err := l.svcCtx.MQ.PublishJSON("conversation.memberBanned", map[string]interface{}{
+		"conversationId": in.GetConversationId(),
+		"operatorId":     in.GetOperatorId(),
+		"userId":         in.GetUserId(),
+		"bannedAt":       now.Unix(),
+	}); err != nil {
+		l.Errorf("publish conversation.memberBanned failed: %v", err)
+	}
+	return &pb.BanMemberResponse{}, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/config"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tierCapacity 返回档位的成员上限；tier 为空时使用 DefaultGroupTier，未知档位返回 false
+func tierCapacity(c config.Config, tier string) (int, bool) {
+	if tier == "" {
+		tier = c.DefaultGroupTier
+	}
+	n, ok := c.Tiers()[tier]
+	return n, ok
+}
+
+// groupCapacity 返回会话当前生效的成员上限：管理员覆盖值优先，其次为档位上限，档位已不在配置中时按默认档位；单聊返回 0 表示不限
+func groupCapacity(c config.Config, conv *model.Conversation) int {
+	if conv.Type != "group" && conv.Type != "channel" {
+		return 0
+	}
+	if conv.MaxMembers > 0 {
+		return int(conv.MaxMembers)
+	}
+	if n, ok := tierCapacity(c, conv.Tier); ok {
+		return n
+	}
+	n, _ := tierCapacity(c, "")
+	return n
+}
+
+// errGroupFull 群成员数已达上限
+func errGroupFull(capacity int) error {
+	return status.Errorf(codes.FailedPrecondition, "group is full: at most %d members", capacity)
+}
+
+// addMembersWithinCapacity 通过 conv（可为事务内的 model）校验人数上限并加入成员，超限时返回 FailedPrecondition，已被封禁时返回 errBanned，其余错误原样返回
+func addMembersWithinCapacity(svcCtx *svc.ServiceContext, conv *model.ConversationModel, conversationID string, members ...*model.ConversationMember) error {
+	var capacity int
+	err := conv.AddMembersWithinLimit(conversationID, members, func(c *model.Conversation) int {
+		capacity = groupCapacity(svcCtx.Config, c)
+		return capacity
+	})
+	if err == model.ErrGroupFull {
+		return errGroupFull(capacity)
+	}
+	if err == model.ErrMemberBanned {
+		// 预检查之后、写入之前被封禁
+		return errBanned
+	}
+	return err
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -38,7 +39,15 @@ func (l *CreateConversationLogic) CreateConversation(in *pb.CreateConversationRe
 	}
 	now := time.Now()
 	var convID string
-	if convType == "group" {
+	// 群聊与频道使用 11 位数字 ID；频道成员即发布者：创建者为群主，其余为管理员，订阅者不写成员表
+	if convType == "group" || convType == "channel" {
+		validIDs := orderedMemberIDs(in.GetOperatorId(), in.GetMemberIds())
+		if capacity, _ := tierCapacity(l.svcCtx.Config, ""); capacity > 0 && len(validIDs) > capacity {
+			return nil, status.Errorf(codes.InvalidArgument, "too many members: at most %d", capacity)
+		}
+		if err := checkGroupAddPrivacy(l.ctx, l.svcCtx, in.GetOperatorId(), validIDs); err != nil {
+			return nil, err
+		}
 		const maxRetries = 10
 		for attempt := 0; attempt < maxRetries; attempt++ {
 			convID = generateElevenDigitGroupID()
@@ -46,21 +55,19 @@ func (l *CreateConversationLogic) CreateConversation(in *pb.CreateConversationRe
 				ID:           convID,
 				Type:         convType,
 				Name:         in.GetName(),
+				IsPublic:     convType == "channel" && in.GetIsPublic(),
+				Tier:         l.svcCtx.Config.DefaultGroupTier,
 				CreatedAt:    now,
 				LastActiveAt: now,
 			}
-			// 先收集非空 memberIds，再按顺序分配角色，保证恰好第一个为 owner
-			var validIDs []string
-			for _, uid := range in.GetMemberIds() {
-				if uid != "" {
-					validIDs = append(validIDs, uid)
-				}
-			}
 			var members []*model.ConversationMember
 			for i, uid := range validIDs {
-				role := "member"
+				role := roleMember
+				if convType == "channel" {
+					role = roleAdmin
+				}
 				if i == 0 {
-					role = "owner"
+					role = roleOwner
 				}
 				members = append(members, &model.ConversationMember{
 					ID:             uuid.Must(uuid.NewUUID()).String(),
@@ -79,6 +86,14 @@ func (l *CreateConversationLogic) CreateConversation(in *pb.CreateConversationRe
 				l.Errorf("create conversation failed: %v", err)
 				return nil, status.Errorf(codes.Internal, "create conversation failed: %v", err)
 			}
+			if convType == "group" && len(validIDs) > 0 {
+				postSystemMessage(l.ctx, l.svcCtx, convID, &messageservice.SystemPayload{
+					Event:      sysGroupCreated,
+					OperatorId: validIDs[0],
+					UserIds:    validIDs[1:],
+					Name:       in.GetName(),
+				})
+			}
 			return &pb.CreateConversationResponse{ConversationId: convID}, nil
 		}
 		l.Errorf("create group conversation: too many id conflicts")
@@ -92,13 +107,7 @@ func (l *CreateConversationLogic) CreateConversation(in *pb.CreateConversationRe
 		CreatedAt:    now,
 		LastActiveAt: now,
 	}
-	// 先收集非空 memberIds，再按顺序分配角色，保证恰好第一个为 owner
-	var validIDs []string
-	for _, uid := range in.GetMemberIds() {
-		if uid != "" {
-			validIDs = append(validIDs, uid)
-		}
-	}
+	validIDs := orderedMemberIDs(in.GetOperatorId(), in.GetMemberIds())
 	var members []*model.ConversationMember
 	for i, uid := range validIDs {
 		role := "member"
@@ -121,6 +130,20 @@ func (l *CreateConversationLogic) CreateConversation(in *pb.CreateConversationRe
 	return &pb.CreateConversationResponse{ConversationId: convID}, nil
 }
 
+// orderedMemberIDs 去重并去掉空 ID，创建者 operatorID 非空时排在第一位；按顺序分配角色时恰好第一个为 owner
+func orderedMemberIDs(operatorID string, memberIDs []string) []string {
+	seen := make(map[string]bool, len(memberIDs)+1)
+	var ids []string
+	for _, uid := range append([]string{operatorID}, memberIDs...) {
+		if uid == "" || seen[uid] {
+			continue
+		}
+		seen[uid] = true
+		ids = append(ids, uid)
+	}
+	return ids
+}
+
 // generateElevenDigitGroupID 生成 10000000000–99999999999 范围内的随机 11 位数字字符串（群聊 ID）
 func generateElevenDigitGroupID() string {
 	n := 10000000000 + rand.Int63n(90000000000)
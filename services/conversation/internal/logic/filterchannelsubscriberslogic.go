@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type FilterChannelSubscribersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFilterChannelSubscribersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FilterChannelSubscribersLogic {
+	return &FilterChannelSubscribersLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// maxFilterSubscribers FilterChannelSubscribers 单次最多检查的用户数
+const maxFilterSubscribers = 1000
+
+// FilterChannelSubscribers 从给定用户中筛出频道订阅者。Gateway 用本实例在线用户调用，
+// 推送代价只与实例连接数有关，与频道订阅总数无关。
+func (l *FilterChannelSubscribersLogic) FilterChannelSubscribers(in *pb.FilterChannelSubscribersRequest) (*pb.FilterChannelSubscribersResponse, error) {
+	if in.GetConversationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	if len(in.GetUserIds()) > maxFilterSubscribers {
+		return nil, status.Error(codes.InvalidArgument, "too many user_ids")
+	}
+	ids, err := l.svcCtx.Channel.FilterSubscribers(in.GetConversationId(), in.GetUserIds())
+	if err != nil {
+		l.Errorf("filter channel subscribers failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "filter channel subscribers failed: %v", err)
+	}
+	return &pb.FilterChannelSubscribersResponse{UserIds: ids}, nil
+}
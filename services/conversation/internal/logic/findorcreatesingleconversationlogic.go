@@ -7,6 +7,7 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -45,7 +46,10 @@ func (l *FindOrCreateSingleConversationLogic) FindOrCreateSingleConversation(in
 		l.Errorf("find single conversation failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "find single conversation failed: %v", err)
 	}
-	// 不存在则创建单聊
+	// 不存在则创建单聊；任一方拉黑对方时不允许新建，已有单聊不受影响（消息由 MessageService 拦截）
+	if err := l.checkBlock(userID1, userID2); err != nil {
+		return nil, err
+	}
 	now := time.Now()
 	convID := uuid.Must(uuid.NewUUID()).String()
 	newConv := &model.Conversation{
@@ -65,3 +69,23 @@ func (l *FindOrCreateSingleConversationLogic) FindOrCreateSingleConversation(in
 	}
 	return &pb.FindOrCreateSingleConversationResponse{ConversationId: convID}, nil
 }
+
+// checkBlock 通过 UserService.CheckBlock 校验黑名单：user_id_1 拉黑了对方返回 FailedPrecondition，被对方拉黑返回 PermissionDenied；
+// 未配置 UserService 或调用失败时放行
+func (l *FindOrCreateSingleConversationLogic) checkBlock(userID1, userID2 string) error {
+	if l.svcCtx.UserSvc == nil {
+		return nil
+	}
+	resp, err := l.svcCtx.UserSvc.CheckBlock(l.ctx, &userservice.CheckBlockRequest{UserId: userID1, PeerId: userID2})
+	if err != nil {
+		l.Errorf("check block failed: %v", err)
+		return nil
+	}
+	if resp.GetBlockedByUser() {
+		return status.Error(codes.FailedPrecondition, "you have blocked this user")
+	}
+	if resp.GetBlockedByPeer() {
+		return status.Error(codes.PermissionDenied, "blocked by this user")
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -42,20 +43,37 @@ func (l *GetConversationLogic) GetConversation(in *pb.GetConversationRequest) (*
 		l.Errorf("count members failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "count members failed: %v", err)
 	}
+	info := toConversationInfo(c, count)
+	if c.Type == "group" || c.Type == "channel" {
+		info.Tier = c.Tier
+		if info.Tier == "" {
+			info.Tier = l.svcCtx.Config.DefaultGroupTier
+		}
+		info.MaxMembers = int32(groupCapacity(l.svcCtx.Config, c))
+	}
+	return &pb.GetConversationResponse{Conversation: info}, nil
+}
+
+// toConversationInfo 将会话记录转换为 pb.ConversationInfo，不含请求用户的个人设置
+func toConversationInfo(c *model.Conversation, memberCount int64) *pb.ConversationInfo {
 	joinType := c.JoinType
 	if joinType == "" {
 		joinType = "approval"
 	}
-	return &pb.GetConversationResponse{
-		Conversation: &pb.ConversationInfo{
-			Id:            c.ID,
-			Type:          c.Type,
-			Name:          c.Name,
-			MemberCount:   int32(count),
-			CreatedAt:     c.CreatedAt.Unix(),
-			LastActiveAt:  c.LastActiveAt.Unix(),
-			Announcement:  c.Announcement,
-			JoinType:      joinType,
-		},
-	}, nil
+	return &pb.ConversationInfo{
+		Id:                c.ID,
+		Type:              c.Type,
+		Name:              c.Name,
+		MemberCount:       int32(memberCount),
+		CreatedAt:         c.CreatedAt.Unix(),
+		LastActiveAt:      c.LastActiveAt.Unix(),
+		Announcement:      c.Announcement,
+		JoinType:          joinType,
+		MessageTtlSeconds: c.MessageTTLSeconds,
+		AvatarUrl:         c.AvatarURL,
+		Status:            c.Status,
+		MuteAll:           c.MuteAll,
+		IsPublic:          c.IsPublic,
+		SubscriberCount:   c.SubscriberCount,
+	}
 }
@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type JoinByInviteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewJoinByInviteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *JoinByInviteLogic {
+	return &JoinByInviteLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// JoinByInvite 凭邀请链接入群：链接免审批或群加入方式为 direct 时直接入群，否则提交入群申请；频道的链接直接订阅频道。
+// 使用次数的占用与入群/申请在同一事务中完成，成员与申请均记录所用链接。
+func (l *JoinByInviteLogic) JoinByInvite(in *pb.JoinByInviteRequest) (*pb.JoinByInviteResponse, error) {
+	if in.GetToken() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and user_id are required")
+	}
+	link, err := findActiveInviteLink(l.svcCtx, in.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	conv, err := findGroupOrChannel(l.svcCtx, link.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if conv.Type == "channel" {
+		return l.subscribe(in, conv)
+	}
+	member, _ := l.svcCtx.Conv.GetMember(conv.ID, in.GetUserId())
+	if member != nil && member.Status == "active" {
+		return nil, status.Error(codes.AlreadyExists, "already in group")
+	}
+	if member != nil && member.Status == model.MemberStatusBanned {
+		return nil, errBanned
+	}
+	direct := link.BypassApproval || conv.JoinType == "direct"
+	now := time.Now()
+	_, err = l.svcCtx.Invite.Redeem(in.GetToken(), now, func(tx *gorm.DB, link *model.GroupInviteLink) error {
+		if direct {
+			return addMembersWithinCapacity(l.svcCtx, model.NewConversationModel(tx), conv.ID, &model.ConversationMember{
+				ID:             uuid.New().String(),
+				ConversationID: conv.ID,
+				UserID:         in.GetUserId(),
+				Role:           roleMember,
+				Status:         "active",
+				JoinedAt:       now,
+				InviteLinkID:   &link.ID,
+			})
+		}
+		if _, err := model.NewGroupJoinRequestModel(tx).Apply(conv.ID, in.GetUserId(), in.GetMessage(), &link.ID); err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return status.Error(codes.FailedPrecondition, "join request already processed")
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.FailedPrecondition, "invite link is revoked, expired or used up")
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("join by invite failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "join by invite failed: %v", err)
+	}
+	if direct {
+		postSystemMessage(l.ctx, l.svcCtx, conv.ID, &messageservice.SystemPayload{
+			Event:      sysMemberJoined,
+			OperatorId: in.GetUserId(),
+			UserIds:    []string{in.GetUserId()},
+			Role:       roleMember,
+		})
+	}
+	return &pb.JoinByInviteResponse{ConversationId: conv.ID, Joined: direct}, nil
+}
+
+// subscribe 凭频道邀请链接订阅频道（公开或私有），不需要审批；已订阅时返回 AlreadyExists，不占用链接次数
+func (l *JoinByInviteLogic) subscribe(in *pb.JoinByInviteRequest, conv *model.Conversation) (*pb.JoinByInviteResponse, error) {
+	subscribed, err := l.svcCtx.Channel.IsSubscribed(conv.ID, in.GetUserId())
+	if err != nil {
+		l.Errorf("check subscription failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "check subscription failed: %v", err)
+	}
+	if subscribed {
+		return nil, status.Error(codes.AlreadyExists, "already subscribed")
+	}
+	_, err = l.svcCtx.Invite.Redeem(in.GetToken(), time.Now(), func(tx *gorm.DB, link *model.GroupInviteLink) error {
+		_, err := model.NewChannelSubscriptionModel(tx).Subscribe(&model.ChannelSubscription{
+			ConversationID: conv.ID,
+			UserID:         in.GetUserId(),
+			CreatedAt:      time.Now(),
+		})
+		return err
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.FailedPrecondition, "invite link is revoked, expired or used up")
+		}
+		l.Errorf("subscribe by invite failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "subscribe by invite failed: %v", err)
+	}
+	return &pb.JoinByInviteResponse{ConversationId: conv.ID, Joined: true}, nil
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LeaveConversationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLeaveConversationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LeaveConversationLogic {
+	return &LeaveConversationLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// LeaveConversation 成员主动退出群聊。群主须先转让群主，除非已是最后一名成员；
+// 最后一名成员（群主）退出时群随之解散，写入审计日志并发布 conversation.dissolved，避免留下无人管理的群。
+func (l *LeaveConversationLogic) LeaveConversation(in *pb.LeaveConversationRequest) (*pb.LeaveConversationResponse, error) {
+	if in.GetConversationId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and user_id are required")
+	}
+	if _, err := findGroup(l.svcCtx, in.GetConversationId()); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	dissolved, err := l.svcCtx.Conv.LeaveGroup(in.GetConversationId(), in.GetUserId(), now, &model.ConversationAuditLog{
+		ID:             uuid.New().String(),
+		ConversationID: in.GetConversationId(),
+		OperatorID:     in.GetUserId(),
+		Action:         auditDissolve,
+		CreatedAt:      now,
+	})
+	switch {
+	case err == model.ErrMemberNotActive:
+		return nil, status.Error(codes.PermissionDenied, "not a member or not active")
+	case err == model.ErrOwnerMustTransfer:
+		return nil, status.Error(codes.FailedPrecondition, "owner must transfer ownership before leaving")
+	case err != nil:
+		l.Errorf("leave conversation failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "leave conversation failed: %v", err)
+	}
+	if dissolved {
+		postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+			Event:      sysGroupDissolved,
+			OperatorId: in.GetUserId(),
+		})
+		if err := l.svcCtx.MQ.PublishJSON("conversation.dissolved", map[string]interface{}{
+			"conversationId": in.GetConversationId(),
+			"operatorId":     in.GetUserId(),
+			"dissolvedAt":    now.Unix(),
+		}); err != nil {
+			l.Errorf("publish conversation.dissolved failed: %v", err)
+		}
+		return &pb.LeaveConversationResponse{}, nil
+	}
+	postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+		Event:      sysMemberLeft,
+		OperatorId: in.GetUserId(),
+		UserIds:    []string{in.GetUserId()},
+	})
+	return &pb.LeaveConversationResponse{}, nil
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListAnnouncementsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListAnnouncementsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAnnouncementsLogic {
+	return &ListAnnouncementsLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// ListAnnouncements 列出群公告历史（作者与发布时间），仅 active 成员可查看。
+func (l *ListAnnouncementsLogic) ListAnnouncements(in *pb.ListAnnouncementsRequest) (*pb.ListAnnouncementsResponse, error) {
+	if in.GetConversationId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and user_id are required")
+	}
+	if _, err := findGroupOrChannel(l.svcCtx, in.GetConversationId()); err != nil {
+		return nil, err
+	}
+	if _, err := requireActiveMember(l.svcCtx, in.GetConversationId(), in.GetUserId()); err != nil {
+		return nil, err
+	}
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	list, err := l.svcCtx.Conv.ListAnnouncements(in.GetConversationId(), limit)
+	if err != nil {
+		l.Errorf("list announcements failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list announcements failed: %v", err)
+	}
+	items := make([]*pb.AnnouncementInfo, 0, len(list))
+	for _, a := range list {
+		items = append(items, &pb.AnnouncementInfo{
+			Id:        a.ID,
+			Content:   a.Content,
+			AuthorId:  a.AuthorID,
+			CreatedAt: a.CreatedAt.Unix(),
+		})
+	}
+	return &pb.ListAnnouncementsResponse{Items: items}, nil
+}
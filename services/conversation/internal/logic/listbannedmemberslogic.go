@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListBannedMembersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListBannedMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListBannedMembersLogic {
+	return &ListBannedMembersLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// ListBannedMembers 群主/管理员查看封禁名单。
+func (l *ListBannedMembersLogic) ListBannedMembers(in *pb.ListBannedMembersRequest) (*pb.ListBannedMembersResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and operator_id are required")
+	}
+	if _, err := requireGroupManager(l.svcCtx, in.GetConversationId(), in.GetOperatorId()); err != nil {
+		return nil, err
+	}
+	list, err := l.svcCtx.Conv.ListBanned(in.GetConversationId())
+	if err != nil {
+		l.Errorf("list banned members failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list banned members failed: %v", err)
+	}
+	now := time.Now()
+	items := make([]*pb.MemberInfo, 0, len(list))
+	for _, m := range list {
+		items = append(items, &pb.MemberInfo{
+			UserId:     m.UserID,
+			Role:       m.Role,
+			JoinedAt:   m.JoinedAt.Unix(),
+			Status:     m.Status,
+			MutedUntil: mutedUntilUnix(m.MutedUntil, now),
+		})
+	}
+	return &pb.ListBannedMembersResponse{Items: items}, nil
+}
@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListInviteLinksLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListInviteLinksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListInviteLinksLogic {
+	return &ListInviteLinksLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// ListInviteLinks 群主/管理员查看群邀请链接及使用次数。
+func (l *ListInviteLinksLogic) ListInviteLinks(in *pb.ListInviteLinksRequest) (*pb.ListInviteLinksResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and operator_id are required")
+	}
+	if _, err := requireManager(l.svcCtx, in.GetConversationId(), in.GetOperatorId(), "group", "channel"); err != nil {
+		return nil, err
+	}
+	list, err := l.svcCtx.Invite.ListByConversation(in.GetConversationId(), !in.GetIncludeInactive(), time.Now())
+	if err != nil {
+		l.Errorf("list invite links failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list invite links failed: %v", err)
+	}
+	items := make([]*pb.InviteLinkInfo, 0, len(list))
+	for _, link := range list {
+		items = append(items, toInviteLinkInfo(link))
+	}
+	return &pb.ListInviteLinksResponse{Items: items}, nil
+}
@@ -2,15 +2,25 @@ package logic
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
+const (
+	defaultMemberPageSize = 100
+	maxMemberPageSize     = 500
+)
+
 type ListMembersLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -25,10 +35,36 @@ func NewListMembersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListM
 	}
 }
 
+// ListMembers 按入群时间正序分页列出成员；operator_id 非空时要求其为 active 成员。
 func (l *ListMembersLogic) ListMembers(in *pb.ListMembersRequest) (*pb.ListMembersResponse, error) {
 	if in.GetConversationId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
 	}
+	for _, r := range in.GetRoles() {
+		if roleRank(r) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role: %s", r)
+		}
+	}
+	for _, s := range in.GetStatuses() {
+		if s != model.MemberStatusActive && s != model.MemberStatusLeft && s != model.MemberStatusBanned {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid status: %s", s)
+		}
+	}
+	var after *model.MemberListCursor
+	if in.GetCursor() != "" {
+		c, ok := decodeMemberCursor(in.GetCursor())
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		after = c
+	}
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultMemberPageSize
+	}
+	if limit > maxMemberPageSize {
+		limit = maxMemberPageSize
+	}
 	_, err := l.svcCtx.Conv.FindByID(in.GetConversationId())
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -37,19 +73,92 @@ func (l *ListMembersLogic) ListMembers(in *pb.ListMembersRequest) (*pb.ListMembe
 		l.Errorf("find conversation failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "find conversation failed: %v", err)
 	}
-	list, err := l.svcCtx.Conv.ListMembers(in.GetConversationId())
+	if in.GetOperatorId() != "" {
+		if _, err := requireActiveMember(l.svcCtx, in.GetConversationId(), in.GetOperatorId()); err != nil {
+			return nil, err
+		}
+	}
+	filter := model.MemberFilter{
+		Query:    strings.TrimSpace(in.GetQuery()),
+		Roles:    in.GetRoles(),
+		Statuses: in.GetStatuses(),
+	}
+	// 多取一条判断是否还有下一页
+	list, err := l.svcCtx.Conv.ListMembers(in.GetConversationId(), filter, after, limit+1)
 	if err != nil {
 		l.Errorf("list members failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "list members failed: %v", err)
 	}
+	var nextCursor string
+	if len(list) > limit {
+		list = list[:limit]
+		last := list[len(list)-1]
+		nextCursor = encodeMemberCursor(last.JoinedAt, last.UserID)
+	}
+	now := time.Now()
 	items := make([]*pb.MemberInfo, 0, len(list))
 	for _, m := range list {
-		items = append(items, &pb.MemberInfo{
-			UserId:   m.UserID,
-			Role:     m.Role,
-			JoinedAt: m.JoinedAt.Unix(),
-			Status:   m.Status,
-		})
-	}
-	return &pb.ListMembersResponse{Items: items}, nil
+		item := &pb.MemberInfo{
+			UserId:          m.UserID,
+			Role:            m.Role,
+			JoinedAt:        m.JoinedAt.Unix(),
+			Status:          m.Status,
+			SpeakMutedUntil: mutedUntilUnix(m.SpeakMutedUntil, now),
+			Nickname:        m.Nickname,
+		}
+		// 免打扰是个人设置，只返回给本人
+		if m.UserID == in.GetOperatorId() {
+			item.MutedUntil = mutedUntilUnix(m.MutedUntil, now)
+		}
+		items = append(items, item)
+	}
+	if in.GetWithProfile() {
+		l.fillProfiles(items, in.GetOperatorId())
+	}
+	return &pb.ListMembersResponse{Items: items, NextCursor: nextCursor}, nil
+}
+
+// fillProfiles 通过 UserService.BatchGetUsers 补充昵称与头像，以 viewerID 的视角按隐私设置过滤；未配置或调用失败时不附带资料，不影响成员列表本身
+func (l *ListMembersLogic) fillProfiles(items []*pb.MemberInfo, viewerID string) {
+	if l.svcCtx.UserSvc == nil || len(items) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.UserId)
+	}
+	resp, err := l.svcCtx.UserSvc.BatchGetUsers(l.ctx, &userservice.BatchGetUsersRequest{Ids: ids, ViewerId: viewerID})
+	if err != nil {
+		l.Errorf("batch get users failed: %v", err)
+		return
+	}
+	users := make(map[string]*userservice.User, len(resp.GetUsers()))
+	for _, u := range resp.GetUsers() {
+		if u != nil {
+			users[u.Id] = u
+		}
+	}
+	for _, it := range items {
+		if u, ok := users[it.UserId]; ok {
+			it.ProfileNickname = u.Nickname
+			it.AvatarUrl = u.AvatarUrl
+		}
+	}
+}
+
+// encodeMemberCursor 游标格式为 "<joined_at 微秒>:<user_id>"
+func encodeMemberCursor(joinedAt time.Time, userID string) string {
+	return strconv.FormatInt(joinedAt.UnixMicro(), 10) + ":" + userID
+}
+
+func decodeMemberCursor(s string) (*model.MemberListCursor, bool) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, false
+	}
+	micros, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &model.MemberListCursor{JoinedAt: time.UnixMicro(micros), UserID: parts[1]}, true
 }
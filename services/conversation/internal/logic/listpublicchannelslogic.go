@@ -0,0 +1,33 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListPublicChannelsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListPublicChannelsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPublicChannelsLogic {
+	return &ListPublicChannelsLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// ListPublicChannels 公开频道目录，按订阅数倒序，可按名称搜索；cursor 为偏移量。
+func (l *ListPublicChannelsLogic) ListPublicChannels(in *pb.ListPublicChannelsRequest) (*pb.ListChannelsResponse, error) {
+	offset, limit := channelPage(in.GetCursor(), in.GetLimit())
+	list, err := l.svcCtx.Channel.ListPublic(strings.TrimSpace(in.GetQuery()), offset, limit+1)
+	if err != nil {
+		l.Errorf("list public channels failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list public channels failed: %v", err)
+	}
+	return toChannelsResponse(l.svcCtx, list, offset, limit), nil
+}
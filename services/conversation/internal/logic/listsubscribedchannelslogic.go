@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListSubscribedChannelsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListSubscribedChannelsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSubscribedChannelsLogic {
+	return &ListSubscribedChannelsLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// ListSubscribedChannels 列出用户订阅的频道，按订阅时间倒序；cursor 为偏移量。
+func (l *ListSubscribedChannelsLogic) ListSubscribedChannels(in *pb.ListSubscribedChannelsRequest) (*pb.ListChannelsResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	offset, limit := channelPage(in.GetCursor(), in.GetLimit())
+	list, err := l.svcCtx.Channel.ListByUser(in.GetUserId(), offset, limit+1)
+	if err != nil {
+		l.Errorf("list subscribed channels failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list subscribed channels failed: %v", err)
+	}
+	return toChannelsResponse(l.svcCtx, list, offset, limit), nil
+}
+
+// channelPage 解析偏移量 cursor 与分页大小（默认 20，最大 100）
+func channelPage(cursor string, limit int32) (int, int) {
+	offset := 0
+	if cursor != "" {
+		if o, err := strconv.Atoi(cursor); err == nil && o >= 0 {
+			offset = o
+		}
+	}
+	n := int(limit)
+	if n <= 0 {
+		n = 20
+	}
+	if n > 100 {
+		n = 100
+	}
+	return offset, n
+}
+
+// toChannelsResponse 将多查一条的结果转换为分页响应；member_count 为发布者人数
+func toChannelsResponse(svcCtx *svc.ServiceContext, list []*model.Conversation, offset, limit int) *pb.ListChannelsResponse {
+	hasMore := len(list) > limit
+	if hasMore {
+		list = list[:limit]
+	}
+	items := make([]*pb.ConversationInfo, 0, len(list))
+	for _, c := range list {
+		count, _ := svcCtx.Conv.CountMembers(c.ID)
+		items = append(items, toConversationInfo(c, count))
+	}
+	var nextCursor string
+	if hasMore {
+		nextCursor = strconv.Itoa(offset + limit)
+	}
+	return &pb.ListChannelsResponse{Items: items, NextCursor: nextCursor}
+}
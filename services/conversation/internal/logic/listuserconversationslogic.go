@@ -3,7 +3,10 @@ package logic
 import (
 	"context"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -11,6 +14,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// syncTokenSkew 全量拉取及增量最后一页返回的同步点至少向前回退的时长，覆盖查询期间尚未提交的事务；增量结果可能因此少量重复
+const syncTokenSkew = 2 * time.Second
+
 type ListUserConversationsLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -25,16 +31,12 @@ func NewListUserConversationsLogic(ctx context.Context, svcCtx *svc.ServiceConte
 	}
 }
 
+// ListUserConversations 全量模式按 (last_active_at, id) 游标分页列出会话，并返回 sync_token；
+// 携带 sync_token 时为增量模式，只返回该同步点之后变化的会话（含已退出、已解散的会话，由 member_status / status 标识）。
 func (l *ListUserConversationsLogic) ListUserConversations(in *pb.ListUserConversationsRequest) (*pb.ListUserConversationsResponse, error) {
 	if in.GetUserId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
-	offset := 0
-	if in.GetCursor() != "" {
-		if o, err := strconv.Atoi(in.GetCursor()); err == nil && o >= 0 {
-			offset = o
-		}
-	}
 	limit := int(in.GetLimit())
 	if limit <= 0 {
 		limit = 50
@@ -42,7 +44,19 @@ func (l *ListUserConversationsLogic) ListUserConversations(in *pb.ListUserConver
 	if limit > 100 {
 		limit = 100
 	}
-	list, err := l.svcCtx.Conv.ListByUserID(in.GetUserId(), offset, limit+1)
+	if in.GetSyncToken() != "" {
+		return l.listChanged(in.GetUserId(), in.GetSyncToken(), limit)
+	}
+	var after *model.ConversationListCursor
+	if in.GetCursor() != "" {
+		c, ok := decodeListCursor(in.GetCursor())
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		after = c
+	}
+	syncToken := encodeSyncToken(time.Now().Add(-syncTokenSkew), "")
+	list, err := l.svcCtx.Conv.ListByUserID(in.GetUserId(), in.GetArchived(), in.GetIncludeHidden(), after, limit+1)
 	if err != nil {
 		l.Errorf("list user conversations failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "list user conversations failed: %v", err)
@@ -51,27 +65,120 @@ func (l *ListUserConversationsLogic) ListUserConversations(in *pb.ListUserConver
 	if hasMore {
 		list = list[:limit]
 	}
+	memberships, err := l.listMemberships(in.GetUserId(), list)
+	if err != nil {
+		return nil, err
+	}
 	items := make([]*pb.ConversationInfo, 0, len(list))
 	for _, c := range list {
 		count, _ := l.svcCtx.Conv.CountMembers(c.ID)
-		joinType := c.JoinType
-		if joinType == "" {
-			joinType = "approval"
-		}
-		items = append(items, &pb.ConversationInfo{
-			Id:            c.ID,
-			Type:          c.Type,
-			Name:          c.Name,
-			MemberCount:   int32(count),
-			CreatedAt:     c.CreatedAt.Unix(),
-			LastActiveAt:  c.LastActiveAt.Unix(),
-			Announcement:  c.Announcement,
-			JoinType:      joinType,
-		})
+		info := toConversationInfo(c, count)
+		info.Settings = toMemberSettings(memberships[c.ID])
+		items = append(items, info)
 	}
 	var nextCursor string
 	if hasMore {
-		nextCursor = strconv.Itoa(offset + limit)
+		last := list[len(list)-1]
+		cursor := &model.ConversationListCursor{At: last.LastActiveAt, ID: last.ID}
+		if m := memberships[last.ID]; m != nil && m.PinnedAt != nil {
+			cursor = &model.ConversationListCursor{Pinned: true, At: *m.PinnedAt, ID: last.ID}
+		}
+		nextCursor = encodeListCursor(cursor)
+	}
+	return &pb.ListUserConversationsResponse{Items: items, NextCursor: nextCursor, SyncToken: syncToken}, nil
+}
+
+// listChanged 增量模式：返回同步点之后变化的会话，按变化时间正序，sync_token 推进到本页最后一条（最后一页回退 syncTokenSkew）
+func (l *ListUserConversationsLogic) listChanged(userID, token string, limit int) (*pb.ListUserConversationsResponse, error) {
+	since, afterID, ok := decodeSyncToken(token)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid sync_token")
+	}
+	list, err := l.svcCtx.Conv.ListChangedSince(userID, since, afterID, limit+1)
+	if err != nil {
+		l.Errorf("list changed conversations failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list user conversations failed: %v", err)
+	}
+	hasMore := len(list) > limit
+	if hasMore {
+		list = list[:limit]
+	}
+	convs := make([]*model.Conversation, 0, len(list))
+	for _, c := range list {
+		convs = append(convs, &c.Conversation)
+	}
+	memberships, err := l.listMemberships(userID, convs)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.ConversationInfo, 0, len(list))
+	for _, c := range list {
+		count, _ := l.svcCtx.Conv.CountMembers(c.ID)
+		info := toConversationInfo(&c.Conversation, count)
+		info.Settings = toMemberSettings(memberships[c.ID])
+		info.MemberStatus = c.MemberStatus
+		info.ActivityChanged = c.LastActiveAt.After(since)
+		items = append(items, info)
+	}
+	next := token
+	if len(list) > 0 {
+		last := list[len(list)-1]
+		next = encodeSyncToken(last.ChangedAt, last.ID)
+		// 最后一页的同步点不晚于 now - syncTokenSkew，否则变化时间更早但尚未提交的事务会被跳过；中间页保持精确游标，避免翻页原地打转
+		if floor := time.Now().Add(-syncTokenSkew); !hasMore && last.ChangedAt.After(floor) {
+			next = encodeSyncToken(floor, "")
+		}
+	}
+	return &pb.ListUserConversationsResponse{Items: items, SyncToken: next, HasMore: hasMore}, nil
+}
+
+func (l *ListUserConversationsLogic) listMemberships(userID string, list []*model.Conversation) (map[string]*model.ConversationMember, error) {
+	convIDs := make([]string, 0, len(list))
+	for _, c := range list {
+		convIDs = append(convIDs, c.ID)
+	}
+	memberships, err := l.svcCtx.Conv.ListMemberships(userID, convIDs)
+	if err != nil {
+		l.Errorf("list memberships failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list user conversations failed: %v", err)
+	}
+	return memberships, nil
+}
+
+// encodeListCursor 游标格式：p|a:<微秒时间戳>:<会话 ID>，p 表示置顶段，a 表示按活跃时间排序的非置顶段
+func encodeListCursor(c *model.ConversationListCursor) string {
+	segment := "a"
+	if c.Pinned {
+		segment = "p"
+	}
+	return segment + ":" + strconv.FormatInt(c.At.UnixMicro(), 10) + ":" + c.ID
+}
+
+func decodeListCursor(s string) (*model.ConversationListCursor, bool) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 || (parts[0] != "p" && parts[0] != "a") || parts[2] == "" {
+		return nil, false
+	}
+	us, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &model.ConversationListCursor{Pinned: parts[0] == "p", At: time.UnixMicro(us), ID: parts[2]}, true
+}
+
+// encodeSyncToken 同步点格式：<微秒时间戳>:<会话 ID>，会话 ID 为空表示该时间点之后的全部变化
+func encodeSyncToken(t time.Time, id string) string {
+	return strconv.FormatInt(t.UnixMicro(), 10) + ":" + id
+}
+
+func decodeSyncToken(s string) (time.Time, string, bool) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return time.Time{}, "", false
+	}
+	us, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", false
 	}
-	return &pb.ListUserConversationsResponse{Items: items, NextCursor: nextCursor}, nil
+	return time.UnixMicro(us), parts[1], true
 }
@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+)
+
+// mutedUntilUnix 将 muted_until 转为对外表示：未免打扰或已过期为 0，永久为 -1，否则为 Unix 秒
+func mutedUntilUnix(t *time.Time, now time.Time) int64 {
+	if t == nil || !t.After(now) {
+		return 0
+	}
+	if !t.Before(model.MutedForever) {
+		return -1
+	}
+	return t.Unix()
+}
+
+// toMemberSettings 由成员记录构造个人设置
+func toMemberSettings(m *model.ConversationMember) *pb.MemberSettings {
+	if m == nil {
+		return nil
+	}
+	s := &pb.MemberSettings{
+		MutedUntil: mutedUntilUnix(m.MutedUntil, time.Now()),
+		Archived:   m.Archived,
+		Hidden:     m.Hidden,
+		Alias:      m.Alias,
+	}
+	if m.PinnedAt != nil {
+		s.Pinned = true
+		s.PinnedAt = m.PinnedAt.Unix()
+	}
+	return s
+}
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type PreviewInviteLinkLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPreviewInviteLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PreviewInviteLinkLogic {
+	return &PreviewInviteLinkLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// PreviewInviteLink 凭 token 查看群名、头像与人数，不要求是群成员；链接已失效时返回 FailedPrecondition。
+func (l *PreviewInviteLinkLogic) PreviewInviteLink(in *pb.PreviewInviteLinkRequest) (*pb.PreviewInviteLinkResponse, error) {
+	if in.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	link, err := findActiveInviteLink(l.svcCtx, in.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	conv, err := l.svcCtx.Conv.FindByID(link.ConversationID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "conversation not found")
+		}
+		l.Errorf("find conversation failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "find conversation failed: %v", err)
+	}
+	if conv.Status == model.ConversationStatusDissolved {
+		return nil, errDissolved
+	}
+	// 频道展示订阅人数
+	count := int64(conv.SubscriberCount)
+	if conv.Type != "channel" {
+		if count, err = l.svcCtx.Conv.CountMembers(conv.ID); err != nil {
+			l.Errorf("count members failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "count members failed: %v", err)
+		}
+	}
+	info := toInviteLinkInfo(link)
+	return &pb.PreviewInviteLinkResponse{
+		ConversationId: conv.ID,
+		Name:           conv.Name,
+		AvatarUrl:      conv.AvatarURL,
+		MemberCount:    int32(count),
+		BypassApproval: link.BypassApproval,
+		ExpiresAt:      info.ExpiresAt,
+	}, nil
+}
+
+// findActiveInviteLink 按 token 查找仍可使用的邀请链接
+func findActiveInviteLink(svcCtx *svc.ServiceContext, token string) (*model.GroupInviteLink, error) {
+	link, err := svcCtx.Invite.FindByToken(token)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "invite link not found")
+		}
+		return nil, status.Errorf(codes.Internal, "find invite link failed: %v", err)
+	}
+	if !link.Active(time.Now()) {
+		return nil, status.Error(codes.FailedPrecondition, "invite link is revoked, expired or used up")
+	}
+	return link, nil
+}
@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// privacyActionGroupAdd 对应 UserService.CheckPrivacy 的 group_add：谁能直接把用户拉进群
+const privacyActionGroupAdd = "group_add"
+
+// checkGroupAddPrivacy 校验 operatorID 能否直接把 userIDs 拉进群（按被拉用户的隐私设置与黑名单），有不允许的用户时返回 PermissionDenied；
+// operatorID 为空（系统/管理后台操作）、未配置 UserService 或调用失败时放行，这些用户仍可通过邀请链接或入群申请加入
+func checkGroupAddPrivacy(ctx context.Context, svcCtx *svc.ServiceContext, operatorID string, userIDs []string) error {
+	if operatorID == "" || svcCtx.UserSvc == nil {
+		return nil
+	}
+	targets := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != operatorID {
+			targets = append(targets, id)
+		}
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	resp, err := svcCtx.UserSvc.CheckPrivacy(ctx, &userservice.CheckPrivacyRequest{
+		ViewerId: operatorID,
+		Action:   privacyActionGroupAdd,
+		UserIds:  targets,
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("check group add privacy failed: %v", err)
+		return nil
+	}
+	if denied := resp.GetDeniedUserIds(); len(denied) > 0 {
+		return status.Errorf(codes.PermissionDenied, "user %s can only join by invite", denied[0])
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,6 +26,7 @@ func NewRemoveMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Remo
 	}
 }
 
+// RemoveMember 群主/管理员移出成员；只能移出角色低于自己的成员，本人退群请使用 LeaveConversation。
 func (l *RemoveMemberLogic) RemoveMember(in *pb.RemoveMemberRequest) (*pb.RemoveMemberResponse, error) {
 	if in.GetConversationId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
@@ -32,15 +34,21 @@ func (l *RemoveMemberLogic) RemoveMember(in *pb.RemoveMemberRequest) (*pb.Remove
 	if in.GetUserId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
-	_, err := l.svcCtx.Conv.FindByID(in.GetConversationId())
+	if in.GetOperatorId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "operator_id is required")
+	}
+	if in.GetOperatorId() == in.GetUserId() {
+		return nil, status.Error(codes.InvalidArgument, "use LeaveConversation to leave")
+	}
+	conv, err := findGroupOrChannel(l.svcCtx, in.GetConversationId())
 	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, status.Error(codes.NotFound, "conversation not found")
-		}
-		l.Errorf("find conversation failed: %v", err)
-		return nil, status.Errorf(codes.Internal, "find conversation failed: %v", err)
+		return nil, err
 	}
-	// 严格语义：用户不在会话中时返回 NotFound
+	operator, err := requireActiveMember(l.svcCtx, in.GetConversationId(), in.GetOperatorId())
+	if err != nil {
+		return nil, err
+	}
+	// 严格语义：用户不在会话中（或已离开）时返回 NotFound
 	member, err := l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
@@ -49,10 +57,23 @@ func (l *RemoveMemberLogic) RemoveMember(in *pb.RemoveMemberRequest) (*pb.Remove
 		l.Errorf("get member failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "get member failed: %v", err)
 	}
-	// 若已离开/非 active，可按需求选择视为 NotFound 或允许幂等；这里保持严格，仍允许更新状态
+	if member.Status != "active" {
+		return nil, status.Error(codes.NotFound, "member not found")
+	}
+	if !outranks(operator.Role, member.Role) || !outranks(operator.Role, roleMember) {
+		return nil, status.Error(codes.PermissionDenied, "insufficient role to remove member")
+	}
 	if err := l.svcCtx.Conv.RemoveMember(member.ConversationID, member.UserID); err != nil {
 		l.Errorf("remove member failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "remove member failed: %v", err)
 	}
+	// 频道发布者变动不写入频道消息流
+	if conv.Type == "group" {
+		postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+			Event:      sysMemberRemoved,
+			OperatorId: in.GetOperatorId(),
+			UserIds:    []string{in.GetUserId()},
+		})
+	}
 	return &pb.RemoveMemberResponse{}, nil
 }
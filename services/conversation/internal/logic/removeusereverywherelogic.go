@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type RemoveUserEverywhereLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveUserEverywhereLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveUserEverywhereLogic {
+	return &RemoveUserEverywhereLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// RemoveUserEverywhere 账号清除时让用户退出所有会话：群主身份转让给管理员优先、入群最早的成员，
+// 没有其他成员时解散群；群聊和频道照常写入退群、角色变更、解散的系统消息与审计日志。
+// 之后取消全部频道订阅并删除入群申请。每个会话单独提交，中途失败时重试只处理剩余部分
+func (l *RemoveUserEverywhereLogic) RemoveUserEverywhere(in *pb.RemoveUserEverywhereRequest) (*pb.RemoveUserEverywhereResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	userID := in.GetUserId()
+	ids, err := l.svcCtx.Conv.ListActiveConversationIDs(userID)
+	if err != nil {
+		l.Errorf("list conversations of user %s failed: %v", userID, err)
+		return nil, status.Errorf(codes.Internal, "list conversations failed: %v", err)
+	}
+	for _, id := range ids {
+		if err := l.leave(id, userID); err != nil {
+			return nil, err
+		}
+	}
+	if err := l.svcCtx.Channel.UnsubscribeAll(userID); err != nil {
+		l.Errorf("unsubscribe channels of user %s failed: %v", userID, err)
+		return nil, status.Errorf(codes.Internal, "unsubscribe channels failed: %v", err)
+	}
+	if err := l.svcCtx.JoinReq.DeleteByUser(userID); err != nil {
+		l.Errorf("delete join requests of user %s failed: %v", userID, err)
+		return nil, status.Errorf(codes.Internal, "delete join requests failed: %v", err)
+	}
+	return &pb.RemoveUserEverywhereResponse{}, nil
+}
+
+// leave 退出单个会话并发出对应的系统消息与事件；单聊只更新成员状态
+func (l *RemoveUserEverywhereLogic) leave(conversationID, userID string) error {
+	now := time.Now()
+	res, err := l.svcCtx.Conv.RemovePurgedMember(conversationID, userID, now, &model.ConversationAuditLog{
+		ID:             uuid.New().String(),
+		ConversationID: conversationID,
+		OperatorID:     userID,
+		Action:         auditAccountPurged,
+		TargetUserID:   userID,
+		CreatedAt:      now,
+	}, &model.ConversationAuditLog{
+		ID:             uuid.New().String(),
+		ConversationID: conversationID,
+		OperatorID:     userID,
+		Action:         auditDissolve,
+		CreatedAt:      now,
+	})
+	if err == gorm.ErrRecordNotFound {
+		// 已退出（并发处理或上次重试已完成）
+		return nil
+	}
+	if err != nil {
+		l.Errorf("remove purged user %s from conversation %s failed: %v", userID, conversationID, err)
+		return status.Errorf(codes.Internal, "remove member failed: %v", err)
+	}
+	if res.Type != "group" && res.Type != "channel" {
+		return nil
+	}
+	if res.NewOwnerID != "" {
+		postSystemMessage(l.ctx, l.svcCtx, conversationID, &messageservice.SystemPayload{
+			Event:      sysMemberRoleChanged,
+			OperatorId: userID,
+			UserIds:    []string{res.NewOwnerID},
+			Role:       roleOwner,
+		})
+	}
+	if res.Dissolved {
+		postSystemMessage(l.ctx, l.svcCtx, conversationID, &messageservice.SystemPayload{
+			Event:      sysGroupDissolved,
+			OperatorId: userID,
+		})
+		if err := l.svcCtx.MQ.PublishJSON("conversation.dissolved", map[string]interface{}{
+			"conversationId": conversationID,
+			"operatorId":     userID,
+			"dissolvedAt":    now.Unix(),
+		}); err != nil {
+			l.Errorf("publish conversation.dissolved failed: %v", err)
+		}
+		return nil
+	}
+	postSystemMessage(l.ctx, l.svcCtx, conversationID, &messageservice.SystemPayload{
+		Event:      sysMemberLeft,
+		OperatorId: userID,
+		UserIds:    []string{userID},
+	})
+	return nil
+}
@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type RevokeInviteLinkLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeInviteLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeInviteLinkLogic {
+	return &RevokeInviteLinkLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// RevokeInviteLink 群主/管理员撤销邀请链接，撤销后不可再使用。
+func (l *RevokeInviteLinkLogic) RevokeInviteLink(in *pb.RevokeInviteLinkRequest) (*pb.RevokeInviteLinkResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" || in.GetLinkId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id, operator_id and link_id are required")
+	}
+	if _, err := requireManager(l.svcCtx, in.GetConversationId(), in.GetOperatorId(), "group", "channel"); err != nil {
+		return nil, err
+	}
+	if err := l.svcCtx.Invite.Revoke(in.GetConversationId(), in.GetLinkId(), time.Now()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "invite link not found or already revoked")
+		}
+		l.Errorf("revoke invite link failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "revoke invite link failed: %v", err)
+	}
+	return &pb.RevokeInviteLinkResponse{}, nil
+}
@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// 成员角色，层级 owner > admin > member
+const (
+	roleOwner  = "owner"
+	roleAdmin  = "admin"
+	roleMember = "member"
+)
+
+// roleRank 返回角色层级，数值越大权限越高；未知角色为 0
+func roleRank(role string) int {
+	switch role {
+	case roleOwner:
+		return 3
+	case roleAdmin:
+		return 2
+	case roleMember:
+		return 1
+	}
+	return 0
+}
+
+// outranks 判断 a 的角色是否严格高于 b
+func outranks(a, b string) bool {
+	return roleRank(a) > roleRank(b)
+}
+
+// requireActiveMember 查询 userID 在会话中的 active 成员记录；不是成员或非 active 时返回 PermissionDenied
+func requireActiveMember(svcCtx *svc.ServiceContext, conversationID, userID string) (*model.ConversationMember, error) {
+	member, err := svcCtx.Conv.GetMember(conversationID, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.PermissionDenied, "not a member or not active")
+		}
+		return nil, status.Errorf(codes.Internal, "get member failed: %v", err)
+	}
+	if member.Status != "active" {
+		return nil, status.Error(codes.PermissionDenied, "not a member or not active")
+	}
+	return member, nil
+}
+
+// requireGroupManager 要求会话为群聊且 operatorID 为群主或管理员
+func requireGroupManager(svcCtx *svc.ServiceContext, conversationID, operatorID string) (*model.ConversationMember, error) {
+	return requireManager(svcCtx, conversationID, operatorID, "group")
+}
+
+// requireManager 要求会话类型为 types 之一、未解散，且 operatorID 为群主或管理员
+func requireManager(svcCtx *svc.ServiceContext, conversationID, operatorID string, types ...string) (*model.ConversationMember, error) {
+	if _, err := findManaged(svcCtx, conversationID, types...); err != nil {
+		return nil, err
+	}
+	operator, err := requireActiveMember(svcCtx, conversationID, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	if !outranks(operator.Role, roleMember) {
+		return nil, status.Error(codes.PermissionDenied, "only owner or admin can manage group")
+	}
+	return operator, nil
+}
+
+// errDissolved 群已解散，只读
+var errDissolved = status.Error(codes.FailedPrecondition, "group is dissolved")
+
+// errBanned 用户已被该群封禁
+var errBanned = status.Error(codes.PermissionDenied, "user is banned from this group")
+
+// findGroup 查询会话并要求为未解散的群聊
+func findGroup(svcCtx *svc.ServiceContext, conversationID string) (*model.Conversation, error) {
+	return findManaged(svcCtx, conversationID, "group")
+}
+
+// findGroupOrChannel 查询会话并要求为未解散的群聊或频道；用于两者共用的管理操作（资料、发布者/成员、解散）
+func findGroupOrChannel(svcCtx *svc.ServiceContext, conversationID string) (*model.Conversation, error) {
+	return findManaged(svcCtx, conversationID, "group", "channel")
+}
+
+// findManaged 查询会话并要求类型为 types 之一且未解散
+func findManaged(svcCtx *svc.ServiceContext, conversationID string, types ...string) (*model.Conversation, error) {
+	conv, err := svcCtx.Conv.FindByID(conversationID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "conversation not found")
+		}
+		return nil, status.Errorf(codes.Internal, "find conversation failed: %v", err)
+	}
+	matched := false
+	for _, t := range types {
+		if conv.Type == t {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		if len(types) == 1 {
+			return nil, status.Error(codes.InvalidArgument, "operation only applies to group conversations")
+		}
+		return nil, status.Error(codes.InvalidArgument, "operation only applies to group or channel conversations")
+	}
+	if conv.Status == model.ConversationStatusDissolved {
+		return nil, errDissolved
+	}
+	return conv, nil
+}
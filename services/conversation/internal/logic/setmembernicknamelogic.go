@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxNicknameLength 群昵称最大长度（字符数）
+const maxNicknameLength = 32
+
+type SetMemberNicknameLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetMemberNicknameLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetMemberNicknameLogic {
+	return &SetMemberNicknameLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetMemberNickname 设置调用者在群内的昵称，仅群聊可用；空串清除。
+func (l *SetMemberNicknameLogic) SetMemberNickname(in *pb.SetMemberNicknameRequest) (*pb.SetMemberNicknameResponse, error) {
+	if in.GetConversationId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and user_id are required")
+	}
+	nickname := strings.TrimSpace(in.GetNickname())
+	if utf8.RuneCountInString(nickname) > maxNicknameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "nickname must be at most %d characters", maxNicknameLength)
+	}
+	if _, err := findGroup(l.svcCtx, in.GetConversationId()); err != nil {
+		return nil, err
+	}
+	if _, err := requireActiveMember(l.svcCtx, in.GetConversationId(), in.GetUserId()); err != nil {
+		return nil, err
+	}
+	updates := map[string]interface{}{"nickname": nickname}
+	if err := l.svcCtx.Conv.UpdateMemberSettings(in.GetConversationId(), in.GetUserId(), updates); err != nil {
+		l.Errorf("update member nickname failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "update member nickname failed: %v", err)
+	}
+	return &pb.SetMemberNicknameResponse{}, nil
+}
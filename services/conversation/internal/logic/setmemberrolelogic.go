@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type SetMemberRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetMemberRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetMemberRoleLogic {
+	return &SetMemberRoleLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// SetMemberRole 群主设置成员为管理员或普通成员；转让群主请使用 TransferOwnership。
+func (l *SetMemberRoleLogic) SetMemberRole(in *pb.SetMemberRoleRequest) (*pb.SetMemberRoleResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id, operator_id and user_id are required")
+	}
+	if in.GetRole() != roleAdmin && in.GetRole() != roleMember {
+		return nil, status.Error(codes.InvalidArgument, "role must be admin or member")
+	}
+	if _, err := findGroup(l.svcCtx, in.GetConversationId()); err != nil {
+		return nil, err
+	}
+	operator, err := requireActiveMember(l.svcCtx, in.GetConversationId(), in.GetOperatorId())
+	if err != nil {
+		return nil, err
+	}
+	if operator.Role != roleOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owner can set member role")
+	}
+	if in.GetUserId() == in.GetOperatorId() {
+		return nil, status.Error(codes.InvalidArgument, "owner role can only be changed by TransferOwnership")
+	}
+	target, err := l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
+	if err != nil || target.Status != "active" {
+		if err == nil || err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "member not found")
+		}
+		l.Errorf("get member failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "get member failed: %v", err)
+	}
+	if target.Role == in.GetRole() {
+		return &pb.SetMemberRoleResponse{}, nil
+	}
+	if err := l.svcCtx.Conv.UpdateMemberRole(in.GetConversationId(), in.GetOperatorId(), in.GetUserId(), in.GetRole()); err != nil {
+		switch err {
+		case model.ErrNotOwner:
+			return nil, status.Error(codes.PermissionDenied, "only owner can set member role")
+		case model.ErrMemberNotActive:
+			return nil, status.Error(codes.NotFound, "member not found")
+		}
+		l.Errorf("update member role failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "update member role failed: %v", err)
+	}
+	postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+		Event:      sysMemberRoleChanged,
+		OperatorId: in.GetOperatorId(),
+		UserIds:    []string{in.GetUserId()},
+		Role:       in.GetRole(),
+	})
+	return &pb.SetMemberRoleResponse{}, nil
+}
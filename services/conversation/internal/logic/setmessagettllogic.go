@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// 消息自动销毁时长范围（秒），0 表示关闭
+const (
+	minMessageTTLSeconds = 5
+	maxMessageTTLSeconds = 7 * 24 * 3600
+)
+
+type SetMessageTTLLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetMessageTTLLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetMessageTTLLogic {
+	return &SetMessageTTLLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// SetMessageTTL 设置会话的消息自动销毁时长。群聊仅群主可设置；单聊双方都是会话所有者，任一成员可设置。
+func (l *SetMessageTTLLogic) SetMessageTTL(in *pb.SetMessageTTLRequest) (*pb.SetMessageTTLResponse, error) {
+	if in.GetConversationId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and user_id are required")
+	}
+	ttl := in.GetTtlSeconds()
+	if ttl != 0 && (ttl < minMessageTTLSeconds || ttl > maxMessageTTLSeconds) {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_seconds must be 0 or between %d and %d", minMessageTTLSeconds, maxMessageTTLSeconds)
+	}
+	conv, err := l.svcCtx.Conv.FindByID(in.GetConversationId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "conversation not found")
+		}
+		return nil, status.Errorf(codes.Internal, "find conversation failed: %v", err)
+	}
+	if conv.Status == model.ConversationStatusDissolved {
+		return nil, errDissolved
+	}
+	member, err := l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
+	if err != nil || member == nil || member.Status != "active" {
+		return nil, status.Error(codes.PermissionDenied, "not a member or not active")
+	}
+	if conv.Type != "single" && member.Role != "owner" {
+		return nil, status.Error(codes.PermissionDenied, "only owner can set message ttl")
+	}
+	if err := l.svcCtx.Conv.UpdateMessageTTL(in.GetConversationId(), ttl); err != nil {
+		l.Errorf("update message ttl failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "update message ttl failed: %v", err)
+	}
+	return &pb.SetMessageTTLResponse{}, nil
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetMuteAllLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetMuteAllLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetMuteAllLogic {
+	return &SetMuteAllLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// SetMuteAll 群主/管理员开启或关闭全员禁言；开启后仅群主/管理员可发言，变更通过 conversation.muteAllChanged 推送给成员。
+func (l *SetMuteAllLogic) SetMuteAll(in *pb.SetMuteAllRequest) (*pb.SetMuteAllResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and operator_id are required")
+	}
+	if _, err := requireGroupManager(l.svcCtx, in.GetConversationId(), in.GetOperatorId()); err != nil {
+		return nil, err
+	}
+	action := auditUnmuteAll
+	if in.GetEnabled() {
+		action = auditMuteAll
+	}
+	err := l.svcCtx.Conv.SetMuteAll(in.GetConversationId(), in.GetEnabled(), &model.ConversationAuditLog{
+		ID:             uuid.New().String(),
+		ConversationID: in.GetConversationId(),
+		OperatorID:     in.GetOperatorId(),
+		Action:         action,
+		CreatedAt:      time.Now(),
+	})
+	if err != nil {
+		l.Errorf("set mute all failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "set mute all failed: %v", err)
+	}
+	if err := l.svcCtx.MQ.PublishJSON("conversation.muteAllChanged", map[string]interface{}{
+		"conversationId": in.GetConversationId(),
+		"operatorId":     in.GetOperatorId(),
+		"muteAll":        in.GetEnabled(),
+	}); err != nil {
+		l.Errorf("publish conversation.muteAllChanged failed: %v", err)
+	}
+	return &pb.SetMuteAllResponse{}, nil
+}
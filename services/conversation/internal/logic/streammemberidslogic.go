@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultMemberIDBatch = 1000
+	maxMemberIDBatch     = 5000
+)
+
+type StreamMemberIDsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStreamMemberIDsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StreamMemberIDsLogic {
+	return &StreamMemberIDsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// StreamMemberIDs 按 user_id 游标分批读取 active 成员并逐批发送，大群推送时无需一次性加载全部成员。
+func (l *StreamMemberIDsLogic) StreamMemberIDs(in *pb.StreamMemberIDsRequest, stream pb.ConversationService_StreamMemberIDsServer) error {
+	if in.GetConversationId() == "" {
+		return status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	batch := int(in.GetBatchSize())
+	if batch <= 0 {
+		batch = defaultMemberIDBatch
+	}
+	if batch > maxMemberIDBatch {
+		batch = maxMemberIDBatch
+	}
+	if _, err := l.svcCtx.Conv.FindByID(in.GetConversationId()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return status.Error(codes.NotFound, "conversation not found")
+		}
+		l.Errorf("find conversation failed: %v", err)
+		return status.Errorf(codes.Internal, "find conversation failed: %v", err)
+	}
+	var after string
+	for {
+		if err := l.ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		list, err := l.svcCtx.Conv.ListActiveMemberIDs(in.GetConversationId(), after, batch)
+		if err != nil {
+			l.Errorf("list active member ids failed: %v", err)
+			return status.Errorf(codes.Internal, "list active member ids failed: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		now := time.Now()
+		chunk := &pb.MemberIDsChunk{UserIds: make([]string, 0, len(list))}
+		for _, m := range list {
+			chunk.UserIds = append(chunk.UserIds, m.UserID)
+			if mutedUntilUnix(m.MutedUntil, now) != 0 {
+				chunk.MutedUserIds = append(chunk.MutedUserIds, m.UserID)
+			}
+		}
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+		if len(list) < batch {
+			return nil
+		}
+		after = list[len(list)-1].UserID
+	}
+}
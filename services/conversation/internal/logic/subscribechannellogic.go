@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SubscribeChannelLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSubscribeChannelLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubscribeChannelLogic {
+	return &SubscribeChannelLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// SubscribeChannel 订阅公开频道；订阅只写 channel_subscriptions 并原子增加订阅数，重复订阅视为成功。
+// 私有频道只能通过频道邀请链接（JoinByInvite）订阅，直接订阅返回 PermissionDenied。
+func (l *SubscribeChannelLogic) SubscribeChannel(in *pb.SubscribeChannelRequest) (*pb.SubscribeChannelResponse, error) {
+	if in.GetConversationId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and user_id are required")
+	}
+	conv, err := findManaged(l.svcCtx, in.GetConversationId(), "channel")
+	if err != nil {
+		return nil, err
+	}
+	if !conv.IsPublic {
+		subscribed, err := l.svcCtx.Channel.IsSubscribed(conv.ID, in.GetUserId())
+		if err != nil {
+			l.Errorf("check subscription failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "check subscription failed: %v", err)
+		}
+		if !subscribed {
+			return nil, status.Error(codes.PermissionDenied, "private channel requires an invite link")
+		}
+		return &pb.SubscribeChannelResponse{SubscriberCount: conv.SubscriberCount}, nil
+	}
+	_, err = l.svcCtx.Channel.Subscribe(&model.ChannelSubscription{
+		ConversationID: in.GetConversationId(),
+		UserID:         in.GetUserId(),
+		CreatedAt:      time.Now(),
+	})
+	if err != nil {
+		l.Errorf("subscribe channel failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "subscribe channel failed: %v", err)
+	}
+	conv, err = l.svcCtx.Conv.FindByID(in.GetConversationId())
+	if err != nil {
+		l.Errorf("find conversation failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "find conversation failed: %v", err)
+	}
+	return &pb.SubscribeChannelResponse{SubscriberCount: conv.SubscriberCount}, nil
+}
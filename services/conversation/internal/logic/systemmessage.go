@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 系统消息事件类型，客户端据此本地化展示
+const (
+	sysGroupCreated        = "group.created"
+	sysGroupRenamed        = "group.renamed"
+	sysGroupDissolved      = "group.dissolved"
+	sysMemberJoined        = "member.joined"
+	sysMemberLeft          = "member.left"
+	sysMemberRemoved       = "member.removed"
+	sysMemberBanned        = "member.banned"
+	sysMemberRoleChanged   = "member.roleChanged"
+	sysAnnouncementChanged = "announcement.changed"
+)
+
+// postSystemMessage 通过 MessageService.PostMessage 向会话写入一条系统消息（入库并经 message.created 推送）。
+// 系统消息只是时间线展示，失败仅记录日志，不影响已完成的群操作；未配置 MessageService 时直接跳过。
+func postSystemMessage(ctx context.Context, svcCtx *svc.ServiceContext, conversationID string, payload *messageservice.SystemPayload) {
+	if svcCtx.MessageSvc == nil {
+		return
+	}
+	_, err := svcCtx.MessageSvc.PostMessage(ctx, &messageservice.PostMessageRequest{
+		ClientMsgId:    "sys-" + uuid.New().String(),
+		ConversationId: conversationID,
+		FromUserId:     payload.OperatorId,
+		Body:           &messageservice.MessageBody{Type: "system", System: payload},
+	})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("post system message %s failed conversationId=%s: %v", payload.Event, conversationID, err)
+	}
+}
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type TransferOwnershipLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTransferOwnershipLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TransferOwnershipLogic {
+	return &TransferOwnershipLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// TransferOwnership 群主将群转让给另一名 active 成员，原群主降为管理员。
+func (l *TransferOwnershipLogic) TransferOwnership(in *pb.TransferOwnershipRequest) (*pb.TransferOwnershipResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" || in.GetNewOwnerId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id, operator_id and new_owner_id are required")
+	}
+	if in.GetNewOwnerId() == in.GetOperatorId() {
+		return nil, status.Error(codes.InvalidArgument, "new owner must be another member")
+	}
+	if _, err := findGroup(l.svcCtx, in.GetConversationId()); err != nil {
+		return nil, err
+	}
+	operator, err := requireActiveMember(l.svcCtx, in.GetConversationId(), in.GetOperatorId())
+	if err != nil {
+		return nil, err
+	}
+	if operator.Role != roleOwner {
+		return nil, status.Error(codes.PermissionDenied, "only owner can transfer ownership")
+	}
+	target, err := l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetNewOwnerId())
+	if err != nil || target.Status != "active" {
+		if err == nil || err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "member not found")
+		}
+		l.Errorf("get member failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "get member failed: %v", err)
+	}
+	if err := l.svcCtx.Conv.TransferOwnership(in.GetConversationId(), in.GetOperatorId(), in.GetNewOwnerId()); err != nil {
+		switch err {
+		case model.ErrNotOwner:
+			return nil, status.Error(codes.PermissionDenied, "only owner can transfer ownership")
+		case model.ErrMemberNotActive:
+			return nil, status.Error(codes.NotFound, "member not found")
+		}
+		l.Errorf("transfer ownership failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "transfer ownership failed: %v", err)
+	}
+	postSystemMessage(l.ctx, l.svcCtx, in.GetConversationId(), &messageservice.SystemPayload{
+		Event:      sysMemberRoleChanged,
+		OperatorId: in.GetOperatorId(),
+		UserIds:    []string{in.GetNewOwnerId()},
+		Role:       roleOwner,
+	})
+	return &pb.TransferOwnershipResponse{}, nil
+}
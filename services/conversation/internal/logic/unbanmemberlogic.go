@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UnbanMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnbanMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnbanMemberLogic {
+	return &UnbanMemberLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// UnbanMember 群主/管理员解除封禁；解封后用户不会自动回到群内，需重新申请或被添加。
+func (l *UnbanMemberLogic) UnbanMember(in *pb.UnbanMemberRequest) (*pb.UnbanMemberResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id, operator_id and user_id are required")
+	}
+	if _, err := requireGroupManager(l.svcCtx, in.GetConversationId(), in.GetOperatorId()); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	err := l.svcCtx.Conv.UnbanMember(in.GetConversationId(), in.GetUserId(), &model.ConversationAuditLog{
+		ID:             uuid.New().String(),
+		ConversationID: in.GetConversationId(),
+		OperatorID:     in.GetOperatorId(),
+		Action:         auditUnban,
+		TargetUserID:   in.GetUserId(),
+		CreatedAt:      now,
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user is not banned")
+		}
+		l.Errorf("unban member failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "unban member failed: %v", err)
+	}
+	if err := l.svcCtx.MQ.PublishJSON("conversation.memberUnbanned", map[string]interface{}{
+		"conversationId": in.GetConversationId(),
+		"operatorId":     in.GetOperatorId(),
+		"userId":         in.GetUserId(),
+	}); err != nil {
+		l.Errorf("publish conversation.memberUnbanned failed: %v", err)
+	}
+	return &pb.UnbanMemberResponse{}, nil
+}
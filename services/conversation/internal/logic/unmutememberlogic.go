@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UnmuteMemberLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnmuteMemberLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnmuteMemberLogic {
+	return &UnmuteMemberLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// UnmuteMember 群主/管理员提前解除成员禁言。
+func (l *UnmuteMemberLogic) UnmuteMember(in *pb.UnmuteMemberRequest) (*pb.UnmuteMemberResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id, operator_id and user_id are required")
+	}
+	target, err := requireMutableMember(l.svcCtx, in.GetConversationId(), in.GetOperatorId(), in.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	err = l.svcCtx.Conv.SetSpeakMutedUntil(in.GetConversationId(), target.UserID, nil, &model.ConversationAuditLog{
+		ID:             uuid.New().String(),
+		ConversationID: in.GetConversationId(),
+		OperatorID:     in.GetOperatorId(),
+		Action:         auditUnmute,
+		TargetUserID:   target.UserID,
+		CreatedAt:      time.Now(),
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "member not found")
+		}
+		l.Errorf("unmute member failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "unmute member failed: %v", err)
+	}
+	publishMemberMuted(l.ctx, l.svcCtx, in.GetConversationId(), in.GetOperatorId(), target.UserID, 0)
+	return &pb.UnmuteMemberResponse{}, nil
+}
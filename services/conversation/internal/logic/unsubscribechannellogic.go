@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UnsubscribeChannelLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnsubscribeChannelLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnsubscribeChannelLogic {
+	return &UnsubscribeChannelLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// UnsubscribeChannel 取消订阅频道；频道已解散时也可取消。
+func (l *UnsubscribeChannelLogic) UnsubscribeChannel(in *pb.UnsubscribeChannelRequest) (*pb.UnsubscribeChannelResponse, error) {
+	if in.GetConversationId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and user_id are required")
+	}
+	if err := l.svcCtx.Channel.Unsubscribe(in.GetConversationId(), in.GetUserId()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "not subscribed")
+		}
+		l.Errorf("unsubscribe channel failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "unsubscribe channel failed: %v", err)
+	}
+	return &pb.UnsubscribeChannelResponse{}, nil
+}
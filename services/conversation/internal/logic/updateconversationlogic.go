@@ -0,0 +1,154 @@
+package logic
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxGroupNameLen    = 64
+	maxAnnouncementLen = 2000
+	maxAvatarURLLen    = 1024
+)
+
+type UpdateConversationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateConversationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateConversationLogic {
+	return &UpdateConversationLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// conversationUpdatedEvent 发布到 im.events（routing key conversation.updated），Gateway 原样推送给会话所有在线成员
+type conversationUpdatedEvent struct {
+	ConversationId string   `json:"conversationId"`
+	OperatorId     string   `json:"operatorId"`
+	Fields         []string `json:"fields"`
+	Name           string   `json:"name"`
+	Announcement   string   `json:"announcement"`
+	JoinType       string   `json:"joinType"`
+	AvatarUrl      string   `json:"avatarUrl"`
+	UpdatedAt      int64    `json:"updatedAt"`
+}
+
+// UpdateConversation 按 update_mask 修改群资料，仅群主/管理员可操作；公告变更同时写入公告历史。
+func (l *UpdateConversationLogic) UpdateConversation(in *pb.UpdateConversationRequest) (*pb.UpdateConversationResponse, error) {
+	if in.GetConversationId() == "" || in.GetOperatorId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and operator_id are required")
+	}
+	paths := in.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	updates := make(map[string]interface{}, len(paths))
+	fields := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if _, dup := updates[p]; !dup {
+			fields = append(fields, p)
+		}
+		switch p {
+		case "name":
+			if utf8.RuneCountInString(in.GetName()) > maxGroupNameLen {
+				return nil, status.Error(codes.InvalidArgument, "name too long")
+			}
+			updates["name"] = in.GetName()
+		case "announcement":
+			if utf8.RuneCountInString(in.GetAnnouncement()) > maxAnnouncementLen {
+				return nil, status.Error(codes.InvalidArgument, "announcement too long")
+			}
+			updates["announcement"] = in.GetAnnouncement()
+		case "join_type":
+			if in.GetJoinType() != "approval" && in.GetJoinType() != "direct" {
+				return nil, status.Error(codes.InvalidArgument, "join_type must be approval or direct")
+			}
+			updates["join_type"] = in.GetJoinType()
+		case "avatar_url":
+			if len(in.GetAvatarUrl()) > maxAvatarURLLen {
+				return nil, status.Error(codes.InvalidArgument, "avatar_url too long")
+			}
+			updates["avatar_url"] = in.GetAvatarUrl()
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update_mask path: %s", p)
+		}
+	}
+	conv, err := findGroupOrChannel(l.svcCtx, in.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+	operator, err := requireActiveMember(l.svcCtx, in.GetConversationId(), in.GetOperatorId())
+	if err != nil {
+		return nil, err
+	}
+	if !outranks(operator.Role, roleMember) {
+		return nil, status.Error(codes.PermissionDenied, "only owner or admin can update group")
+	}
+
+	now := time.Now()
+	name := conv.Name
+	var announcement *model.ConversationAnnouncement
+	if v, ok := updates["announcement"]; ok && v != conv.Announcement {
+		announcement = &model.ConversationAnnouncement{
+			ID:             uuid.New().String(),
+			ConversationID: conv.ID,
+			Content:        in.GetAnnouncement(),
+			AuthorID:       in.GetOperatorId(),
+			CreatedAt:      now,
+		}
+	}
+	if err := l.svcCtx.Conv.UpdateProfile(conv.ID, updates, announcement); err != nil {
+		l.Errorf("update conversation failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "update conversation failed: %v", err)
+	}
+
+	conv, err = l.svcCtx.Conv.FindByID(conv.ID)
+	if err != nil {
+		l.Errorf("find conversation failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "find conversation failed: %v", err)
+	}
+	count, err := l.svcCtx.Conv.CountMembers(conv.ID)
+	if err != nil {
+		l.Errorf("count members failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "count members failed: %v", err)
+	}
+	info := toConversationInfo(conv, count)
+
+	if err := l.svcCtx.MQ.PublishJSON("conversation.updated", &conversationUpdatedEvent{
+		ConversationId: conv.ID,
+		OperatorId:     in.GetOperatorId(),
+		Fields:         fields,
+		Name:           info.Name,
+		Announcement:   info.Announcement,
+		JoinType:       info.JoinType,
+		AvatarUrl:      info.AvatarUrl,
+		UpdatedAt:      now.Unix(),
+	}); err != nil {
+		l.Errorf("publish conversation.updated failed: %v", err)
+	}
+	if _, ok := updates["name"]; ok && name != info.Name {
+		postSystemMessage(l.ctx, l.svcCtx, conv.ID, &messageservice.SystemPayload{
+			Event:      sysGroupRenamed,
+			OperatorId: in.GetOperatorId(),
+			Name:       info.Name,
+		})
+	}
+	if announcement != nil {
+		postSystemMessage(l.ctx, l.svcCtx, conv.ID, &messageservice.SystemPayload{
+			Event:        sysAnnouncementChanged,
+			OperatorId:   in.GetOperatorId(),
+			Announcement: announcement.Content,
+		})
+	}
+	return &pb.UpdateConversationResponse{Conversation: info}, nil
+}
@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/conversation/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAliasLength 会话备注名最大长度（字符数）
+const maxAliasLength = 64
+
+type UpdateMemberSettingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateMemberSettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateMemberSettingsLogic {
+	return &UpdateMemberSettingsLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// UpdateMemberSettings 更新调用者对会话的个人设置，仅请求中设置了的字段会被修改。
+func (l *UpdateMemberSettingsLogic) UpdateMemberSettings(in *pb.UpdateMemberSettingsRequest) (*pb.UpdateMemberSettingsResponse, error) {
+	if in.GetConversationId() == "" || in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id and user_id are required")
+	}
+	member, err := l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
+	if err != nil || member == nil || member.Status != "active" {
+		return nil, status.Error(codes.PermissionDenied, "not a member or not active")
+	}
+	now := time.Now()
+	updates := make(map[string]interface{})
+	if in.MutedUntil != nil {
+		switch v := in.GetMutedUntil(); {
+		case v == 0:
+			updates["muted_until"] = nil
+		case v < 0:
+			updates["muted_until"] = model.MutedForever
+		case v <= now.Unix():
+			return nil, status.Error(codes.InvalidArgument, "muted_until must be in the future")
+		default:
+			updates["muted_until"] = time.Unix(v, 0)
+		}
+	}
+	if in.Pinned != nil {
+		if in.GetPinned() {
+			updates["pinned_at"] = now
+		} else {
+			updates["pinned_at"] = nil
+		}
+	}
+	if in.Archived != nil {
+		updates["archived"] = in.GetArchived()
+	}
+	if in.Hidden != nil {
+		updates["hidden"] = in.GetHidden()
+	}
+	if in.Alias != nil {
+		if utf8.RuneCountInString(in.GetAlias()) > maxAliasLength {
+			return nil, status.Errorf(codes.InvalidArgument, "alias must be at most %d characters", maxAliasLength)
+		}
+		updates["alias"] = in.GetAlias()
+	}
+	if err := l.svcCtx.Conv.UpdateMemberSettings(in.GetConversationId(), in.GetUserId(), updates); err != nil {
+		l.Errorf("update member settings failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "update member settings failed: %v", err)
+	}
+	member, err = l.svcCtx.Conv.GetMember(in.GetConversationId(), in.GetUserId())
+	if err != nil {
+		l.Errorf("get member failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "get member failed: %v", err)
+	}
+	return &pb.UpdateMemberSettingsResponse{Settings: toMemberSettings(member)}, nil
+}
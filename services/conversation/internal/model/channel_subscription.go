@@ -0,0 +1,135 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ChannelSubscription 对应 channel_subscriptions 表。频道订阅者不写 conversation_members，
+// 只有发布者（群主/管理员）是频道成员。
+type ChannelSubscription struct {
+	ConversationID string    `gorm:"column:conversation_id;type:varchar(36);primaryKey"`
+	UserID         string    `gorm:"column:user_id;type:varchar(10);primaryKey"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+}
+
+func (ChannelSubscription) TableName() string {
+	return "channel_subscriptions"
+}
+
+type ChannelSubscriptionModel struct {
+	db *gorm.DB
+}
+
+func NewChannelSubscriptionModel(db *gorm.DB) *ChannelSubscriptionModel {
+	return &ChannelSubscriptionModel{db: db}
+}
+
+// Subscribe 订阅频道并在同一事务内增加 subscriber_count；已订阅时不变，返回 false
+func (m *ChannelSubscriptionModel) Subscribe(sub *ChannelSubscription) (bool, error) {
+	created := false
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(sub)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		created = true
+		return tx.Model(&Conversation{}).Where("id = ?", sub.ConversationID).
+			Update("subscriber_count", gorm.Expr("subscriber_count + 1")).Error
+	})
+	return created, err
+}
+
+// IsSubscribed 判断用户是否已订阅频道
+func (m *ChannelSubscriptionModel) IsSubscribed(conversationID, userID string) (bool, error) {
+	var n int64
+	err := m.db.Model(&ChannelSubscription{}).
+		Where("conversation_id = ? AND user_id = ?", conversationID, userID).
+		Count(&n).Error
+	return n > 0, err
+}
+
+// Unsubscribe 取消订阅并在同一事务内减少 subscriber_count；未订阅时返回 gorm.ErrRecordNotFound
+func (m *ChannelSubscriptionModel) Unsubscribe(conversationID, userID string) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("conversation_id = ? AND user_id = ?", conversationID, userID).Delete(&ChannelSubscription{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Model(&Conversation{}).Where("id = ?", conversationID).
+			Update("subscriber_count", gorm.Expr("GREATEST(subscriber_count - 1, 0)")).Error
+	})
+}
+
+// UnsubscribeAll 取消用户的全部频道订阅并在同一事务内减少各频道的 subscriber_count
+func (m *ChannelSubscriptionModel) UnsubscribeAll(userID string) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		var ids []string
+		if err := tx.Model(&ChannelSubscription{}).Where("user_id = ?", userID).Pluck("conversation_id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&ChannelSubscription{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&Conversation{}).Where("id IN ?", ids).
+			Update("subscriber_count", gorm.Expr("GREATEST(subscriber_count - 1, 0)")).Error
+	})
+}
+
+// ListByUser 列出用户订阅的未解散频道，按订阅时间倒序
+func (m *ChannelSubscriptionModel) ListByUser(userID string, offset, limit int) ([]*Conversation, error) {
+	var list []*Conversation
+	err := m.db.Table("conversations").
+		Joins("INNER JOIN channel_subscriptions ON channel_subscriptions.conversation_id = conversations.id AND channel_subscriptions.user_id = ?", userID).
+		Where("conversations.status <> ?", ConversationStatusDissolved).
+		Order("channel_subscriptions.created_at DESC, conversations.id DESC").
+		Offset(offset).Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// ListPublic 公开频道目录：未解散的公开频道按订阅数倒序；query 非空时按名称模糊匹配
+func (m *ChannelSubscriptionModel) ListPublic(query string, offset, limit int) ([]*Conversation, error) {
+	var list []*Conversation
+	q := m.db.Where("type = ? AND is_public AND status = ?", "channel", ConversationStatusActive)
+	if query != "" {
+		q = q.Where("name ILIKE ?", "%"+escapeLike(query)+"%")
+	}
+	err := q.Order("subscriber_count DESC, id").Offset(offset).Limit(limit).Find(&list).Error
+	return list, err
+}
+
+// FilterSubscribers 返回 userIDs 中订阅了该频道的用户
+func (m *ChannelSubscriptionModel) FilterSubscribers(conversationID string, userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	var out []string
+	err := m.db.Model(&ChannelSubscription{}).
+		Where("conversation_id = ? AND user_id IN ?", conversationID, userIDs).
+		Pluck("user_id", &out).Error
+	return out, err
+}
+
+// escapeLike 转义 LIKE 通配符，使查询词按字面匹配
+func escapeLike(s string) string {
+	r := make([]rune, 0, len(s))
+	for _, c := range s {
+		if c == '%' || c == '_' || c == '\\' {
+			r = append(r, '\\')
+		}
+		r = append(r, c)
+	}
+	return string(r)
+}
@@ -1,41 +1,130 @@
 package model
 
 import (
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Conversation 对应 conversations 表。单聊 id 为 UUID 字符串，群聊 id 为 11 位数字字符串。
 // JoinType 仅群聊有效：approval=需审批加入，direct=直接加入。
+// AvatarURL 为群头像，仅群聊使用。Status 为 active / dissolved，群解散后只读。
+// MuteAll 为全员禁言，开启后仅群主/管理员可发言。
+// IsPublic / SubscriberCount 仅频道使用：公开频道出现在频道目录中，SubscriberCount 为订阅人数。
+// MessageTTLSeconds 为消息自动销毁时长，0 表示不销毁；Message 服务写入消息时据此计算 expires_at。
+// Tier 为人数档位，为空时使用默认档位；MaxMembers 为管理员设置的成员上限覆盖值，0 表示使用档位上限。
 type Conversation struct {
-	ID           string    `gorm:"column:id;type:varchar(36);primaryKey"`
-	Type         string    `gorm:"column:type;type:text;not null;default:single"`
-	Name         string    `gorm:"column:name;type:text;not null;default:''"`
-	Announcement string    `gorm:"column:announcement;type:text;not null;default:''"`
-	JoinType     string    `gorm:"column:join_type;type:text;not null;default:approval"`
-	CreatedAt    time.Time `gorm:"column:created_at;type:timestamptz;not null"`
-	LastActiveAt time.Time `gorm:"column:last_active_at;type:timestamptz;not null"`
+	ID                string     `gorm:"column:id;type:varchar(36);primaryKey"`
+	Type              string     `gorm:"column:type;type:text;not null;default:single"`
+	Name              string     `gorm:"column:name;type:text;not null;default:''"`
+	Announcement      string     `gorm:"column:announcement;type:text;not null;default:''"`
+	JoinType          string     `gorm:"column:join_type;type:text;not null;default:approval"`
+	AvatarURL         string     `gorm:"column:avatar_url;type:text;not null;default:''"`
+	Status            string     `gorm:"column:status;type:text;not null;default:active"`
+	DissolvedAt       *time.Time `gorm:"column:dissolved_at;type:timestamptz"`
+	MuteAll           bool       `gorm:"column:mute_all;not null;default:false"`
+	IsPublic          bool       `gorm:"column:is_public;not null;default:false"`
+	SubscriberCount   int64      `gorm:"column:subscriber_count;not null;default:0"`
+	MessageTTLSeconds int32      `gorm:"column:message_ttl_seconds;not null;default:0"`
+	Tier              string     `gorm:"column:tier;type:text;not null;default:''"`
+	MaxMembers        int32      `gorm:"column:max_members;not null;default:0"`
+	CreatedAt         time.Time  `gorm:"column:created_at;type:timestamptz;not null"`
+	LastActiveAt      time.Time  `gorm:"column:last_active_at;type:timestamptz;not null"`
+	UpdatedAt         time.Time  `gorm:"column:updated_at;type:timestamptz;not null"`
 }
 
 func (Conversation) TableName() string {
 	return "conversations"
 }
 
-// ConversationMember 对应 conversation_members 表
+// 会话状态
+const (
+	ConversationStatusActive    = "active"
+	ConversationStatusDissolved = "dissolved"
+)
+
+// 成员状态：active 在群内，left 已退出或被移出，banned 被封禁（不能再加入，直到解封）
+const (
+	MemberStatusActive = "active"
+	MemberStatusLeft   = "left"
+	MemberStatusBanned = "banned"
+)
+
+// ErrGroupFull 加入后成员数将超过上限
+var ErrGroupFull = errors.New("group is full")
+
+// ErrBelowMemberCount 新的人数上限小于当前 active 成员数
+var ErrBelowMemberCount = errors.New("capacity below member count")
+
+// ErrMemberBanned 加入成员时该用户已被群封禁
+var ErrMemberBanned = errors.New("member is banned")
+
+// ErrNotOwner 转让群主时原群主已不是 active 群主（并发转让或角色变更）
+var ErrNotOwner = errors.New("operator is not owner")
+
+// ErrMemberNotActive 目标成员不存在或不是 active 状态
+var ErrMemberNotActive = errors.New("member not active")
+
+// MutedForever 永久免打扰时 muted_until 存储的时间
+var MutedForever = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// ConversationMember 对应 conversation_members 表。
+// MutedUntil / PinnedAt / Archived / Hidden / Alias 为成员对该会话的个人设置，只影响本人的会话列表与推送。
+// InviteLinkID 为通过邀请链接入群时所用链接。
+// SpeakMutedUntil 为群主/管理员对该成员设置的禁言截止时间，nil 表示未禁言。
+// Nickname 为成员在该群内的昵称，对其他成员可见，为空时显示用户资料昵称。
 type ConversationMember struct {
-	ID             string    `gorm:"column:id;type:uuid;primaryKey"`
-	ConversationID string    `gorm:"column:conversation_id;type:varchar(36);not null;uniqueIndex:uq_conv_member"`
-	UserID         string    `gorm:"column:user_id;type:char(10);not null;uniqueIndex:uq_conv_member"`
-	Role           string    `gorm:"column:role;type:text;not null;default:member"`
-	Status         string    `gorm:"column:status;type:text;not null;default:active"`
-	JoinedAt       time.Time `gorm:"column:joined_at;type:timestamptz;not null"`
+	ID              string     `gorm:"column:id;type:uuid;primaryKey"`
+	ConversationID  string     `gorm:"column:conversation_id;type:varchar(36);not null;uniqueIndex:uq_conv_member"`
+	UserID          string     `gorm:"column:user_id;type:char(10);not null;uniqueIndex:uq_conv_member"`
+	Role            string     `gorm:"column:role;type:text;not null;default:member"`
+	Status          string     `gorm:"column:status;type:text;not null;default:active"`
+	JoinedAt        time.Time  `gorm:"column:joined_at;type:timestamptz;not null"`
+	MutedUntil      *time.Time `gorm:"column:muted_until;type:timestamptz"`
+	PinnedAt        *time.Time `gorm:"column:pinned_at;type:timestamptz"`
+	Archived        bool       `gorm:"column:archived;not null;default:false"`
+	Hidden          bool       `gorm:"column:hidden;not null;default:false"`
+	Alias           string     `gorm:"column:alias;type:text;not null;default:''"`
+	InviteLinkID    *string    `gorm:"column:invite_link_id;type:uuid"`
+	SpeakMutedUntil *time.Time `gorm:"column:speak_muted_until;type:timestamptz"`
+	Nickname        string     `gorm:"column:nickname;type:text;not null;default:''"`
+	UpdatedAt       time.Time  `gorm:"column:updated_at;type:timestamptz;not null"`
 }
 
 func (ConversationMember) TableName() string {
 	return "conversation_members"
 }
 
+// ConversationAnnouncement 对应 conversation_announcements 表，记录每次群公告修改
+type ConversationAnnouncement struct {
+	ID             string    `gorm:"column:id;type:uuid;primaryKey"`
+	ConversationID string    `gorm:"column:conversation_id;type:varchar(36);not null"`
+	Content        string    `gorm:"column:content;type:text;not null;default:''"`
+	AuthorID       string    `gorm:"column:author_id;type:varchar(10);not null"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+}
+
+func (ConversationAnnouncement) TableName() string {
+	return "conversation_announcements"
+}
+
+// ConversationAuditLog 对应 conversation_audit_logs 表，记录解散、封禁、解封等群管理操作
+type ConversationAuditLog struct {
+	ID             string    `gorm:"column:id;type:uuid;primaryKey"`
+	ConversationID string    `gorm:"column:conversation_id;type:varchar(36);not null"`
+	OperatorID     string    `gorm:"column:operator_id;type:varchar(10);not null"`
+	Action         string    `gorm:"column:action;type:text;not null"`
+	TargetUserID   string    `gorm:"column:target_user_id;type:varchar(10);not null;default:''"`
+	Detail         string    `gorm:"column:detail;type:text;not null;default:''"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+}
+
+func (ConversationAuditLog) TableName() string {
+	return "conversation_audit_logs"
+}
+
 type ConversationModel struct {
 	db *gorm.DB
 }
@@ -71,30 +160,362 @@ func (m *ConversationModel) UpdateLastActiveAt(id string, t time.Time) error {
 	return m.db.Model(&Conversation{}).Where("id = ?", id).Update("last_active_at", t).Error
 }
 
+// UpdateMessageTTL 设置会话消息自动销毁时长，仅影响之后写入的消息
+func (m *ConversationModel) UpdateMessageTTL(id string, ttlSeconds int32) error {
+	return m.db.Model(&Conversation{}).Where("id = ?", id).Update("message_ttl_seconds", ttlSeconds).Error
+}
+
+// UpdateProfile 更新群资料字段；announcement 非 nil 时在同一事务中写入公告历史
+func (m *ConversationModel) UpdateProfile(id string, updates map[string]interface{}, announcement *ConversationAnnouncement) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&Conversation{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+			return err
+		}
+		if announcement == nil {
+			return nil
+		}
+		return tx.Create(announcement).Error
+	})
+}
+
+// Dissolve 将群标记为已解散并写入审计日志；已解散时返回 gorm.ErrRecordNotFound
+func (m *ConversationModel) Dissolve(id string, now time.Time, audit *ConversationAuditLog) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Conversation{}).
+			Where("id = ? AND status <> ?", id, ConversationStatusDissolved).
+			Updates(map[string]interface{}{"status": ConversationStatusDissolved, "dissolved_at": now})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(audit).Error
+	})
+}
+
+// BanMember 将用户在群内的状态设为 banned（不是成员时新建一条 banned 记录）并写入审计日志
+func (m *ConversationModel) BanMember(member *ConversationMember, audit *ConversationAuditLog) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "conversation_id"}, {Name: "user_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"status": MemberStatusBanned, "role": "member"}),
+		}).Create(member).Error; err != nil {
+			return err
+		}
+		return tx.Create(audit).Error
+	})
+}
+
+// SetMuteAll 开启/关闭全员禁言并写入审计日志；会话不存在时返回 gorm.ErrRecordNotFound
+func (m *ConversationModel) SetMuteAll(conversationID string, muteAll bool, audit *ConversationAuditLog) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Conversation{}).Where("id = ?", conversationID).Update("mute_all", muteAll)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(audit).Error
+	})
+}
+
+// SetSpeakMutedUntil 设置 active 成员的禁言截止时间（nil 为解除禁言）并写入审计日志；成员不存在或不在群内时返回 gorm.ErrRecordNotFound
+func (m *ConversationModel) SetSpeakMutedUntil(conversationID, userID string, until *time.Time, audit *ConversationAuditLog) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&ConversationMember{}).
+			Where("conversation_id = ? AND user_id = ? AND status = ?", conversationID, userID, MemberStatusActive).
+			Update("speak_muted_until", until)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(audit).Error
+	})
+}
+
+// UnbanMember 解除封禁，成员状态恢复为 left（可重新加入）并写入审计日志；未被封禁时返回 gorm.ErrRecordNotFound
+func (m *ConversationModel) UnbanMember(conversationID, userID string, audit *ConversationAuditLog) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&ConversationMember{}).
+			Where("conversation_id = ? AND user_id = ? AND status = ?", conversationID, userID, MemberStatusBanned).
+			Update("status", MemberStatusLeft)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(audit).Error
+	})
+}
+
+// ListBanned 列出群内被封禁的用户
+func (m *ConversationModel) ListBanned(conversationID string) ([]*ConversationMember, error) {
+	var list []*ConversationMember
+	err := m.db.Where("conversation_id = ? AND status = ?", conversationID, MemberStatusBanned).Order("joined_at ASC").Find(&list).Error
+	return list, err
+}
+
 func (m *ConversationModel) CountMembers(conversationID string) (int64, error) {
 	var n int64
 	err := m.db.Model(&ConversationMember{}).Where("conversation_id = ? AND status = ?", conversationID, "active").Count(&n).Error
 	return n, err
 }
 
-func (m *ConversationModel) ListByUserID(userID string, offset, limit int) ([]*Conversation, error) {
+// ConversationListCursor 会话列表游标，指向上一页最后一条。Pinned 为 true 时位于置顶段，At 为置顶时间；
+// 否则位于非置顶段，At 为 last_active_at
+type ConversationListCursor struct {
+	Pinned bool
+	At     time.Time
+	ID     string
+}
+
+// ListByUserID 按用户个人设置列出会话：archived 决定列出归档或未归档会话，includeHidden 为 false 时排除已隐藏会话；
+// 置顶会话按 (pinned_at, id) 倒序排在最前，其余按 (last_active_at, id) 倒序。after 为 nil 时从头开始，
+// 否则从游标之后继续，新消息导致的重新排序不会造成跳过或重复
+func (m *ConversationModel) ListByUserID(userID string, archived, includeHidden bool, after *ConversationListCursor, limit int) ([]*Conversation, error) {
+	base := func() *gorm.DB {
+		q := m.db.Table("conversations").Select("conversations.*").
+			Joins("INNER JOIN conversation_members ON conversation_members.conversation_id = conversations.id AND conversation_members.user_id = ? AND conversation_members.status = ?", userID, "active").
+			Where("conversation_members.archived = ? AND conversations.status <> ?", archived, ConversationStatusDissolved)
+		if !includeHidden {
+			q = q.Where("conversation_members.hidden = ?", false)
+		}
+		return q
+	}
 	var list []*Conversation
+	if after == nil || after.Pinned {
+		q := base().Where("conversation_members.pinned_at IS NOT NULL")
+		if after != nil {
+			q = q.Where("(conversation_members.pinned_at, conversations.id) < (?, ?)", after.At, after.ID)
+		}
+		if err := q.Order("conversation_members.pinned_at DESC, conversations.id DESC").Limit(limit).Find(&list).Error; err != nil {
+			return nil, err
+		}
+		if len(list) >= limit {
+			return list, nil
+		}
+		after = nil
+	}
+	var rest []*Conversation
+	q := base().Where("conversation_members.pinned_at IS NULL")
+	if after != nil {
+		q = q.Where("(conversations.last_active_at, conversations.id) < (?, ?)", after.At, after.ID)
+	}
+	if err := q.Order("conversations.last_active_at DESC, conversations.id DESC").Limit(limit - len(list)).Find(&rest).Error; err != nil {
+		return nil, err
+	}
+	return append(list, rest...), nil
+}
+
+// ChangedConversation 增量同步结果：会话及请求用户在其中的成员状态，ChangedAt 为会话最近一次变化时间
+type ChangedConversation struct {
+	Conversation `gorm:"embedded"`
+	MemberStatus string    `gorm:"column:member_status"`
+	ChangedAt    time.Time `gorm:"column:changed_at"`
+}
+
+// changedAtSQL 会话对某成员的变化时间：新消息、会话资料/状态变化、成员记录变化中最晚的一个
+const changedAtSQL = "GREATEST(conversations.last_active_at, conversations.updated_at, conversation_members.updated_at)"
+
+// ListChangedSince 列出用户所在（含已退出、被封禁）会话中 (changed_at, id) 晚于游标的会话，按变化时间正序
+func (m *ConversationModel) ListChangedSince(userID string, since time.Time, afterID string, limit int) ([]*ChangedConversation, error) {
+	var list []*ChangedConversation
 	err := m.db.Table("conversations").
-		Joins("INNER JOIN conversation_members ON conversation_members.conversation_id = conversations.id AND conversation_members.user_id = ? AND conversation_members.status = ?", userID, "active").
-		Order("conversations.last_active_at DESC, conversations.id DESC").
-		Offset(offset).Limit(limit).
+		Select("conversations.*, conversation_members.status AS member_status, "+changedAtSQL+" AS changed_at").
+		Joins("INNER JOIN conversation_members ON conversation_members.conversation_id = conversations.id AND conversation_members.user_id = ?", userID).
+		Where("("+changedAtSQL+", conversations.id) > (?, ?)", since, afterID).
+		Order("changed_at ASC, conversations.id ASC").
+		Limit(limit).
 		Find(&list).Error
 	return list, err
 }
 
+// ListMemberships 返回用户在给定会话中的成员记录，key 为 conversation_id
+func (m *ConversationModel) ListMemberships(userID string, conversationIDs []string) (map[string]*ConversationMember, error) {
+	if len(conversationIDs) == 0 {
+		return nil, nil
+	}
+	var list []*ConversationMember
+	err := m.db.Where("user_id = ? AND conversation_id IN ?", userID, conversationIDs).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]*ConversationMember, len(list))
+	for _, mem := range list {
+		out[mem.ConversationID] = mem
+	}
+	return out, nil
+}
+
+// UpdateMemberSettings 更新成员个人设置，updates 的 key 为列名
+func (m *ConversationModel) UpdateMemberSettings(conversationID, userID string, updates map[string]interface{}) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	return m.db.Model(&ConversationMember{}).
+		Where("conversation_id = ? AND user_id = ?", conversationID, userID).
+		Updates(updates).Error
+}
+
 func (m *ConversationModel) ListByUserIDCount(userID string) (int64, error) {
 	var n int64
 	err := m.db.Model(&ConversationMember{}).Where("user_id = ? AND status = ?", userID, "active").Count(&n).Error
 	return n, err
 }
 
+// AddMember 添加成员；若该用户曾离开（status=left）则重新激活并更新角色与加入时间。
+// 已是 active 成员时不做修改；已被封禁（upsert 未生效）时返回 ErrMemberBanned
 func (m *ConversationModel) AddMember(member *ConversationMember) error {
-	return m.db.Create(member).Error
+	res := m.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "conversation_id"}, {Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"status":         "active",
+			"role":           member.Role,
+			"joined_at":      member.JoinedAt,
+			"invite_link_id": member.InviteLinkID,
+		}),
+		Where: clause.Where{Exprs: []clause.Expression{clause.Eq{Column: "conversation_members.status", Value: "left"}}},
+	}).Create(member)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected > 0 {
+		return nil
+	}
+	var existing ConversationMember
+	if err := m.db.Select("status").Where("conversation_id = ? AND user_id = ?", member.ConversationID, member.UserID).First(&existing).Error; err != nil {
+		return err
+	}
+	if existing.Status != MemberStatusActive {
+		return ErrMemberBanned
+	}
+	return nil
+}
+
+// AddMembersWithinLimit 在一个事务内锁定会话行，确认加入后 active 成员数不超过 capacity(会话) 再写入成员，
+// 超出时返回 ErrGroupFull 且不写入；capacity 返回 0 表示不限。已是 active 的成员不计入新增，并发加入按会话串行化
+func (m *ConversationModel) AddMembersWithinLimit(conversationID string, members []*ConversationMember, capacity func(c *Conversation) int) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		var c Conversation
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", conversationID).First(&c).Error; err != nil {
+			return err
+		}
+		if limit := capacity(&c); limit > 0 {
+			userIDs := make([]string, 0, len(members))
+			for _, mem := range members {
+				userIDs = append(userIDs, mem.UserID)
+			}
+			var active, already int64
+			if err := tx.Model(&ConversationMember{}).Where("conversation_id = ? AND status = ?", conversationID, MemberStatusActive).Count(&active).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&ConversationMember{}).Where("conversation_id = ? AND status = ? AND user_id IN ?", conversationID, MemberStatusActive, userIDs).Count(&already).Error; err != nil {
+				return err
+			}
+			if active-already+int64(len(members)) > int64(limit) {
+				return ErrGroupFull
+			}
+		}
+		txModel := NewConversationModel(tx)
+		for _, mem := range members {
+			if err := txModel.AddMember(mem); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateCapacity 在一个事务内锁定会话行，修改人数档位与覆盖上限（updates 的 key 为列名），与 AddMembersWithinLimit 串行。
+// capacity 接收加锁读出的会话并返回修改后的上限（0 表示不限）；上限小于当前 active 成员数时返回 ErrBelowMemberCount 且不写入。
+// 返回修改后的上限与当前 active 成员数
+func (m *ConversationModel) UpdateCapacity(id string, updates map[string]interface{}, capacity func(c *Conversation) int) (int, int64, error) {
+	var limit int
+	var count int64
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		var c Conversation
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", id).First(&c).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&ConversationMember{}).Where("conversation_id = ? AND status = ?", id, MemberStatusActive).Count(&count).Error; err != nil {
+			return err
+		}
+		limit = capacity(&c)
+		if limit > 0 && count > int64(limit) {
+			return ErrBelowMemberCount
+		}
+		return tx.Model(&Conversation{}).Where("id = ?", id).Updates(updates).Error
+	})
+	return limit, count, err
+}
+
+// UpdateMemberRole 由群主 ownerID 修改成员角色，群主行加锁，与 TransferOwnership 串行。
+// ownerID 已不是 active 群主时返回 ErrNotOwner；目标不是 active 成员或已成为群主时返回 ErrMemberNotActive。
+func (m *ConversationModel) UpdateMemberRole(conversationID, ownerID, userID, role string) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		var owner ConversationMember
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("conversation_id = ? AND user_id = ? AND role = ? AND status = ?", conversationID, ownerID, "owner", MemberStatusActive).
+			First(&owner).Error
+		if err == gorm.ErrRecordNotFound {
+			return ErrNotOwner
+		}
+		if err != nil {
+			return err
+		}
+		res := tx.Model(&ConversationMember{}).
+			Where("conversation_id = ? AND user_id = ? AND status = ? AND role <> ?", conversationID, userID, MemberStatusActive, "owner").
+			Update("role", role)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrMemberNotActive
+		}
+		return nil
+	})
+}
+
+// TransferOwnership 在同一事务中将 newOwnerID 设为群主、原群主 oldOwnerID 降为管理员。
+// 先锁定原群主行并确认其仍为 active 群主，否则返回 ErrNotOwner；newOwnerID 不是 active 成员时返回 ErrMemberNotActive。
+// 并发的转让或角色变更会在行锁上串行，保证始终只有一个群主。
+func (m *ConversationModel) TransferOwnership(conversationID, oldOwnerID, newOwnerID string) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		var owner ConversationMember
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("conversation_id = ? AND user_id = ? AND role = ? AND status = ?", conversationID, oldOwnerID, "owner", MemberStatusActive).
+			First(&owner).Error
+		if err == gorm.ErrRecordNotFound {
+			return ErrNotOwner
+		}
+		if err != nil {
+			return err
+		}
+		res := tx.Model(&ConversationMember{}).
+			Where("conversation_id = ? AND user_id = ? AND status = ?", conversationID, newOwnerID, MemberStatusActive).
+			Update("role", "owner")
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrMemberNotActive
+		}
+		return tx.Model(&ConversationMember{}).
+			Where("conversation_id = ? AND user_id = ?", conversationID, oldOwnerID).
+			Update("role", "admin").Error
+	})
+}
+
+// ListAnnouncements 列出群公告历史，按发布时间倒序
+func (m *ConversationModel) ListAnnouncements(conversationID string, limit int) ([]*ConversationAnnouncement, error) {
+	var list []*ConversationAnnouncement
+	err := m.db.Where("conversation_id = ?", conversationID).Order("created_at DESC").Limit(limit).Find(&list).Error
+	return list, err
 }
 
 func (m *ConversationModel) GetMember(conversationID, userID string) (*ConversationMember, error) {
@@ -111,9 +532,175 @@ func (m *ConversationModel) RemoveMember(conversationID, userID string) error {
 		Update("status", "left").Error
 }
 
-func (m *ConversationModel) ListMembers(conversationID string) ([]*ConversationMember, error) {
+// ErrOwnerMustTransfer 群主退群时群内还有其他成员，须先转让群主
+var ErrOwnerMustTransfer = errors.New("owner must transfer ownership before leaving")
+
+// LeaveGroup 成员主动退群，返回群是否因此解散。会话行与成员行依次加锁，与加人、转让串行：
+// 群主退群时群内还有其他 active 成员返回 ErrOwnerMustTransfer；群主是最后一名成员时在同一事务内解散群并写入 dissolveAudit。
+// 用户不是 active 成员时返回 ErrMemberNotActive
+func (m *ConversationModel) LeaveGroup(conversationID, userID string, now time.Time, dissolveAudit *ConversationAuditLog) (bool, error) {
+	dissolved := false
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		var c Conversation
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", conversationID).First(&c).Error; err != nil {
+			return err
+		}
+		var member ConversationMember
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("conversation_id = ? AND user_id = ? AND status = ?", conversationID, userID, MemberStatusActive).
+			First(&member).Error
+		if err == gorm.ErrRecordNotFound {
+			return ErrMemberNotActive
+		}
+		if err != nil {
+			return err
+		}
+		if member.Role == "owner" {
+			var others int64
+			if err := tx.Model(&ConversationMember{}).
+				Where("conversation_id = ? AND user_id <> ? AND status = ?", conversationID, userID, MemberStatusActive).
+				Count(&others).Error; err != nil {
+				return err
+			}
+			if others > 0 {
+				return ErrOwnerMustTransfer
+			}
+			if c.Status != ConversationStatusDissolved {
+				if err := tx.Model(&Conversation{}).Where("id = ?", conversationID).
+					Updates(map[string]interface{}{"status": ConversationStatusDissolved, "dissolved_at": now}).Error; err != nil {
+					return err
+				}
+				if err := tx.Create(dissolveAudit).Error; err != nil {
+					return err
+				}
+				dissolved = true
+			}
+		}
+		return tx.Model(&ConversationMember{}).Where("id = ?", member.ID).Update("status", "left").Error
+	})
+	return dissolved, err
+}
+
+// ListActiveConversationIDs 返回用户作为 active 成员所在的全部会话 ID
+func (m *ConversationModel) ListActiveConversationIDs(userID string) ([]string, error) {
+	var ids []string
+	err := m.db.Model(&ConversationMember{}).
+		Where("user_id = ? AND status = ?", userID, MemberStatusActive).
+		Order("conversation_id").
+		Pluck("conversation_id", &ids).Error
+	return ids, err
+}
+
+// PurgedMembership 账号清除时退出单个会话的结果。
+// NewOwnerID 非空表示群主身份已转让给该成员；Dissolved 表示用户是最后一名成员，群已解散
+type PurgedMembership struct {
+	Type       string
+	NewOwnerID string
+	Dissolved  bool
+}
+
+// RemovePurgedMember 在一个事务内让被清除的账号退出会话并清空其个人设置，同时写入审计日志。
+// 用户是群主时转让给管理员优先、入群最早的 active 成员；没有其他成员时解散群（dissolveAudit 一并写入）。
+// 成员行加锁，与转让、角色变更串行；用户已不是 active 成员时返回 gorm.ErrRecordNotFound
+func (m *ConversationModel) RemovePurgedMember(conversationID, userID string, now time.Time, audit, dissolveAudit *ConversationAuditLog) (*PurgedMembership, error) {
+	var out PurgedMembership
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		var member ConversationMember
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("conversation_id = ? AND user_id = ? AND status = ?", conversationID, userID, MemberStatusActive).
+			First(&member).Error; err != nil {
+			return err
+		}
+		var conv Conversation
+		if err := tx.Select("id", "type").Where("id = ?", conversationID).First(&conv).Error; err != nil {
+			return err
+		}
+		out.Type = conv.Type
+		if member.Role == "owner" {
+			var next ConversationMember
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("conversation_id = ? AND user_id <> ? AND status = ?", conversationID, userID, MemberStatusActive).
+				Order("(role = 'admin') DESC, joined_at, user_id").
+				First(&next).Error
+			switch {
+			case err == nil:
+				if err := tx.Model(&ConversationMember{}).Where("id = ?", next.ID).Update("role", "owner").Error; err != nil {
+					return err
+				}
+				out.NewOwnerID = next.UserID
+				audit.Detail = next.UserID
+			case err == gorm.ErrRecordNotFound:
+				res := tx.Model(&Conversation{}).
+					Where("id = ? AND status <> ?", conversationID, ConversationStatusDissolved).
+					Updates(map[string]interface{}{"status": ConversationStatusDissolved, "dissolved_at": now})
+				if res.Error != nil {
+					return res.Error
+				}
+				if res.RowsAffected > 0 {
+					out.Dissolved = true
+					if err := tx.Create(dissolveAudit).Error; err != nil {
+						return err
+					}
+				}
+			default:
+				return err
+			}
+		}
+		err := tx.Model(&ConversationMember{}).Where("id = ?", member.ID).Updates(map[string]interface{}{
+			"status": "left", "role": "member", "nickname": "", "alias": "",
+			"muted_until": nil, "pinned_at": nil, "archived": false, "hidden": false,
+		}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(audit).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// MemberFilter 成员列表过滤条件，零值字段不参与过滤
+type MemberFilter struct {
+	Query    string // 群昵称或用户 ID 模糊匹配
+	Roles    []string
+	Statuses []string
+}
+
+// MemberListCursor 成员列表游标，按 (joined_at, user_id) 正序翻页
+type MemberListCursor struct {
+	JoinedAt time.Time
+	UserID   string
+}
+
+// ListMembers 按入群时间正序分页返回满足 f 的成员，after 为上一页最后一条的游标
+func (m *ConversationModel) ListMembers(conversationID string, f MemberFilter, after *MemberListCursor, limit int) ([]*ConversationMember, error) {
+	q := m.db.Where("conversation_id = ?", conversationID)
+	if f.Query != "" {
+		pattern := "%" + escapeLike(f.Query) + "%"
+		q = q.Where("(nickname ILIKE ? OR user_id LIKE ?)", pattern, pattern)
+	}
+	if len(f.Roles) > 0 {
+		q = q.Where("role IN ?", f.Roles)
+	}
+	if len(f.Statuses) > 0 {
+		q = q.Where("status IN ?", f.Statuses)
+	}
+	if after != nil {
+		q = q.Where("(joined_at, user_id) > (?, ?)", after.JoinedAt, after.UserID)
+	}
+	var list []*ConversationMember
+	err := q.Order("joined_at ASC, user_id ASC").Limit(limit).Find(&list).Error
+	return list, err
+}
+
+// ListActiveMemberIDs 按 user_id 正序返回 afterUserID 之后的 active 成员，仅查询 user_id 与 muted_until，供推送 fan-out 使用
+func (m *ConversationModel) ListActiveMemberIDs(conversationID, afterUserID string, limit int) ([]*ConversationMember, error) {
 	var list []*ConversationMember
-	err := m.db.Where("conversation_id = ?", conversationID).Order("joined_at ASC").Find(&list).Error
+	err := m.db.Select("user_id", "muted_until").
+		Where("conversation_id = ? AND status = ? AND user_id > ?", conversationID, MemberStatusActive, afterUserID).
+		Order("user_id ASC").Limit(limit).Find(&list).Error
 	return list, err
 }
 
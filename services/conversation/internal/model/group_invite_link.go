@@ -0,0 +1,108 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// GroupInviteLink 对应 group_invite_links 表。MaxUses 为 0 表示不限次数，ExpiresAt 为 nil 表示永不过期；
+// BypassApproval 为 true 时通过该链接可直接入群，否则提交入群申请。
+type GroupInviteLink struct {
+	ID             string     `gorm:"column:id;type:uuid;primaryKey"`
+	ConversationID string     `gorm:"column:conversation_id;type:varchar(36);not null;index"`
+	Token          string     `gorm:"column:token;type:text;not null;uniqueIndex"`
+	CreatedBy      string     `gorm:"column:created_by;type:varchar(10);not null"`
+	ExpiresAt      *time.Time `gorm:"column:expires_at;type:timestamptz"`
+	MaxUses        int32      `gorm:"column:max_uses;not null;default:0"`
+	UseCount       int32      `gorm:"column:use_count;not null;default:0"`
+	BypassApproval bool       `gorm:"column:bypass_approval;not null;default:false"`
+	RevokedAt      *time.Time `gorm:"column:revoked_at;type:timestamptz"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamptz;not null"`
+}
+
+func (GroupInviteLink) TableName() string {
+	return "group_invite_links"
+}
+
+// Active 判断链接在 now 时刻是否仍可使用（未撤销、未过期、未用完）
+func (l *GroupInviteLink) Active(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	if l.ExpiresAt != nil && !l.ExpiresAt.After(now) {
+		return false
+	}
+	return l.MaxUses == 0 || l.UseCount < l.MaxUses
+}
+
+type GroupInviteLinkModel struct {
+	db *gorm.DB
+}
+
+func NewGroupInviteLinkModel(db *gorm.DB) *GroupInviteLinkModel {
+	return &GroupInviteLinkModel{db: db}
+}
+
+func (m *GroupInviteLinkModel) Create(link *GroupInviteLink) error {
+	return m.db.Create(link).Error
+}
+
+func (m *GroupInviteLinkModel) FindByToken(token string) (*GroupInviteLink, error) {
+	var link GroupInviteLink
+	if err := m.db.Where("token = ?", token).First(&link).Error; err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// ListByConversation 列出群的邀请链接，按创建时间倒序；activeOnly 为 true 时只返回仍可使用的链接
+func (m *GroupInviteLinkModel) ListByConversation(conversationID string, activeOnly bool, now time.Time) ([]*GroupInviteLink, error) {
+	q := m.db.Where("conversation_id = ?", conversationID)
+	if activeOnly {
+		q = q.Where(activeLinkSQL, now)
+	}
+	var list []*GroupInviteLink
+	err := q.Order("created_at DESC").Find(&list).Error
+	return list, err
+}
+
+// Revoke 撤销群内一条尚未撤销的链接；不存在或已撤销时返回 gorm.ErrRecordNotFound
+func (m *GroupInviteLinkModel) Revoke(conversationID, linkID string, now time.Time) error {
+	res := m.db.Model(&GroupInviteLink{}).
+		Where("id = ? AND conversation_id = ? AND revoked_at IS NULL", linkID, conversationID).
+		Update("revoked_at", now)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Redeem 在一个事务内占用链接的一次使用次数并调用 join 完成入群或提交申请。
+// 次数占用为带条件的原子 UPDATE，并发使用时不会超出 max_uses；链接已失效时返回 gorm.ErrRecordNotFound，join 返回错误时整体回滚。
+func (m *GroupInviteLinkModel) Redeem(token string, now time.Time, join func(tx *gorm.DB, link *GroupInviteLink) error) (*GroupInviteLink, error) {
+	var link GroupInviteLink
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Raw(`
+			UPDATE group_invite_links SET use_count = use_count + 1
+			WHERE token = ? AND `+activeLinkSQL+`
+			RETURNING *
+		`, token, now).Scan(&link)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return join(tx, &link)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+const activeLinkSQL = `revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?) AND (max_uses = 0 OR use_count < max_uses)`
@@ -17,6 +17,7 @@ type GroupJoinRequest struct {
 	ProcessedAt    *time.Time `gorm:"column:processed_at;type:timestamptz"`
 	ProcessedBy    string    `gorm:"column:processed_by;type:varchar(10)"`
 	CreatedAt      time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	InviteLinkID   *string   `gorm:"column:invite_link_id;type:uuid"`
 }
 
 func (GroupJoinRequest) TableName() string {
@@ -31,7 +32,8 @@ func NewGroupJoinRequestModel(db *gorm.DB) *GroupJoinRequestModel {
 	return &GroupJoinRequestModel{db: db}
 }
 
-func (m *GroupJoinRequestModel) Apply(conversationID, userID, message string) (*GroupJoinRequest, error) {
+// Apply 提交入群申请；inviteLinkID 非 nil 时记录申请所用邀请链接
+func (m *GroupJoinRequestModel) Apply(conversationID, userID, message string, inviteLinkID *string) (*GroupJoinRequest, error) {
 	var existing GroupJoinRequest
 	err := m.db.Where("conversation_id = ? AND user_id = ?", conversationID, userID).First(&existing).Error
 	if err == nil {
@@ -45,6 +47,7 @@ func (m *GroupJoinRequestModel) Apply(conversationID, userID, message string) (*
 		existing.Message = message
 		existing.ProcessedAt = nil
 		existing.ProcessedBy = ""
+		existing.InviteLinkID = inviteLinkID
 		if err := m.db.Save(&existing).Error; err != nil {
 			return nil, err
 		}
@@ -60,6 +63,7 @@ func (m *GroupJoinRequestModel) Apply(conversationID, userID, message string) (*
 		Message:        message,
 		Status:         "pending",
 		CreatedAt:      time.Now(),
+		InviteLinkID:   inviteLinkID,
 	}
 	if err := m.db.Create(req).Error; err != nil {
 		return nil, err
@@ -104,3 +108,8 @@ func (m *GroupJoinRequestModel) Decline(requestID, conversationID, processedBy s
 	}
 	return nil
 }
+
+// DeleteByUser 删除用户的全部入群申请（账号清除时使用）
+func (m *GroupJoinRequestModel) DeleteByUser(userID string) error {
+	return m.db.Where("user_id = ?", userID).Delete(&GroupJoinRequest{}).Error
+}
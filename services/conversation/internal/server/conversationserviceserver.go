@@ -77,3 +77,143 @@ func (s *ConversationServiceServer) DeclineJoinRequest(ctx context.Context, in *
 	l := logic.NewDeclineJoinRequestLogic(ctx, s.svcCtx)
 	return l.DeclineJoinRequest(in)
 }
+
+func (s *ConversationServiceServer) SetMessageTTL(ctx context.Context, in *pb.SetMessageTTLRequest) (*pb.SetMessageTTLResponse, error) {
+	l := logic.NewSetMessageTTLLogic(ctx, s.svcCtx)
+	return l.SetMessageTTL(in)
+}
+
+func (s *ConversationServiceServer) UpdateMemberSettings(ctx context.Context, in *pb.UpdateMemberSettingsRequest) (*pb.UpdateMemberSettingsResponse, error) {
+	l := logic.NewUpdateMemberSettingsLogic(ctx, s.svcCtx)
+	return l.UpdateMemberSettings(in)
+}
+
+func (s *ConversationServiceServer) SetMemberRole(ctx context.Context, in *pb.SetMemberRoleRequest) (*pb.SetMemberRoleResponse, error) {
+	l := logic.NewSetMemberRoleLogic(ctx, s.svcCtx)
+	return l.SetMemberRole(in)
+}
+
+func (s *ConversationServiceServer) TransferOwnership(ctx context.Context, in *pb.TransferOwnershipRequest) (*pb.TransferOwnershipResponse, error) {
+	l := logic.NewTransferOwnershipLogic(ctx, s.svcCtx)
+	return l.TransferOwnership(in)
+}
+
+func (s *ConversationServiceServer) LeaveConversation(ctx context.Context, in *pb.LeaveConversationRequest) (*pb.LeaveConversationResponse, error) {
+	l := logic.NewLeaveConversationLogic(ctx, s.svcCtx)
+	return l.LeaveConversation(in)
+}
+
+func (s *ConversationServiceServer) UpdateConversation(ctx context.Context, in *pb.UpdateConversationRequest) (*pb.UpdateConversationResponse, error) {
+	l := logic.NewUpdateConversationLogic(ctx, s.svcCtx)
+	return l.UpdateConversation(in)
+}
+
+func (s *ConversationServiceServer) ListAnnouncements(ctx context.Context, in *pb.ListAnnouncementsRequest) (*pb.ListAnnouncementsResponse, error) {
+	l := logic.NewListAnnouncementsLogic(ctx, s.svcCtx)
+	return l.ListAnnouncements(in)
+}
+
+func (s *ConversationServiceServer) CreateInviteLink(ctx context.Context, in *pb.CreateInviteLinkRequest) (*pb.CreateInviteLinkResponse, error) {
+	l := logic.NewCreateInviteLinkLogic(ctx, s.svcCtx)
+	return l.CreateInviteLink(in)
+}
+
+func (s *ConversationServiceServer) RevokeInviteLink(ctx context.Context, in *pb.RevokeInviteLinkRequest) (*pb.RevokeInviteLinkResponse, error) {
+	l := logic.NewRevokeInviteLinkLogic(ctx, s.svcCtx)
+	return l.RevokeInviteLink(in)
+}
+
+func (s *ConversationServiceServer) ListInviteLinks(ctx context.Context, in *pb.ListInviteLinksRequest) (*pb.ListInviteLinksResponse, error) {
+	l := logic.NewListInviteLinksLogic(ctx, s.svcCtx)
+	return l.ListInviteLinks(in)
+}
+
+func (s *ConversationServiceServer) PreviewInviteLink(ctx context.Context, in *pb.PreviewInviteLinkRequest) (*pb.PreviewInviteLinkResponse, error) {
+	l := logic.NewPreviewInviteLinkLogic(ctx, s.svcCtx)
+	return l.PreviewInviteLink(in)
+}
+
+func (s *ConversationServiceServer) JoinByInvite(ctx context.Context, in *pb.JoinByInviteRequest) (*pb.JoinByInviteResponse, error) {
+	l := logic.NewJoinByInviteLogic(ctx, s.svcCtx)
+	return l.JoinByInvite(in)
+}
+
+func (s *ConversationServiceServer) DissolveConversation(ctx context.Context, in *pb.DissolveConversationRequest) (*pb.DissolveConversationResponse, error) {
+	l := logic.NewDissolveConversationLogic(ctx, s.svcCtx)
+	return l.DissolveConversation(in)
+}
+
+func (s *ConversationServiceServer) BanMember(ctx context.Context, in *pb.BanMemberRequest) (*pb.BanMemberResponse, error) {
+	l := logic.NewBanMemberLogic(ctx, s.svcCtx)
+	return l.BanMember(in)
+}
+
+func (s *ConversationServiceServer) UnbanMember(ctx context.Context, in *pb.UnbanMemberRequest) (*pb.UnbanMemberResponse, error) {
+	l := logic.NewUnbanMemberLogic(ctx, s.svcCtx)
+	return l.UnbanMember(in)
+}
+
+func (s *ConversationServiceServer) ListBannedMembers(ctx context.Context, in *pb.ListBannedMembersRequest) (*pb.ListBannedMembersResponse, error) {
+	l := logic.NewListBannedMembersLogic(ctx, s.svcCtx)
+	return l.ListBannedMembers(in)
+}
+
+func (s *ConversationServiceServer) SetMuteAll(ctx context.Context, in *pb.SetMuteAllRequest) (*pb.SetMuteAllResponse, error) {
+	l := logic.NewSetMuteAllLogic(ctx, s.svcCtx)
+	return l.SetMuteAll(in)
+}
+
+func (s *ConversationServiceServer) MuteMember(ctx context.Context, in *pb.MuteMemberRequest) (*pb.MuteMemberResponse, error) {
+	l := logic.NewMuteMemberLogic(ctx, s.svcCtx)
+	return l.MuteMember(in)
+}
+
+func (s *ConversationServiceServer) UnmuteMember(ctx context.Context, in *pb.UnmuteMemberRequest) (*pb.UnmuteMemberResponse, error) {
+	l := logic.NewUnmuteMemberLogic(ctx, s.svcCtx)
+	return l.UnmuteMember(in)
+}
+
+func (s *ConversationServiceServer) SubscribeChannel(ctx context.Context, in *pb.SubscribeChannelRequest) (*pb.SubscribeChannelResponse, error) {
+	l := logic.NewSubscribeChannelLogic(ctx, s.svcCtx)
+	return l.SubscribeChannel(in)
+}
+
+func (s *ConversationServiceServer) UnsubscribeChannel(ctx context.Context, in *pb.UnsubscribeChannelRequest) (*pb.UnsubscribeChannelResponse, error) {
+	l := logic.NewUnsubscribeChannelLogic(ctx, s.svcCtx)
+	return l.UnsubscribeChannel(in)
+}
+
+func (s *ConversationServiceServer) ListSubscribedChannels(ctx context.Context, in *pb.ListSubscribedChannelsRequest) (*pb.ListChannelsResponse, error) {
+	l := logic.NewListSubscribedChannelsLogic(ctx, s.svcCtx)
+	return l.ListSubscribedChannels(in)
+}
+
+func (s *ConversationServiceServer) ListPublicChannels(ctx context.Context, in *pb.ListPublicChannelsRequest) (*pb.ListChannelsResponse, error) {
+	l := logic.NewListPublicChannelsLogic(ctx, s.svcCtx)
+	return l.ListPublicChannels(in)
+}
+
+func (s *ConversationServiceServer) FilterChannelSubscribers(ctx context.Context, in *pb.FilterChannelSubscribersRequest) (*pb.FilterChannelSubscribersResponse, error) {
+	l := logic.NewFilterChannelSubscribersLogic(ctx, s.svcCtx)
+	return l.FilterChannelSubscribers(in)
+}
+
+func (s *ConversationServiceServer) StreamMemberIDs(in *pb.StreamMemberIDsRequest, stream pb.ConversationService_StreamMemberIDsServer) error {
+	l := logic.NewStreamMemberIDsLogic(stream.Context(), s.svcCtx)
+	return l.StreamMemberIDs(in, stream)
+}
+
+func (s *ConversationServiceServer) SetMemberNickname(ctx context.Context, in *pb.SetMemberNicknameRequest) (*pb.SetMemberNicknameResponse, error) {
+	l := logic.NewSetMemberNicknameLogic(ctx, s.svcCtx)
+	return l.SetMemberNickname(in)
+}
+
+func (s *ConversationServiceServer) SetConversationCapacity(ctx context.Context, in *pb.SetConversationCapacityRequest) (*pb.SetConversationCapacityResponse, error) {
+	l := logic.NewSetConversationCapacityLogic(ctx, s.svcCtx)
+	return l.SetConversationCapacity(in)
+}
+
+func (s *ConversationServiceServer) RemoveUserEverywhere(ctx context.Context, in *pb.RemoveUserEverywhereRequest) (*pb.RemoveUserEverywhereResponse, error) {
+	l := logic.NewRemoveUserEverywhereLogic(ctx, s.svcCtx)
+	return l.RemoveUserEverywhere(in)
+}
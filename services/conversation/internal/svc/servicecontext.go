@@ -5,22 +5,36 @@ import (
 
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/config"
 	"github.com/HappyLadySauce/Beehive/services/conversation/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/conversation/internal/mq"
+	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"github.com/zeromicro/go-zero/zrpc"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
 type ServiceContext struct {
-	Config config.Config
-	DB     *gorm.DB
-	Conv   *model.ConversationModel
+	Config  config.Config
+	DB      *gorm.DB
+	Conv    *model.ConversationModel
 	JoinReq *model.GroupJoinRequestModel
+	Invite  *model.GroupInviteLinkModel
+	Channel *model.ChannelSubscriptionModel
+	MQ      *mq.Publisher
+	// MessageSvc 用于写入系统消息；未配置时为 nil，不产生系统消息
+	MessageSvc messageservice.MessageService
+	// UserSvc 用于成员列表附带用户资料、新建单聊校验黑名单、拉人入群校验隐私设置；未配置时为 nil，成员列表不含昵称、头像，不做上述校验
+	UserSvc userservice.UserService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	if c.PostgresDSN == "" {
 		panic("conversation service requires PostgresDSN")
 	}
+	if err := c.ValidateTiers(); err != nil {
+		panic(err)
+	}
 	db, err := gorm.Open(postgres.Open(c.PostgresDSN), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent),
 	})
@@ -31,10 +45,30 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	if err == nil {
 		sqlDB.SetConnMaxLifetime(time.Hour)
 	}
+	var pub *mq.Publisher
+	if c.RabbitMQURL != "" {
+		pub, err = mq.NewPublisher(c.RabbitMQURL, c.RabbitMQExchange)
+		if err != nil {
+			panic("rabbitmq: " + err.Error())
+		}
+	}
+	var msgSvc messageservice.MessageService
+	if c.MessageRpcConfigured() {
+		msgSvc = messageservice.NewMessageService(zrpc.MustNewClient(c.MessageRpc))
+	}
+	var userSvc userservice.UserService
+	if c.UserRpcConfigured() {
+		userSvc = userservice.NewUserService(zrpc.MustNewClient(c.UserRpc))
+	}
 	return &ServiceContext{
-		Config:  c,
-		DB:      db,
-		Conv:    model.NewConversationModel(db),
-		JoinReq: model.NewGroupJoinRequestModel(db),
+		Config:     c,
+		DB:         db,
+		Conv:       model.NewConversationModel(db),
+		JoinReq:    model.NewGroupJoinRequestModel(db),
+		Invite:     model.NewGroupInviteLinkModel(db),
+		Channel:    model.NewChannelSubscriptionModel(db),
+		MQ:         pub,
+		MessageSvc: msgSvc,
+		UserSvc:    userSvc,
 	}
 }
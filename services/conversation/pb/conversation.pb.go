@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,7 +26,9 @@ type CreateConversationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // single / group / channel
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	MemberIds     []string               `protobuf:"bytes,3,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	MemberIds     []string               `protobuf:"bytes,3,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`    // 频道时为发布者（管理员）
+	OperatorId    string                 `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 创建者，成为群主；为空时 member_ids 第一个为群主
+	IsPublic      bool                   `protobuf:"varint,5,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`      // 仅频道：是否出现在公开频道目录
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +84,20 @@ func (x *CreateConversationRequest) GetMemberIds() []string {
 	return nil
 }
 
+func (x *CreateConversationRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *CreateConversationRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
 type CreateConversationResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -129,7 +146,8 @@ type AddMemberRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // owner / admin / member
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`                               // admin / member，只能授予低于操作人的角色
+	OperatorId     string                 `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人（群主/管理员）
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -185,6 +203,13 @@ func (x *AddMemberRequest) GetRole() string {
 	return ""
 }
 
+func (x *AddMemberRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
 type AddMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -225,6 +250,7 @@ type RemoveMemberRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人，角色须高于被移除成员
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -273,6 +299,13 @@ func (x *RemoveMemberRequest) GetUserId() string {
 	return ""
 }
 
+func (x *RemoveMemberRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
 type RemoveMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -310,17 +343,28 @@ func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
 }
 
 type ConversationInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	MemberCount   int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastActiveAt  int64                  `protobuf:"varint,6,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
-	Announcement  string                 `protobuf:"bytes,7,opt,name=announcement,proto3" json:"announcement,omitempty"`         // 群公告，仅群聊使用
-	JoinType      string                 `protobuf:"bytes,8,opt,name=join_type,json=joinType,proto3" json:"join_type,omitempty"` // 群加入方式：approval=需审批，direct=直接加入，仅群聊有效
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MemberCount       int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	CreatedAt         int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastActiveAt      int64                  `protobuf:"varint,6,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
+	Announcement      string                 `protobuf:"bytes,7,opt,name=announcement,proto3" json:"announcement,omitempty"`                                       // 群公告，仅群聊使用
+	JoinType          string                 `protobuf:"bytes,8,opt,name=join_type,json=joinType,proto3" json:"join_type,omitempty"`                               // 群加入方式：approval=需审批，direct=直接加入，仅群聊有效
+	MessageTtlSeconds int32                  `protobuf:"varint,9,opt,name=message_ttl_seconds,json=messageTtlSeconds,proto3" json:"message_ttl_seconds,omitempty"` // 消息自动销毁时长（秒），0 表示不销毁
+	Settings          *MemberSettings        `protobuf:"bytes,10,opt,name=settings,proto3" json:"settings,omitempty"`                                              // 请求用户对该会话的个人设置，仅 ListUserConversations 返回
+	AvatarUrl         string                 `protobuf:"bytes,11,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`                           // 群头像，仅群聊使用
+	Status            string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`                                                  // active / dissolved
+	MuteAll           bool                   `protobuf:"varint,13,opt,name=mute_all,json=muteAll,proto3" json:"mute_all,omitempty"`                                // 全员禁言，仅群聊使用
+	IsPublic          bool                   `protobuf:"varint,14,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`                             // 仅频道：是否公开
+	SubscriberCount   int64                  `protobuf:"varint,15,opt,name=subscriber_count,json=subscriberCount,proto3" json:"subscriber_count,omitempty"`        // 仅频道：订阅人数
+	MemberStatus      string                 `protobuf:"bytes,16,opt,name=member_status,json=memberStatus,proto3" json:"member_status,omitempty"`                  // 请求用户的成员状态（active / left / banned），仅 ListUserConversations 增量模式返回
+	ActivityChanged   bool                   `protobuf:"varint,17,opt,name=activity_changed,json=activityChanged,proto3" json:"activity_changed,omitempty"`        // 增量模式下自上次同步后是否有新消息，客户端据此决定是否刷新最后一条消息与未读数
+	Tier              string                 `protobuf:"bytes,18,opt,name=tier,proto3" json:"tier,omitempty"`                                                      // 群/频道人数档位
+	MaxMembers        int32                  `protobuf:"varint,19,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`                       // 当前生效的成员上限（管理员覆盖值优先，否则为档位上限），0 表示不限
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ConversationInfo) Reset() {
@@ -409,18 +453,182 @@ func (x *ConversationInfo) GetJoinType() string {
 	return ""
 }
 
+func (x *ConversationInfo) GetMessageTtlSeconds() int32 {
+	if x != nil {
+		return x.MessageTtlSeconds
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetSettings() *MemberSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *ConversationInfo) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *ConversationInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ConversationInfo) GetMuteAll() bool {
+	if x != nil {
+		return x.MuteAll
+	}
+	return false
+}
+
+func (x *ConversationInfo) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *ConversationInfo) GetSubscriberCount() int64 {
+	if x != nil {
+		return x.SubscriberCount
+	}
+	return 0
+}
+
+func (x *ConversationInfo) GetMemberStatus() string {
+	if x != nil {
+		return x.MemberStatus
+	}
+	return ""
+}
+
+func (x *ConversationInfo) GetActivityChanged() bool {
+	if x != nil {
+		return x.ActivityChanged
+	}
+	return false
+}
+
+func (x *ConversationInfo) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *ConversationInfo) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+type MemberSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MutedUntil    int64                  `protobuf:"varint,1,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"` // 免打扰截止时间（Unix 秒），0 表示未免打扰，-1 表示永久
+	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	PinnedAt      int64                  `protobuf:"varint,3,opt,name=pinned_at,json=pinnedAt,proto3" json:"pinned_at,omitempty"` // 置顶时间，置顶会话按该时间倒序排在最前
+	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Hidden        bool                   `protobuf:"varint,5,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	Alias         string                 `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"` // 会话备注名，仅本人可见
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberSettings) Reset() {
+	*x = MemberSettings{}
+	mi := &file_proto_conversation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberSettings) ProtoMessage() {}
+
+func (x *MemberSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberSettings.ProtoReflect.Descriptor instead.
+func (*MemberSettings) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MemberSettings) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
+func (x *MemberSettings) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *MemberSettings) GetPinnedAt() int64 {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return 0
+}
+
+func (x *MemberSettings) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *MemberSettings) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *MemberSettings) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
 type ListUserConversationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，按 (last_active_at, id) 游标分页
 	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`                                // true 只列出已归档会话，false 只列出未归档会话
+	IncludeHidden bool                   `protobuf:"varint,5,opt,name=include_hidden,json=includeHidden,proto3" json:"include_hidden,omitempty"` // 是否包含已隐藏的会话
+	SyncToken     string                 `protobuf:"bytes,6,opt,name=sync_token,json=syncToken,proto3" json:"sync_token,omitempty"`              // 非空时为增量模式：只返回该同步点之后变化的会话（忽略 cursor / archived / include_hidden）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserConversationsRequest) Reset() {
 	*x = ListUserConversationsRequest{}
-	mi := &file_proto_conversation_proto_msgTypes[7]
+	mi := &file_proto_conversation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +640,7 @@ func (x *ListUserConversationsRequest) String() string {
 func (*ListUserConversationsRequest) ProtoMessage() {}
 
 func (x *ListUserConversationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[7]
+	mi := &file_proto_conversation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +653,7 @@ func (x *ListUserConversationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserConversationsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserConversationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{7}
+	return file_proto_conversation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListUserConversationsRequest) GetUserId() string {
@@ -469,17 +677,40 @@ func (x *ListUserConversationsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListUserConversationsRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *ListUserConversationsRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
+func (x *ListUserConversationsRequest) GetSyncToken() string {
+	if x != nil {
+		return x.SyncToken
+	}
+	return ""
+}
+
 type ListUserConversationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*ConversationInfo    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	SyncToken     string                 `protobuf:"bytes,3,opt,name=sync_token,json=syncToken,proto3" json:"sync_token,omitempty"` // 下次增量同步使用的同步点
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`      // 增量模式下是否还有变化未返回，为 true 时应立即用新的 sync_token 继续拉取
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserConversationsResponse) Reset() {
 	*x = ListUserConversationsResponse{}
-	mi := &file_proto_conversation_proto_msgTypes[8]
+	mi := &file_proto_conversation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +722,7 @@ func (x *ListUserConversationsResponse) String() string {
 func (*ListUserConversationsResponse) ProtoMessage() {}
 
 func (x *ListUserConversationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[8]
+	mi := &file_proto_conversation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +735,7 @@ func (x *ListUserConversationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserConversationsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserConversationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{8}
+	return file_proto_conversation_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListUserConversationsResponse) GetItems() []*ConversationInfo {
@@ -521,6 +752,20 @@ func (x *ListUserConversationsResponse) GetNextCursor() string {
 	return ""
 }
 
+func (x *ListUserConversationsResponse) GetSyncToken() string {
+	if x != nil {
+		return x.SyncToken
+	}
+	return ""
+}
+
+func (x *ListUserConversationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 type GetConversationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -530,7 +775,7 @@ type GetConversationRequest struct {
 
 func (x *GetConversationRequest) Reset() {
 	*x = GetConversationRequest{}
-	mi := &file_proto_conversation_proto_msgTypes[9]
+	mi := &file_proto_conversation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -542,7 +787,7 @@ func (x *GetConversationRequest) String() string {
 func (*GetConversationRequest) ProtoMessage() {}
 
 func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[9]
+	mi := &file_proto_conversation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +800,7 @@ func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
 func (*GetConversationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{9}
+	return file_proto_conversation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetConversationRequest) GetId() string {
@@ -574,7 +819,7 @@ type GetConversationResponse struct {
 
 func (x *GetConversationResponse) Reset() {
 	*x = GetConversationResponse{}
-	mi := &file_proto_conversation_proto_msgTypes[10]
+	mi := &file_proto_conversation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +831,7 @@ func (x *GetConversationResponse) String() string {
 func (*GetConversationResponse) ProtoMessage() {}
 
 func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[10]
+	mi := &file_proto_conversation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +844,7 @@ func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationResponse.ProtoReflect.Descriptor instead.
 func (*GetConversationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{10}
+	return file_proto_conversation_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetConversationResponse) GetConversation() *ConversationInfo {
@@ -612,13 +857,20 @@ func (x *GetConversationResponse) GetConversation() *ConversationInfo {
 type ListMembersRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`     // 可选：非空时要求其为 active 成员，否则返回 PermissionDenied
+	Cursor         string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`                               // 上一页返回的 next_cursor，空表示第一页
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                // 每页条数，默认 100，最大 500
+	Query          string                 `protobuf:"bytes,5,opt,name=query,proto3" json:"query,omitempty"`                                 // 可选：按群昵称或用户 ID 模糊搜索
+	Roles          []string               `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`                                 // 可选：owner / admin / member
+	Statuses       []string               `protobuf:"bytes,7,rep,name=statuses,proto3" json:"statuses,omitempty"`                           // 可选：active / left / banned，为空时不过滤
+	WithProfile    bool                   `protobuf:"varint,8,opt,name=with_profile,json=withProfile,proto3" json:"with_profile,omitempty"` // 为 true 时通过 UserService.BatchGetUsers 附带昵称、头像
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ListMembersRequest) Reset() {
 	*x = ListMembersRequest{}
-	mi := &file_proto_conversation_proto_msgTypes[11]
+	mi := &file_proto_conversation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -630,7 +882,7 @@ func (x *ListMembersRequest) String() string {
 func (*ListMembersRequest) ProtoMessage() {}
 
 func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[11]
+	mi := &file_proto_conversation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,7 +895,7 @@ func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
 func (*ListMembersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{11}
+	return file_proto_conversation_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListMembersRequest) GetConversationId() string {
@@ -653,19 +905,73 @@ func (x *ListMembersRequest) GetConversationId() string {
 	return ""
 }
 
+func (x *ListMembersRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMembersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetWithProfile() bool {
+	if x != nil {
+		return x.WithProfile
+	}
+	return false
+}
+
 type MemberInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	JoinedAt      int64                  `protobuf:"varint,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active / left / banned
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role            string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedAt        int64                  `protobuf:"varint,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                                             // active / left / banned
+	MutedUntil      int64                  `protobuf:"varint,5,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`                  // 该成员的免打扰截止时间，语义同 MemberSettings.muted_until；ListMembers 仅在 user_id 为 operator_id 时返回
+	SpeakMutedUntil int64                  `protobuf:"varint,6,opt,name=speak_muted_until,json=speakMutedUntil,proto3" json:"speak_muted_until,omitempty"` // 被禁言截止时间（Unix 秒），0 表示未禁言
+	Nickname        string                 `protobuf:"bytes,7,opt,name=nickname,proto3" json:"nickname,omitempty"`                                         // 群昵称，空表示未设置
+	ProfileNickname string                 `protobuf:"bytes,8,opt,name=profile_nickname,json=profileNickname,proto3" json:"profile_nickname,omitempty"`    // 用户资料昵称，仅 with_profile 时返回
+	AvatarUrl       string                 `protobuf:"bytes,9,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`                      // 用户头像，仅 with_profile 时返回
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *MemberInfo) Reset() {
 	*x = MemberInfo{}
-	mi := &file_proto_conversation_proto_msgTypes[12]
+	mi := &file_proto_conversation_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -677,7 +983,7 @@ func (x *MemberInfo) String() string {
 func (*MemberInfo) ProtoMessage() {}
 
 func (x *MemberInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[12]
+	mi := &file_proto_conversation_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +996,7 @@ func (x *MemberInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberInfo.ProtoReflect.Descriptor instead.
 func (*MemberInfo) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{12}
+	return file_proto_conversation_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MemberInfo) GetUserId() string {
@@ -721,18 +1027,54 @@ func (x *MemberInfo) GetStatus() string {
 	return ""
 }
 
-type ListMembersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*MemberInfo          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListMembersResponse) Reset() {
-	*x = ListMembersResponse{}
-	mi := &file_proto_conversation_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MemberInfo) GetMutedUntil() int64 {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return 0
+}
+
+func (x *MemberInfo) GetSpeakMutedUntil() int64 {
+	if x != nil {
+		return x.SpeakMutedUntil
+	}
+	return 0
+}
+
+func (x *MemberInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *MemberInfo) GetProfileNickname() string {
+	if x != nil {
+		return x.ProfileNickname
+	}
+	return ""
+}
+
+func (x *MemberInfo) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type ListMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MemberInfo          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 为空表示已到末页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
 func (x *ListMembersResponse) String() string {
@@ -742,7 +1084,7 @@ func (x *ListMembersResponse) String() string {
 func (*ListMembersResponse) ProtoMessage() {}
 
 func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[13]
+	mi := &file_proto_conversation_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +1097,7 @@ func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
 func (*ListMembersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{13}
+	return file_proto_conversation_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListMembersResponse) GetItems() []*MemberInfo {
@@ -765,29 +1107,88 @@ func (x *ListMembersResponse) GetItems() []*MemberInfo {
 	return nil
 }
 
-type FindOrCreateSingleConversationRequest struct {
+func (x *ListMembersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type StreamMemberIDsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	BatchSize      int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每批条数，默认 1000，最大 5000
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StreamMemberIDsRequest) Reset() {
+	*x = StreamMemberIDsRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMemberIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMemberIDsRequest) ProtoMessage() {}
+
+func (x *StreamMemberIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMemberIDsRequest.ProtoReflect.Descriptor instead.
+func (*StreamMemberIDsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StreamMemberIDsRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *StreamMemberIDsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type MemberIDsChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId_1      string                 `protobuf:"bytes,1,opt,name=user_id_1,json=userId1,proto3" json:"user_id_1,omitempty"`
-	UserId_2      string                 `protobuf:"bytes,2,opt,name=user_id_2,json=userId2,proto3" json:"user_id_2,omitempty"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	MutedUserIds  []string               `protobuf:"bytes,2,rep,name=muted_user_ids,json=mutedUserIds,proto3" json:"muted_user_ids,omitempty"` // user_ids 中当前处于免打扰的成员
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindOrCreateSingleConversationRequest) Reset() {
-	*x = FindOrCreateSingleConversationRequest{}
-	mi := &file_proto_conversation_proto_msgTypes[14]
+func (x *MemberIDsChunk) Reset() {
+	*x = MemberIDsChunk{}
+	mi := &file_proto_conversation_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindOrCreateSingleConversationRequest) String() string {
+func (x *MemberIDsChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindOrCreateSingleConversationRequest) ProtoMessage() {}
+func (*MemberIDsChunk) ProtoMessage() {}
 
-func (x *FindOrCreateSingleConversationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[14]
+func (x *MemberIDsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,47 +1199,49 @@ func (x *FindOrCreateSingleConversationRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindOrCreateSingleConversationRequest.ProtoReflect.Descriptor instead.
-func (*FindOrCreateSingleConversationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MemberIDsChunk.ProtoReflect.Descriptor instead.
+func (*MemberIDsChunk) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *FindOrCreateSingleConversationRequest) GetUserId_1() string {
+func (x *MemberIDsChunk) GetUserIds() []string {
 	if x != nil {
-		return x.UserId_1
+		return x.UserIds
 	}
-	return ""
+	return nil
 }
 
-func (x *FindOrCreateSingleConversationRequest) GetUserId_2() string {
+func (x *MemberIDsChunk) GetMutedUserIds() []string {
 	if x != nil {
-		return x.UserId_2
+		return x.MutedUserIds
 	}
-	return ""
+	return nil
 }
 
-type FindOrCreateSingleConversationResponse struct {
+type SetMemberNicknameRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname       string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"` // 空串清除群昵称，最长 32 字符
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *FindOrCreateSingleConversationResponse) Reset() {
-	*x = FindOrCreateSingleConversationResponse{}
-	mi := &file_proto_conversation_proto_msgTypes[15]
+func (x *SetMemberNicknameRequest) Reset() {
+	*x = SetMemberNicknameRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindOrCreateSingleConversationResponse) String() string {
+func (x *SetMemberNicknameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindOrCreateSingleConversationResponse) ProtoMessage() {}
+func (*SetMemberNicknameRequest) ProtoMessage() {}
 
-func (x *FindOrCreateSingleConversationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[15]
+func (x *SetMemberNicknameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,42 +1252,92 @@ func (x *FindOrCreateSingleConversationResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindOrCreateSingleConversationResponse.ProtoReflect.Descriptor instead.
-func (*FindOrCreateSingleConversationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetMemberNicknameRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberNicknameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *FindOrCreateSingleConversationResponse) GetConversationId() string {
+func (x *SetMemberNicknameRequest) GetConversationId() string {
 	if x != nil {
 		return x.ConversationId
 	}
 	return ""
 }
 
-type ApplyJoinGroupRequest struct {
+func (x *SetMemberNicknameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMemberNicknameRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+type SetMemberNicknameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemberNicknameResponse) Reset() {
+	*x = SetMemberNicknameResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberNicknameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberNicknameResponse) ProtoMessage() {}
+
+func (x *SetMemberNicknameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberNicknameResponse.ProtoReflect.Descriptor instead.
+func (*SetMemberNicknameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{18}
+}
+
+type SetConversationCapacityRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Tier           *string                `protobuf:"bytes,2,opt,name=tier,proto3,oneof" json:"tier,omitempty"`                                // 设置时须为已配置的档位名
+	MaxMembers     *int32                 `protobuf:"varint,3,opt,name=max_members,json=maxMembers,proto3,oneof" json:"max_members,omitempty"` // 覆盖值，0 清除覆盖；不能小于当前成员数
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ApplyJoinGroupRequest) Reset() {
-	*x = ApplyJoinGroupRequest{}
-	mi := &file_proto_conversation_proto_msgTypes[16]
+func (x *SetConversationCapacityRequest) Reset() {
+	*x = SetConversationCapacityRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyJoinGroupRequest) String() string {
+func (x *SetConversationCapacityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyJoinGroupRequest) ProtoMessage() {}
+func (*SetConversationCapacityRequest) ProtoMessage() {}
 
-func (x *ApplyJoinGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[16]
+func (x *SetConversationCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,55 +1348,3020 @@ func (x *ApplyJoinGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyJoinGroupRequest.ProtoReflect.Descriptor instead.
-func (*ApplyJoinGroupRequest) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SetConversationCapacityRequest.ProtoReflect.Descriptor instead.
+func (*SetConversationCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetConversationCapacityRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SetConversationCapacityRequest) GetTier() string {
+	if x != nil && x.Tier != nil {
+		return *x.Tier
+	}
+	return ""
+}
+
+func (x *SetConversationCapacityRequest) GetMaxMembers() int32 {
+	if x != nil && x.MaxMembers != nil {
+		return *x.MaxMembers
+	}
+	return 0
+}
+
+type SetConversationCapacityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tier          string                 `protobuf:"bytes,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	MaxMembers    int32                  `protobuf:"varint,2,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"` // 生效的成员上限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConversationCapacityResponse) Reset() {
+	*x = SetConversationCapacityResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationCapacityResponse) ProtoMessage() {}
+
+func (x *SetConversationCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationCapacityResponse.ProtoReflect.Descriptor instead.
+func (*SetConversationCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetConversationCapacityResponse) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *SetConversationCapacityResponse) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+type FindOrCreateSingleConversationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId_1      string                 `protobuf:"bytes,1,opt,name=user_id_1,json=userId1,proto3" json:"user_id_1,omitempty"`
+	UserId_2      string                 `protobuf:"bytes,2,opt,name=user_id_2,json=userId2,proto3" json:"user_id_2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindOrCreateSingleConversationRequest) Reset() {
+	*x = FindOrCreateSingleConversationRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindOrCreateSingleConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOrCreateSingleConversationRequest) ProtoMessage() {}
+
+func (x *FindOrCreateSingleConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOrCreateSingleConversationRequest.ProtoReflect.Descriptor instead.
+func (*FindOrCreateSingleConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FindOrCreateSingleConversationRequest) GetUserId_1() string {
+	if x != nil {
+		return x.UserId_1
+	}
+	return ""
+}
+
+func (x *FindOrCreateSingleConversationRequest) GetUserId_2() string {
+	if x != nil {
+		return x.UserId_2
+	}
+	return ""
+}
+
+type FindOrCreateSingleConversationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FindOrCreateSingleConversationResponse) Reset() {
+	*x = FindOrCreateSingleConversationResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindOrCreateSingleConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOrCreateSingleConversationResponse) ProtoMessage() {}
+
+func (x *FindOrCreateSingleConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOrCreateSingleConversationResponse.ProtoReflect.Descriptor instead.
+func (*FindOrCreateSingleConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FindOrCreateSingleConversationResponse) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type ApplyJoinGroupRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApplyJoinGroupRequest) Reset() {
+	*x = ApplyJoinGroupRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyJoinGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyJoinGroupRequest) ProtoMessage() {}
+
+func (x *ApplyJoinGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyJoinGroupRequest.ProtoReflect.Descriptor instead.
+func (*ApplyJoinGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ApplyJoinGroupRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ApplyJoinGroupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ApplyJoinGroupRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ApplyJoinGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Joined        bool                   `protobuf:"varint,2,opt,name=joined,proto3" json:"joined,omitempty"` // true 表示已直接加入（join_type=direct），无需审批
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyJoinGroupResponse) Reset() {
+	*x = ApplyJoinGroupResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyJoinGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyJoinGroupResponse) ProtoMessage() {}
+
+func (x *ApplyJoinGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyJoinGroupResponse.ProtoReflect.Descriptor instead.
+func (*ApplyJoinGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ApplyJoinGroupResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ApplyJoinGroupResponse) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+type ListJoinRequestsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 调用方（群主/管理员）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsRequest) Reset() {
+	*x = ListJoinRequestsRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListJoinRequestsRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListJoinRequestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type JoinRequestItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RequestId      string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JoinRequestItem) Reset() {
+	*x = JoinRequestItem{}
+	mi := &file_proto_conversation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequestItem) ProtoMessage() {}
+
+func (x *JoinRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequestItem.ProtoReflect.Descriptor instead.
+func (*JoinRequestItem) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JoinRequestItem) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *JoinRequestItem) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *JoinRequestItem) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinRequestItem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinRequestItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListJoinRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*JoinRequestItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsResponse) Reset() {
+	*x = ListJoinRequestsResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListJoinRequestsResponse) GetItems() []*JoinRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ApproveJoinRequestRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	RequestId      string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 审批人（群主/管理员）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestRequest) Reset() {
+	*x = ApproveJoinRequestRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveJoinRequestRequest) ProtoMessage() {}
+
+func (x *ApproveJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApproveJoinRequestRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ApproveJoinRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ApproveJoinRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ApproveJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestResponse) Reset() {
+	*x = ApproveJoinRequestResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveJoinRequestResponse) ProtoMessage() {}
+
+func (x *ApproveJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{29}
+}
+
+type DeclineJoinRequestRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	RequestId      string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeclineJoinRequestRequest) Reset() {
+	*x = DeclineJoinRequestRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineJoinRequestRequest) ProtoMessage() {}
+
+func (x *DeclineJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclineJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeclineJoinRequestRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DeclineJoinRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *DeclineJoinRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeclineJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineJoinRequestResponse) Reset() {
+	*x = DeclineJoinRequestResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineJoinRequestResponse) ProtoMessage() {}
+
+func (x *DeclineJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*DeclineJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{31}
+}
+
+type SetMessageTTLRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 操作人
+	TtlSeconds     int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // 0 表示关闭
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMessageTTLRequest) Reset() {
+	*x = SetMessageTTLRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMessageTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMessageTTLRequest) ProtoMessage() {}
+
+func (x *SetMessageTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMessageTTLRequest.ProtoReflect.Descriptor instead.
+func (*SetMessageTTLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetMessageTTLRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SetMessageTTLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMessageTTLRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type SetMessageTTLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMessageTTLResponse) Reset() {
+	*x = SetMessageTTLResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMessageTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMessageTTLResponse) ProtoMessage() {}
+
+func (x *SetMessageTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMessageTTLResponse.ProtoReflect.Descriptor instead.
+func (*SetMessageTTLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{33}
+}
+
+// 仅设置了的字段会被更新
+type UpdateMemberSettingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MutedUntil     *int64                 `protobuf:"varint,3,opt,name=muted_until,json=mutedUntil,proto3,oneof" json:"muted_until,omitempty"` // 0 取消免打扰，-1 永久免打扰
+	Pinned         *bool                  `protobuf:"varint,4,opt,name=pinned,proto3,oneof" json:"pinned,omitempty"`
+	Archived       *bool                  `protobuf:"varint,5,opt,name=archived,proto3,oneof" json:"archived,omitempty"`
+	Hidden         *bool                  `protobuf:"varint,6,opt,name=hidden,proto3,oneof" json:"hidden,omitempty"`
+	Alias          *string                `protobuf:"bytes,7,opt,name=alias,proto3,oneof" json:"alias,omitempty"` // 空串表示清除备注名
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateMemberSettingsRequest) Reset() {
+	*x = UpdateMemberSettingsRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateMemberSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateMemberSettingsRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *UpdateMemberSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateMemberSettingsRequest) GetMutedUntil() int64 {
+	if x != nil && x.MutedUntil != nil {
+		return *x.MutedUntil
+	}
+	return 0
+}
+
+func (x *UpdateMemberSettingsRequest) GetPinned() bool {
+	if x != nil && x.Pinned != nil {
+		return *x.Pinned
+	}
+	return false
+}
+
+func (x *UpdateMemberSettingsRequest) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+func (x *UpdateMemberSettingsRequest) GetHidden() bool {
+	if x != nil && x.Hidden != nil {
+		return *x.Hidden
+	}
+	return false
+}
+
+func (x *UpdateMemberSettingsRequest) GetAlias() string {
+	if x != nil && x.Alias != nil {
+		return *x.Alias
+	}
+	return ""
+}
+
+type UpdateMemberSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *MemberSettings        `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberSettingsResponse) Reset() {
+	*x = UpdateMemberSettingsResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateMemberSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemberSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateMemberSettingsResponse) GetSettings() *MemberSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetMemberRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 仅群主
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role           string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"` // admin / member
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMemberRoleRequest) Reset() {
+	*x = SetMemberRoleRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetMemberRoleRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SetMemberRoleRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *SetMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type SetMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMemberRoleResponse) Reset() {
+	*x = SetMemberRoleResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMemberRoleResponse) ProtoMessage() {}
+
+func (x *SetMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{37}
+}
+
+type TransferOwnershipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 当前群主，转让后成为管理员
+	NewOwnerId     string                 `protobuf:"bytes,3,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransferOwnershipRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
+type TransferOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{39}
+}
+
+type LeaveConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeaveConversationRequest) Reset() {
+	*x = LeaveConversationRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveConversationRequest) ProtoMessage() {}
+
+func (x *LeaveConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveConversationRequest.ProtoReflect.Descriptor instead.
+func (*LeaveConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LeaveConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *LeaveConversationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveConversationResponse) Reset() {
+	*x = LeaveConversationResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveConversationResponse) ProtoMessage() {}
+
+func (x *LeaveConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveConversationResponse.ProtoReflect.Descriptor instead.
+func (*LeaveConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{41}
+}
+
+// update_mask.paths 可取 name / announcement / join_type / avatar_url，只更新列出的字段
+type UpdateConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 群主或管理员
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Announcement   string                 `protobuf:"bytes,4,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	JoinType       string                 `protobuf:"bytes,5,opt,name=join_type,json=joinType,proto3" json:"join_type,omitempty"` // approval / direct
+	AvatarUrl      string                 `protobuf:"bytes,6,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	UpdateMask     *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateConversationRequest) Reset() {
+	*x = UpdateConversationRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationRequest) ProtoMessage() {}
+
+func (x *UpdateConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *UpdateConversationRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *UpdateConversationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateConversationRequest) GetAnnouncement() string {
+	if x != nil {
+		return x.Announcement
+	}
+	return ""
+}
+
+func (x *UpdateConversationRequest) GetJoinType() string {
+	if x != nil {
+		return x.JoinType
+	}
+	return ""
+}
+
+func (x *UpdateConversationRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateConversationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conversation  *ConversationInfo      `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateConversationResponse) Reset() {
+	*x = UpdateConversationResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateConversationResponse) ProtoMessage() {}
+
+func (x *UpdateConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateConversationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateConversationResponse) GetConversation() *ConversationInfo {
+	if x != nil {
+		return x.Conversation
+	}
+	return nil
+}
+
+type AnnouncementInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // 空串表示清空公告
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnouncementInfo) Reset() {
+	*x = AnnouncementInfo{}
+	mi := &file_proto_conversation_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnouncementInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnouncementInfo) ProtoMessage() {}
+
+func (x *AnnouncementInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnouncementInfo.ProtoReflect.Descriptor instead.
+func (*AnnouncementInfo) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AnnouncementInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AnnouncementInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AnnouncementInfo) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AnnouncementInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListAnnouncementsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 请求者，须为 active 成员
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                // 默认 20，最大 100
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsRequest) Reset() {
+	*x = ListAnnouncementsRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsRequest) ProtoMessage() {}
+
+func (x *ListAnnouncementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsRequest.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAnnouncementsRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListAnnouncementsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAnnouncementsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAnnouncementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AnnouncementInfo    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAnnouncementsResponse) Reset() {
+	*x = ListAnnouncementsResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnnouncementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnnouncementsResponse) ProtoMessage() {}
+
+func (x *ListAnnouncementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnnouncementsResponse.ProtoReflect.Descriptor instead.
+func (*ListAnnouncementsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListAnnouncementsResponse) GetItems() []*AnnouncementInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type InviteLinkInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Token          string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ExpiresAt      int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 0 表示永不过期
+	MaxUses        int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`       // 0 表示不限次数
+	UseCount       int32                  `protobuf:"varint,7,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	BypassApproval bool                   `protobuf:"varint,8,opt,name=bypass_approval,json=bypassApproval,proto3" json:"bypass_approval,omitempty"` // true 时通过链接直接入群，否则提交入群申请
+	Revoked        bool                   `protobuf:"varint,9,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InviteLinkInfo) Reset() {
+	*x = InviteLinkInfo{}
+	mi := &file_proto_conversation_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteLinkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteLinkInfo) ProtoMessage() {}
+
+func (x *InviteLinkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteLinkInfo.ProtoReflect.Descriptor instead.
+func (*InviteLinkInfo) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InviteLinkInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InviteLinkInfo) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *InviteLinkInfo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *InviteLinkInfo) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *InviteLinkInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *InviteLinkInfo) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *InviteLinkInfo) GetUseCount() int32 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+func (x *InviteLinkInfo) GetBypassApproval() bool {
+	if x != nil {
+		return x.BypassApproval
+	}
+	return false
+}
+
+func (x *InviteLinkInfo) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *InviteLinkInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateInviteLinkRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 群主或管理员
+	ExpiresAt      int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`   // 可选：过期时间（Unix 秒），0 表示永不过期
+	MaxUses        int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`         // 可选：最大使用次数，0 表示不限
+	BypassApproval bool                   `protobuf:"varint,5,opt,name=bypass_approval,json=bypassApproval,proto3" json:"bypass_approval,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateInviteLinkRequest) Reset() {
+	*x = CreateInviteLinkRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteLinkRequest) ProtoMessage() {}
+
+func (x *CreateInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateInviteLinkRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *CreateInviteLinkRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *CreateInviteLinkRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *CreateInviteLinkRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteLinkRequest) GetBypassApproval() bool {
+	if x != nil {
+		return x.BypassApproval
+	}
+	return false
+}
+
+type CreateInviteLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Link          *InviteLinkInfo        `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteLinkResponse) Reset() {
+	*x = CreateInviteLinkResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteLinkResponse) ProtoMessage() {}
+
+func (x *CreateInviteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateInviteLinkResponse) GetLink() *InviteLinkInfo {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type RevokeInviteLinkRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	LinkId         string                 `protobuf:"bytes,3,opt,name=link_id,json=linkId,proto3" json:"link_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevokeInviteLinkRequest) Reset() {
+	*x = RevokeInviteLinkRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteLinkRequest) ProtoMessage() {}
+
+func (x *RevokeInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RevokeInviteLinkRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *RevokeInviteLinkRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *RevokeInviteLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type RevokeInviteLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteLinkResponse) Reset() {
+	*x = RevokeInviteLinkResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteLinkResponse) ProtoMessage() {}
+
+func (x *RevokeInviteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{51}
+}
+
+type ListInviteLinksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId  string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId      string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,3,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // 是否包含已撤销、已过期、已用完的链接
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListInviteLinksRequest) Reset() {
+	*x = ListInviteLinksRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteLinksRequest) ProtoMessage() {}
+
+func (x *ListInviteLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListInviteLinksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListInviteLinksRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListInviteLinksRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *ListInviteLinksRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListInviteLinksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InviteLinkInfo      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInviteLinksResponse) Reset() {
+	*x = ListInviteLinksResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInviteLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInviteLinksResponse) ProtoMessage() {}
+
+func (x *ListInviteLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInviteLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListInviteLinksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListInviteLinksResponse) GetItems() []*InviteLinkInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 预览不要求是群成员，只返回公开的群资料
+type PreviewInviteLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewInviteLinkRequest) Reset() {
+	*x = PreviewInviteLinkRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewInviteLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewInviteLinkRequest) ProtoMessage() {}
+
+func (x *PreviewInviteLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewInviteLinkRequest.ProtoReflect.Descriptor instead.
+func (*PreviewInviteLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PreviewInviteLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PreviewInviteLinkResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AvatarUrl      string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	MemberCount    int32                  `protobuf:"varint,4,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	BypassApproval bool                   `protobuf:"varint,5,opt,name=bypass_approval,json=bypassApproval,proto3" json:"bypass_approval,omitempty"`
+	ExpiresAt      int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreviewInviteLinkResponse) Reset() {
+	*x = PreviewInviteLinkResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewInviteLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewInviteLinkResponse) ProtoMessage() {}
+
+func (x *PreviewInviteLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewInviteLinkResponse.ProtoReflect.Descriptor instead.
+func (*PreviewInviteLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PreviewInviteLinkResponse) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *PreviewInviteLinkResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreviewInviteLinkResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *PreviewInviteLinkResponse) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *PreviewInviteLinkResponse) GetBypassApproval() bool {
+	if x != nil {
+		return x.BypassApproval
+	}
+	return false
+}
+
+func (x *PreviewInviteLinkResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type JoinByInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // 需审批时作为入群申请留言
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinByInviteRequest) Reset() {
+	*x = JoinByInviteRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByInviteRequest) ProtoMessage() {}
+
+func (x *JoinByInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByInviteRequest.ProtoReflect.Descriptor instead.
+func (*JoinByInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *JoinByInviteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinByInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinByInviteRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type JoinByInviteResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	Joined         bool                   `protobuf:"varint,2,opt,name=joined,proto3" json:"joined,omitempty"` // true 已入群；false 已提交入群申请，等待审批
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JoinByInviteResponse) Reset() {
+	*x = JoinByInviteResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByInviteResponse) ProtoMessage() {}
+
+func (x *JoinByInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByInviteResponse.ProtoReflect.Descriptor instead.
+func (*JoinByInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *JoinByInviteResponse) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *JoinByInviteResponse) GetJoined() bool {
+	if x != nil {
+		return x.Joined
+	}
+	return false
+}
+
+type DissolveConversationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 仅群主
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DissolveConversationRequest) Reset() {
+	*x = DissolveConversationRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DissolveConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DissolveConversationRequest) ProtoMessage() {}
+
+func (x *DissolveConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DissolveConversationRequest.ProtoReflect.Descriptor instead.
+func (*DissolveConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DissolveConversationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DissolveConversationRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type DissolveConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DissolveConversationResponse) Reset() {
+	*x = DissolveConversationResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DissolveConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DissolveConversationResponse) ProtoMessage() {}
+
+func (x *DissolveConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DissolveConversationResponse.ProtoReflect.Descriptor instead.
+func (*DissolveConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{59}
+}
+
+type BanMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 群主或管理员，只能封禁角色低于自己的成员
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 可以不是当前成员
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BanMemberRequest) Reset() {
+	*x = BanMemberRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanMemberRequest) ProtoMessage() {}
+
+func (x *BanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanMemberRequest.ProtoReflect.Descriptor instead.
+func (*BanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BanMemberRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *BanMemberRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *BanMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BanMemberRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BanMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanMemberResponse) Reset() {
+	*x = BanMemberResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanMemberResponse) ProtoMessage() {}
+
+func (x *BanMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanMemberResponse.ProtoReflect.Descriptor instead.
+func (*BanMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{61}
+}
+
+type UnbanMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnbanMemberRequest) Reset() {
+	*x = UnbanMemberRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanMemberRequest) ProtoMessage() {}
+
+func (x *UnbanMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnbanMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UnbanMemberRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *UnbanMemberRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *UnbanMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnbanMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnbanMemberResponse) Reset() {
+	*x = UnbanMemberResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnbanMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbanMemberResponse) ProtoMessage() {}
+
+func (x *UnbanMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbanMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnbanMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{63}
+}
+
+type ListBannedMembersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListBannedMembersRequest) Reset() {
+	*x = ListBannedMembersRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBannedMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBannedMembersRequest) ProtoMessage() {}
+
+func (x *ListBannedMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBannedMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListBannedMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListBannedMembersRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ListBannedMembersRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type ListBannedMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MemberInfo          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBannedMembersResponse) Reset() {
+	*x = ListBannedMembersResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBannedMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBannedMembersResponse) ProtoMessage() {}
+
+func (x *ListBannedMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBannedMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListBannedMembersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListBannedMembersResponse) GetItems() []*MemberInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SetMuteAllRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 群主或管理员
+	Enabled        bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetMuteAllRequest) Reset() {
+	*x = SetMuteAllRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMuteAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMuteAllRequest) ProtoMessage() {}
+
+func (x *SetMuteAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMuteAllRequest.ProtoReflect.Descriptor instead.
+func (*SetMuteAllRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SetMuteAllRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SetMuteAllRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *SetMuteAllRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetMuteAllResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMuteAllResponse) Reset() {
+	*x = SetMuteAllResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMuteAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMuteAllResponse) ProtoMessage() {}
+
+func (x *SetMuteAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMuteAllResponse.ProtoReflect.Descriptor instead.
+func (*SetMuteAllResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{67}
+}
+
+type MuteMemberRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId  string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId      string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 群主或管理员，只能禁言角色低于自己的成员
+	UserId          string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 禁言时长，最长 30 天
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MuteMemberRequest) Reset() {
+	*x = MuteMemberRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberRequest) ProtoMessage() {}
+
+func (x *MuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*MuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MuteMemberRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *MuteMemberRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *MuteMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MuteMemberRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type MuteMemberResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SpeakMutedUntil int64                  `protobuf:"varint,1,opt,name=speak_muted_until,json=speakMutedUntil,proto3" json:"speak_muted_until,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MuteMemberResponse) Reset() {
+	*x = MuteMemberResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteMemberResponse) ProtoMessage() {}
+
+func (x *MuteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteMemberResponse.ProtoReflect.Descriptor instead.
+func (*MuteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MuteMemberResponse) GetSpeakMutedUntil() int64 {
+	if x != nil {
+		return x.SpeakMutedUntil
+	}
+	return 0
+}
+
+type UnmuteMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OperatorId     string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberRequest) Reset() {
+	*x = UnmuteMemberRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberRequest) ProtoMessage() {}
+
+func (x *UnmuteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UnmuteMemberRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *UnmuteMemberRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *UnmuteMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnmuteMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteMemberResponse) Reset() {
+	*x = UnmuteMemberResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteMemberResponse) ProtoMessage() {}
+
+func (x *UnmuteMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteMemberResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{71}
+}
+
+type SubscribeChannelRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscribeChannelRequest) Reset() {
+	*x = SubscribeChannelRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeChannelRequest) ProtoMessage() {}
+
+func (x *SubscribeChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeChannelRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeChannelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SubscribeChannelRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *SubscribeChannelRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SubscribeChannelResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SubscriberCount int64                  `protobuf:"varint,1,opt,name=subscriber_count,json=subscriberCount,proto3" json:"subscriber_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubscribeChannelResponse) Reset() {
+	*x = SubscribeChannelResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeChannelResponse) ProtoMessage() {}
+
+func (x *SubscribeChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeChannelResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeChannelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SubscribeChannelResponse) GetSubscriberCount() int64 {
+	if x != nil {
+		return x.SubscriberCount
+	}
+	return 0
+}
+
+type UnsubscribeChannelRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnsubscribeChannelRequest) Reset() {
+	*x = UnsubscribeChannelRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeChannelRequest) ProtoMessage() {}
+
+func (x *UnsubscribeChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeChannelRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeChannelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ApplyJoinGroupRequest) GetConversationId() string {
+func (x *UnsubscribeChannelRequest) GetConversationId() string {
 	if x != nil {
 		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *ApplyJoinGroupRequest) GetUserId() string {
+func (x *UnsubscribeChannelRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ApplyJoinGroupRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type ApplyJoinGroupResponse struct {
+type UnsubscribeChannelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Joined        bool                   `protobuf:"varint,2,opt,name=joined,proto3" json:"joined,omitempty"` // true 表示已直接加入（join_type=direct），无需审批
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyJoinGroupResponse) Reset() {
-	*x = ApplyJoinGroupResponse{}
-	mi := &file_proto_conversation_proto_msgTypes[17]
+func (x *UnsubscribeChannelResponse) Reset() {
+	*x = UnsubscribeChannelResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyJoinGroupResponse) String() string {
+func (x *UnsubscribeChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyJoinGroupResponse) ProtoMessage() {}
+func (*UnsubscribeChannelResponse) ProtoMessage() {}
 
-func (x *ApplyJoinGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[17]
+func (x *UnsubscribeChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,48 +4372,35 @@ func (x *ApplyJoinGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyJoinGroupResponse.ProtoReflect.Descriptor instead.
-func (*ApplyJoinGroupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *ApplyJoinGroupResponse) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (x *ApplyJoinGroupResponse) GetJoined() bool {
-	if x != nil {
-		return x.Joined
-	}
-	return false
+// Deprecated: Use UnsubscribeChannelResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeChannelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{75}
 }
 
-type ListJoinRequestsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 调用方（群主/管理员）
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListSubscribedChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJoinRequestsRequest) Reset() {
-	*x = ListJoinRequestsRequest{}
-	mi := &file_proto_conversation_proto_msgTypes[18]
+func (x *ListSubscribedChannelsRequest) Reset() {
+	*x = ListSubscribedChannelsRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJoinRequestsRequest) String() string {
+func (x *ListSubscribedChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJoinRequestsRequest) ProtoMessage() {}
+func (*ListSubscribedChannelsRequest) ProtoMessage() {}
 
-func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[18]
+func (x *ListSubscribedChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1006,51 +4411,56 @@ func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListSubscribedChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscribedChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListJoinRequestsRequest) GetConversationId() string {
+func (x *ListSubscribedChannelsRequest) GetUserId() string {
 	if x != nil {
-		return x.ConversationId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListJoinRequestsRequest) GetUserId() string {
+func (x *ListSubscribedChannelsRequest) GetCursor() string {
 	if x != nil {
-		return x.UserId
+		return x.Cursor
 	}
 	return ""
 }
 
-type JoinRequestItem struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RequestId      string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	CreatedAt      int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ListSubscribedChannelsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *JoinRequestItem) Reset() {
-	*x = JoinRequestItem{}
-	mi := &file_proto_conversation_proto_msgTypes[19]
+type ListPublicChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // 按名称模糊匹配，可选
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicChannelsRequest) Reset() {
+	*x = ListPublicChannelsRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinRequestItem) String() string {
+func (x *ListPublicChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRequestItem) ProtoMessage() {}
+func (*ListPublicChannelsRequest) ProtoMessage() {}
 
-func (x *JoinRequestItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[19]
+func (x *ListPublicChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,68 +4471,55 @@ func (x *JoinRequestItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRequestItem.ProtoReflect.Descriptor instead.
-func (*JoinRequestItem) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *JoinRequestItem) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (x *JoinRequestItem) GetConversationId() string {
-	if x != nil {
-		return x.ConversationId
-	}
-	return ""
+// Deprecated: Use ListPublicChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListPublicChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *JoinRequestItem) GetUserId() string {
+func (x *ListPublicChannelsRequest) GetQuery() string {
 	if x != nil {
-		return x.UserId
+		return x.Query
 	}
 	return ""
 }
 
-func (x *JoinRequestItem) GetMessage() string {
+func (x *ListPublicChannelsRequest) GetCursor() string {
 	if x != nil {
-		return x.Message
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *JoinRequestItem) GetCreatedAt() int64 {
+func (x *ListPublicChannelsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Limit
 	}
 	return 0
 }
 
-type ListJoinRequestsResponse struct {
+type ListChannelsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*JoinRequestItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*ConversationInfo    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJoinRequestsResponse) Reset() {
-	*x = ListJoinRequestsResponse{}
-	mi := &file_proto_conversation_proto_msgTypes[20]
+func (x *ListChannelsResponse) Reset() {
+	*x = ListChannelsResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJoinRequestsResponse) String() string {
+func (x *ListChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJoinRequestsResponse) ProtoMessage() {}
+func (*ListChannelsResponse) ProtoMessage() {}
 
-func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[20]
+func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,42 +4530,48 @@ func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJoinRequestsResponse.ProtoReflect.Descriptor instead.
-func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ListJoinRequestsResponse) GetItems() []*JoinRequestItem {
+func (x *ListChannelsResponse) GetItems() []*ConversationInfo {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-type ApproveJoinRequestRequest struct {
+func (x *ListChannelsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type FilterChannelSubscribersRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	RequestId      string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 审批人（群主/管理员）
+	UserIds        []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // 单次最多 1000 个
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ApproveJoinRequestRequest) Reset() {
-	*x = ApproveJoinRequestRequest{}
-	mi := &file_proto_conversation_proto_msgTypes[21]
+func (x *FilterChannelSubscribersRequest) Reset() {
+	*x = FilterChannelSubscribersRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveJoinRequestRequest) String() string {
+func (x *FilterChannelSubscribersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveJoinRequestRequest) ProtoMessage() {}
+func (*FilterChannelSubscribersRequest) ProtoMessage() {}
 
-func (x *ApproveJoinRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[21]
+func (x *FilterChannelSubscribersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,53 +4582,47 @@ func (x *ApproveJoinRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveJoinRequestRequest.ProtoReflect.Descriptor instead.
-func (*ApproveJoinRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use FilterChannelSubscribersRequest.ProtoReflect.Descriptor instead.
+func (*FilterChannelSubscribersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ApproveJoinRequestRequest) GetConversationId() string {
+func (x *FilterChannelSubscribersRequest) GetConversationId() string {
 	if x != nil {
 		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *ApproveJoinRequestRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (x *ApproveJoinRequestRequest) GetUserId() string {
+func (x *FilterChannelSubscribersRequest) GetUserIds() []string {
 	if x != nil {
-		return x.UserId
+		return x.UserIds
 	}
-	return ""
+	return nil
 }
 
-type ApproveJoinRequestResponse struct {
+type FilterChannelSubscribersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // 其中订阅了该频道的用户
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveJoinRequestResponse) Reset() {
-	*x = ApproveJoinRequestResponse{}
-	mi := &file_proto_conversation_proto_msgTypes[22]
+func (x *FilterChannelSubscribersResponse) Reset() {
+	*x = FilterChannelSubscribersResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveJoinRequestResponse) String() string {
+func (x *FilterChannelSubscribersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveJoinRequestResponse) ProtoMessage() {}
+func (*FilterChannelSubscribersResponse) ProtoMessage() {}
 
-func (x *ApproveJoinRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[22]
+func (x *FilterChannelSubscribersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,35 +4633,40 @@ func (x *ApproveJoinRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveJoinRequestResponse.ProtoReflect.Descriptor instead.
-func (*ApproveJoinRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use FilterChannelSubscribersResponse.ProtoReflect.Descriptor instead.
+func (*FilterChannelSubscribersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{80}
 }
 
-type DeclineJoinRequestRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	RequestId      string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *FilterChannelSubscribersResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
 }
 
-func (x *DeclineJoinRequestRequest) Reset() {
-	*x = DeclineJoinRequestRequest{}
-	mi := &file_proto_conversation_proto_msgTypes[23]
+type RemoveUserEverywhereRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveUserEverywhereRequest) Reset() {
+	*x = RemoveUserEverywhereRequest{}
+	mi := &file_proto_conversation_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeclineJoinRequestRequest) String() string {
+func (x *RemoveUserEverywhereRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeclineJoinRequestRequest) ProtoMessage() {}
+func (*RemoveUserEverywhereRequest) ProtoMessage() {}
 
-func (x *DeclineJoinRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[23]
+func (x *RemoveUserEverywhereRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1275,53 +4677,39 @@ func (x *DeclineJoinRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeclineJoinRequestRequest.ProtoReflect.Descriptor instead.
-func (*DeclineJoinRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *DeclineJoinRequestRequest) GetConversationId() string {
-	if x != nil {
-		return x.ConversationId
-	}
-	return ""
-}
-
-func (x *DeclineJoinRequestRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
+// Deprecated: Use RemoveUserEverywhereRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserEverywhereRequest) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *DeclineJoinRequestRequest) GetUserId() string {
+func (x *RemoveUserEverywhereRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type DeclineJoinRequestResponse struct {
+type RemoveUserEverywhereResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeclineJoinRequestResponse) Reset() {
-	*x = DeclineJoinRequestResponse{}
-	mi := &file_proto_conversation_proto_msgTypes[24]
+func (x *RemoveUserEverywhereResponse) Reset() {
+	*x = RemoveUserEverywhereResponse{}
+	mi := &file_proto_conversation_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeclineJoinRequestResponse) String() string {
+func (x *RemoveUserEverywhereResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeclineJoinRequestResponse) ProtoMessage() {}
+func (*RemoveUserEverywhereResponse) ProtoMessage() {}
 
-func (x *DeclineJoinRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_conversation_proto_msgTypes[24]
+func (x *RemoveUserEverywhereResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_conversation_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,32 +4720,39 @@ func (x *DeclineJoinRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeclineJoinRequestResponse.ProtoReflect.Descriptor instead.
-func (*DeclineJoinRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_conversation_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RemoveUserEverywhereResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUserEverywhereResponse) Descriptor() ([]byte, []int) {
+	return file_proto_conversation_proto_rawDescGZIP(), []int{82}
 }
 
 var File_proto_conversation_proto protoreflect.FileDescriptor
 
 const file_proto_conversation_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/conversation.proto\x12\x14beehive.conversation\"b\n" +
+	"\x18proto/conversation.proto\x12\x14beehive.conversation\x1a google/protobuf/field_mask.proto\"\xa0\x01\n" +
 	"\x19CreateConversationRequest\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
-	"member_ids\x18\x03 \x03(\tR\tmemberIds\"E\n" +
+	"member_ids\x18\x03 \x03(\tR\tmemberIds\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\tR\n" +
+	"operatorId\x12\x1b\n" +
+	"\tis_public\x18\x05 \x01(\bR\bisPublic\"E\n" +
 	"\x1aCreateConversationResponse\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"h\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"\x89\x01\n" +
 	"\x10AddMemberRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\"\x13\n" +
-	"\x11AddMemberResponse\"W\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\tR\n" +
+	"operatorId\"\x13\n" +
+	"\x11AddMemberResponse\"x\n" +
 	"\x13RemoveMemberRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x16\n" +
-	"\x14RemoveMemberResponse\"\xf3\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\tR\n" +
+	"operatorId\"\x16\n" +
+	"\x14RemoveMemberResponse\"\x84\x05\n" +
 	"\x10ConversationInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
@@ -1367,29 +4762,98 @@ const file_proto_conversation_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12$\n" +
 	"\x0elast_active_at\x18\x06 \x01(\x03R\flastActiveAt\x12\"\n" +
 	"\fannouncement\x18\a \x01(\tR\fannouncement\x12\x1b\n" +
-	"\tjoin_type\x18\b \x01(\tR\bjoinType\"e\n" +
+	"\tjoin_type\x18\b \x01(\tR\bjoinType\x12.\n" +
+	"\x13message_ttl_seconds\x18\t \x01(\x05R\x11messageTtlSeconds\x12@\n" +
+	"\bsettings\x18\n" +
+	" \x01(\v2$.beehive.conversation.MemberSettingsR\bsettings\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\v \x01(\tR\tavatarUrl\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x19\n" +
+	"\bmute_all\x18\r \x01(\bR\amuteAll\x12\x1b\n" +
+	"\tis_public\x18\x0e \x01(\bR\bisPublic\x12)\n" +
+	"\x10subscriber_count\x18\x0f \x01(\x03R\x0fsubscriberCount\x12#\n" +
+	"\rmember_status\x18\x10 \x01(\tR\fmemberStatus\x12)\n" +
+	"\x10activity_changed\x18\x11 \x01(\bR\x0factivityChanged\x12\x12\n" +
+	"\x04tier\x18\x12 \x01(\tR\x04tier\x12\x1f\n" +
+	"\vmax_members\x18\x13 \x01(\x05R\n" +
+	"maxMembers\"\xb0\x01\n" +
+	"\x0eMemberSettings\x12\x1f\n" +
+	"\vmuted_until\x18\x01 \x01(\x03R\n" +
+	"mutedUntil\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\x12\x1b\n" +
+	"\tpinned_at\x18\x03 \x01(\x03R\bpinnedAt\x12\x1a\n" +
+	"\barchived\x18\x04 \x01(\bR\barchived\x12\x16\n" +
+	"\x06hidden\x18\x05 \x01(\bR\x06hidden\x12\x14\n" +
+	"\x05alias\x18\x06 \x01(\tR\x05alias\"\xc7\x01\n" +
 	"\x1cListUserConversationsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"~\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\barchived\x18\x04 \x01(\bR\barchived\x12%\n" +
+	"\x0einclude_hidden\x18\x05 \x01(\bR\rincludeHidden\x12\x1d\n" +
+	"\n" +
+	"sync_token\x18\x06 \x01(\tR\tsyncToken\"\xb8\x01\n" +
 	"\x1dListUserConversationsResponse\x12<\n" +
 	"\x05items\x18\x01 \x03(\v2&.beehive.conversation.ConversationInfoR\x05items\x12\x1f\n" +
 	"\vnext_cursor\x18\x02 \x01(\tR\n" +
-	"nextCursor\"(\n" +
+	"nextCursor\x12\x1d\n" +
+	"\n" +
+	"sync_token\x18\x03 \x01(\tR\tsyncToken\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"(\n" +
 	"\x16GetConversationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
 	"\x17GetConversationResponse\x12J\n" +
-	"\fconversation\x18\x01 \x01(\v2&.beehive.conversation.ConversationInfoR\fconversation\"=\n" +
+	"\fconversation\x18\x01 \x01(\v2&.beehive.conversation.ConversationInfoR\fconversation\"\xf7\x01\n" +
 	"\x12ListMembersRequest\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\"n\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x14\n" +
+	"\x05query\x18\x05 \x01(\tR\x05query\x12\x14\n" +
+	"\x05roles\x18\x06 \x03(\tR\x05roles\x12\x1a\n" +
+	"\bstatuses\x18\a \x03(\tR\bstatuses\x12!\n" +
+	"\fwith_profile\x18\b \x01(\bR\vwithProfile\"\xa1\x02\n" +
 	"\n" +
 	"MemberInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1b\n" +
 	"\tjoined_at\x18\x03 \x01(\x03R\bjoinedAt\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\"M\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
+	"\vmuted_until\x18\x05 \x01(\x03R\n" +
+	"mutedUntil\x12*\n" +
+	"\x11speak_muted_until\x18\x06 \x01(\x03R\x0fspeakMutedUntil\x12\x1a\n" +
+	"\bnickname\x18\a \x01(\tR\bnickname\x12)\n" +
+	"\x10profile_nickname\x18\b \x01(\tR\x0fprofileNickname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\t \x01(\tR\tavatarUrl\"n\n" +
 	"\x13ListMembersResponse\x126\n" +
-	"\x05items\x18\x01 \x03(\v2 .beehive.conversation.MemberInfoR\x05items\"_\n" +
+	"\x05items\x18\x01 \x03(\v2 .beehive.conversation.MemberInfoR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"`\n" +
+	"\x16StreamMemberIDsRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\"Q\n" +
+	"\x0eMemberIDsChunk\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\x12$\n" +
+	"\x0emuted_user_ids\x18\x02 \x03(\tR\fmutedUserIds\"x\n" +
+	"\x18SetMemberNicknameRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\"\x1b\n" +
+	"\x19SetMemberNicknameResponse\"\xa1\x01\n" +
+	"\x1eSetConversationCapacityRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\x04tier\x18\x02 \x01(\tH\x00R\x04tier\x88\x01\x01\x12$\n" +
+	"\vmax_members\x18\x03 \x01(\x05H\x01R\n" +
+	"maxMembers\x88\x01\x01B\a\n" +
+	"\x05_tierB\x0e\n" +
+	"\f_max_members\"V\n" +
+	"\x1fSetConversationCapacityResponse\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\tR\x04tier\x12\x1f\n" +
+	"\vmax_members\x18\x02 \x01(\x05R\n" +
+	"maxMembers\"_\n" +
 	"%FindOrCreateSingleConversationRequest\x12\x1a\n" +
 	"\tuser_id_1\x18\x01 \x01(\tR\auserId1\x12\x1a\n" +
 	"\tuser_id_2\x18\x02 \x01(\tR\auserId2\"Q\n" +
@@ -1427,20 +4891,243 @@ const file_proto_conversation_proto_rawDesc = "" +
 	"\n" +
 	"request_id\x18\x02 \x01(\tR\trequestId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x1c\n" +
-	"\x1aDeclineJoinRequestResponse2\x9a\n" +
+	"\x1aDeclineJoinRequestResponse\"y\n" +
+	"\x14SetMessageTTLRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds\"\x17\n" +
+	"\x15SetMessageTTLResponse\"\xb8\x02\n" +
+	"\x1bUpdateMemberSettingsRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12$\n" +
+	"\vmuted_until\x18\x03 \x01(\x03H\x00R\n" +
+	"mutedUntil\x88\x01\x01\x12\x1b\n" +
+	"\x06pinned\x18\x04 \x01(\bH\x01R\x06pinned\x88\x01\x01\x12\x1f\n" +
+	"\barchived\x18\x05 \x01(\bH\x02R\barchived\x88\x01\x01\x12\x1b\n" +
+	"\x06hidden\x18\x06 \x01(\bH\x03R\x06hidden\x88\x01\x01\x12\x19\n" +
+	"\x05alias\x18\a \x01(\tH\x04R\x05alias\x88\x01\x01B\x0e\n" +
+	"\f_muted_untilB\t\n" +
+	"\a_pinnedB\v\n" +
+	"\t_archivedB\t\n" +
+	"\a_hiddenB\b\n" +
+	"\x06_alias\"`\n" +
+	"\x1cUpdateMemberSettingsResponse\x12@\n" +
+	"\bsettings\x18\x01 \x01(\v2$.beehive.conversation.MemberSettingsR\bsettings\"\x8d\x01\n" +
+	"\x14SetMemberRoleRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"\x17\n" +
+	"\x15SetMemberRoleResponse\"\x86\x01\n" +
+	"\x18TransferOwnershipRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12 \n" +
+	"\fnew_owner_id\x18\x03 \x01(\tR\n" +
+	"newOwnerId\"\x1b\n" +
+	"\x19TransferOwnershipResponse\"\\\n" +
+	"\x18LeaveConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1b\n" +
+	"\x19LeaveConversationResponse\"\x96\x02\n" +
+	"\x19UpdateConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
+	"\fannouncement\x18\x04 \x01(\tR\fannouncement\x12\x1b\n" +
+	"\tjoin_type\x18\x05 \x01(\tR\bjoinType\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x06 \x01(\tR\tavatarUrl\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"h\n" +
+	"\x1aUpdateConversationResponse\x12J\n" +
+	"\fconversation\x18\x01 \x01(\v2&.beehive.conversation.ConversationInfoR\fconversation\"x\n" +
+	"\x10AnnouncementInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"r\n" +
+	"\x18ListAnnouncementsRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"Y\n" +
+	"\x19ListAnnouncementsResponse\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.beehive.conversation.AnnouncementInfoR\x05items\"\xb7\x02\n" +
+	"\x0eInviteLinkInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1d\n" +
 	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\x05R\amaxUses\x12\x1b\n" +
+	"\tuse_count\x18\a \x01(\x05R\buseCount\x12'\n" +
+	"\x0fbypass_approval\x18\b \x01(\bR\x0ebypassApproval\x12\x18\n" +
+	"\arevoked\x18\t \x01(\bR\arevoked\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\"\xc6\x01\n" +
+	"\x17CreateInviteLinkRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUses\x12'\n" +
+	"\x0fbypass_approval\x18\x05 \x01(\bR\x0ebypassApproval\"T\n" +
+	"\x18CreateInviteLinkResponse\x128\n" +
+	"\x04link\x18\x01 \x01(\v2$.beehive.conversation.InviteLinkInfoR\x04link\"|\n" +
+	"\x17RevokeInviteLinkRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x17\n" +
+	"\alink_id\x18\x03 \x01(\tR\x06linkId\"\x1a\n" +
+	"\x18RevokeInviteLinkResponse\"\x8d\x01\n" +
+	"\x16ListInviteLinksRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12)\n" +
+	"\x10include_inactive\x18\x03 \x01(\bR\x0fincludeInactive\"U\n" +
+	"\x17ListInviteLinksResponse\x12:\n" +
+	"\x05items\x18\x01 \x03(\v2$.beehive.conversation.InviteLinkInfoR\x05items\"0\n" +
+	"\x18PreviewInviteLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xe2\x01\n" +
+	"\x19PreviewInviteLinkResponse\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12!\n" +
+	"\fmember_count\x18\x04 \x01(\x05R\vmemberCount\x12'\n" +
+	"\x0fbypass_approval\x18\x05 \x01(\bR\x0ebypassApproval\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\"^\n" +
+	"\x13JoinByInviteRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"W\n" +
+	"\x14JoinByInviteResponse\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x16\n" +
+	"\x06joined\x18\x02 \x01(\bR\x06joined\"g\n" +
+	"\x1bDissolveConversationRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\"\x1e\n" +
+	"\x1cDissolveConversationResponse\"\x8d\x01\n" +
+	"\x10BanMemberRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x13\n" +
+	"\x11BanMemberResponse\"w\n" +
+	"\x12UnbanMemberRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x15\n" +
+	"\x13UnbanMemberResponse\"d\n" +
+	"\x18ListBannedMembersRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\"S\n" +
+	"\x19ListBannedMembersResponse\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .beehive.conversation.MemberInfoR\x05items\"w\n" +
+	"\x11SetMuteAllRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\"\x14\n" +
+	"\x12SetMuteAllResponse\"\xa1\x01\n" +
+	"\x11MuteMemberRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12)\n" +
+	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\"@\n" +
+	"\x12MuteMemberResponse\x12*\n" +
+	"\x11speak_muted_until\x18\x01 \x01(\x03R\x0fspeakMutedUntil\"x\n" +
+	"\x13UnmuteMemberRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x16\n" +
+	"\x14UnmuteMemberResponse\"[\n" +
+	"\x17SubscribeChannelRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"E\n" +
+	"\x18SubscribeChannelResponse\x12)\n" +
+	"\x10subscriber_count\x18\x01 \x01(\x03R\x0fsubscriberCount\"]\n" +
+	"\x19UnsubscribeChannelRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1c\n" +
+	"\x1aUnsubscribeChannelResponse\"f\n" +
+	"\x1dListSubscribedChannelsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"_\n" +
+	"\x19ListPublicChannelsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"u\n" +
+	"\x14ListChannelsResponse\x12<\n" +
+	"\x05items\x18\x01 \x03(\v2&.beehive.conversation.ConversationInfoR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"e\n" +
+	"\x1fFilterChannelSubscribersRequest\x12'\n" +
+	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"=\n" +
+	" FilterChannelSubscribersResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"6\n" +
+	"\x1bRemoveUserEverywhereRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1e\n" +
+	"\x1cRemoveUserEverywhereResponse2\xa8#\n" +
 	"\x13ConversationService\x12w\n" +
 	"\x12CreateConversation\x12/.beehive.conversation.CreateConversationRequest\x1a0.beehive.conversation.CreateConversationResponse\x12\\\n" +
 	"\tAddMember\x12&.beehive.conversation.AddMemberRequest\x1a'.beehive.conversation.AddMemberResponse\x12e\n" +
 	"\fRemoveMember\x12).beehive.conversation.RemoveMemberRequest\x1a*.beehive.conversation.RemoveMemberResponse\x12\x80\x01\n" +
 	"\x15ListUserConversations\x122.beehive.conversation.ListUserConversationsRequest\x1a3.beehive.conversation.ListUserConversationsResponse\x12n\n" +
 	"\x0fGetConversation\x12,.beehive.conversation.GetConversationRequest\x1a-.beehive.conversation.GetConversationResponse\x12b\n" +
-	"\vListMembers\x12(.beehive.conversation.ListMembersRequest\x1a).beehive.conversation.ListMembersResponse\x12\x9b\x01\n" +
+	"\vListMembers\x12(.beehive.conversation.ListMembersRequest\x1a).beehive.conversation.ListMembersResponse\x12g\n" +
+	"\x0fStreamMemberIDs\x12,.beehive.conversation.StreamMemberIDsRequest\x1a$.beehive.conversation.MemberIDsChunk0\x01\x12t\n" +
+	"\x11SetMemberNickname\x12..beehive.conversation.SetMemberNicknameRequest\x1a/.beehive.conversation.SetMemberNicknameResponse\x12\x86\x01\n" +
+	"\x17SetConversationCapacity\x124.beehive.conversation.SetConversationCapacityRequest\x1a5.beehive.conversation.SetConversationCapacityResponse\x12\x9b\x01\n" +
 	"\x1eFindOrCreateSingleConversation\x12;.beehive.conversation.FindOrCreateSingleConversationRequest\x1a<.beehive.conversation.FindOrCreateSingleConversationResponse\x12k\n" +
 	"\x0eApplyJoinGroup\x12+.beehive.conversation.ApplyJoinGroupRequest\x1a,.beehive.conversation.ApplyJoinGroupResponse\x12q\n" +
 	"\x10ListJoinRequests\x12-.beehive.conversation.ListJoinRequestsRequest\x1a..beehive.conversation.ListJoinRequestsResponse\x12w\n" +
 	"\x12ApproveJoinRequest\x12/.beehive.conversation.ApproveJoinRequestRequest\x1a0.beehive.conversation.ApproveJoinRequestResponse\x12w\n" +
-	"\x12DeclineJoinRequest\x12/.beehive.conversation.DeclineJoinRequestRequest\x1a0.beehive.conversation.DeclineJoinRequestResponseB\x1cZ\x1a./services/conversation/pbb\x06proto3"
+	"\x12DeclineJoinRequest\x12/.beehive.conversation.DeclineJoinRequestRequest\x1a0.beehive.conversation.DeclineJoinRequestResponse\x12h\n" +
+	"\rSetMessageTTL\x12*.beehive.conversation.SetMessageTTLRequest\x1a+.beehive.conversation.SetMessageTTLResponse\x12}\n" +
+	"\x14UpdateMemberSettings\x121.beehive.conversation.UpdateMemberSettingsRequest\x1a2.beehive.conversation.UpdateMemberSettingsResponse\x12h\n" +
+	"\rSetMemberRole\x12*.beehive.conversation.SetMemberRoleRequest\x1a+.beehive.conversation.SetMemberRoleResponse\x12t\n" +
+	"\x11TransferOwnership\x12..beehive.conversation.TransferOwnershipRequest\x1a/.beehive.conversation.TransferOwnershipResponse\x12t\n" +
+	"\x11LeaveConversation\x12..beehive.conversation.LeaveConversationRequest\x1a/.beehive.conversation.LeaveConversationResponse\x12w\n" +
+	"\x12UpdateConversation\x12/.beehive.conversation.UpdateConversationRequest\x1a0.beehive.conversation.UpdateConversationResponse\x12t\n" +
+	"\x11ListAnnouncements\x12..beehive.conversation.ListAnnouncementsRequest\x1a/.beehive.conversation.ListAnnouncementsResponse\x12q\n" +
+	"\x10CreateInviteLink\x12-.beehive.conversation.CreateInviteLinkRequest\x1a..beehive.conversation.CreateInviteLinkResponse\x12q\n" +
+	"\x10RevokeInviteLink\x12-.beehive.conversation.RevokeInviteLinkRequest\x1a..beehive.conversation.RevokeInviteLinkResponse\x12n\n" +
+	"\x0fListInviteLinks\x12,.beehive.conversation.ListInviteLinksRequest\x1a-.beehive.conversation.ListInviteLinksResponse\x12t\n" +
+	"\x11PreviewInviteLink\x12..beehive.conversation.PreviewInviteLinkRequest\x1a/.beehive.conversation.PreviewInviteLinkResponse\x12e\n" +
+	"\fJoinByInvite\x12).beehive.conversation.JoinByInviteRequest\x1a*.beehive.conversation.JoinByInviteResponse\x12}\n" +
+	"\x14DissolveConversation\x121.beehive.conversation.DissolveConversationRequest\x1a2.beehive.conversation.DissolveConversationResponse\x12\\\n" +
+	"\tBanMember\x12&.beehive.conversation.BanMemberRequest\x1a'.beehive.conversation.BanMemberResponse\x12b\n" +
+	"\vUnbanMember\x12(.beehive.conversation.UnbanMemberRequest\x1a).beehive.conversation.UnbanMemberResponse\x12t\n" +
+	"\x11ListBannedMembers\x12..beehive.conversation.ListBannedMembersRequest\x1a/.beehive.conversation.ListBannedMembersResponse\x12_\n" +
+	"\n" +
+	"SetMuteAll\x12'.beehive.conversation.SetMuteAllRequest\x1a(.beehive.conversation.SetMuteAllResponse\x12_\n" +
+	"\n" +
+	"MuteMember\x12'.beehive.conversation.MuteMemberRequest\x1a(.beehive.conversation.MuteMemberResponse\x12e\n" +
+	"\fUnmuteMember\x12).beehive.conversation.UnmuteMemberRequest\x1a*.beehive.conversation.UnmuteMemberResponse\x12q\n" +
+	"\x10SubscribeChannel\x12-.beehive.conversation.SubscribeChannelRequest\x1a..beehive.conversation.SubscribeChannelResponse\x12w\n" +
+	"\x12UnsubscribeChannel\x12/.beehive.conversation.UnsubscribeChannelRequest\x1a0.beehive.conversation.UnsubscribeChannelResponse\x12y\n" +
+	"\x16ListSubscribedChannels\x123.beehive.conversation.ListSubscribedChannelsRequest\x1a*.beehive.conversation.ListChannelsResponse\x12q\n" +
+	"\x12ListPublicChannels\x12/.beehive.conversation.ListPublicChannelsRequest\x1a*.beehive.conversation.ListChannelsResponse\x12\x89\x01\n" +
+	"\x18FilterChannelSubscribers\x125.beehive.conversation.FilterChannelSubscribersRequest\x1a6.beehive.conversation.FilterChannelSubscribersResponse\x12}\n" +
+	"\x14RemoveUserEverywhere\x121.beehive.conversation.RemoveUserEverywhereRequest\x1a2.beehive.conversation.RemoveUserEverywhereResponseB\x1cZ\x1a./services/conversation/pbb\x06proto3"
 
 var (
 	file_proto_conversation_proto_rawDescOnce sync.Once
@@ -1454,7 +5141,7 @@ func file_proto_conversation_proto_rawDescGZIP() []byte {
 	return file_proto_conversation_proto_rawDescData
 }
 
-var file_proto_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_proto_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_proto_conversation_proto_goTypes = []any{
 	(*CreateConversationRequest)(nil),              // 0: beehive.conversation.CreateConversationRequest
 	(*CreateConversationResponse)(nil),             // 1: beehive.conversation.CreateConversationResponse
@@ -1463,57 +5150,181 @@ var file_proto_conversation_proto_goTypes = []any{
 	(*RemoveMemberRequest)(nil),                    // 4: beehive.conversation.RemoveMemberRequest
 	(*RemoveMemberResponse)(nil),                   // 5: beehive.conversation.RemoveMemberResponse
 	(*ConversationInfo)(nil),                       // 6: beehive.conversation.ConversationInfo
-	(*ListUserConversationsRequest)(nil),           // 7: beehive.conversation.ListUserConversationsRequest
-	(*ListUserConversationsResponse)(nil),          // 8: beehive.conversation.ListUserConversationsResponse
-	(*GetConversationRequest)(nil),                 // 9: beehive.conversation.GetConversationRequest
-	(*GetConversationResponse)(nil),                // 10: beehive.conversation.GetConversationResponse
-	(*ListMembersRequest)(nil),                     // 11: beehive.conversation.ListMembersRequest
-	(*MemberInfo)(nil),                             // 12: beehive.conversation.MemberInfo
-	(*ListMembersResponse)(nil),                    // 13: beehive.conversation.ListMembersResponse
-	(*FindOrCreateSingleConversationRequest)(nil),  // 14: beehive.conversation.FindOrCreateSingleConversationRequest
-	(*FindOrCreateSingleConversationResponse)(nil), // 15: beehive.conversation.FindOrCreateSingleConversationResponse
-	(*ApplyJoinGroupRequest)(nil),                  // 16: beehive.conversation.ApplyJoinGroupRequest
-	(*ApplyJoinGroupResponse)(nil),                 // 17: beehive.conversation.ApplyJoinGroupResponse
-	(*ListJoinRequestsRequest)(nil),                // 18: beehive.conversation.ListJoinRequestsRequest
-	(*JoinRequestItem)(nil),                        // 19: beehive.conversation.JoinRequestItem
-	(*ListJoinRequestsResponse)(nil),               // 20: beehive.conversation.ListJoinRequestsResponse
-	(*ApproveJoinRequestRequest)(nil),              // 21: beehive.conversation.ApproveJoinRequestRequest
-	(*ApproveJoinRequestResponse)(nil),             // 22: beehive.conversation.ApproveJoinRequestResponse
-	(*DeclineJoinRequestRequest)(nil),              // 23: beehive.conversation.DeclineJoinRequestRequest
-	(*DeclineJoinRequestResponse)(nil),             // 24: beehive.conversation.DeclineJoinRequestResponse
+	(*MemberSettings)(nil),                         // 7: beehive.conversation.MemberSettings
+	(*ListUserConversationsRequest)(nil),           // 8: beehive.conversation.ListUserConversationsRequest
+	(*ListUserConversationsResponse)(nil),          // 9: beehive.conversation.ListUserConversationsResponse
+	(*GetConversationRequest)(nil),                 // 10: beehive.conversation.GetConversationRequest
+	(*GetConversationResponse)(nil),                // 11: beehive.conversation.GetConversationResponse
+	(*ListMembersRequest)(nil),                     // 12: beehive.conversation.ListMembersRequest
+	(*MemberInfo)(nil),                             // 13: beehive.conversation.MemberInfo
+	(*ListMembersResponse)(nil),                    // 14: beehive.conversation.ListMembersResponse
+	(*StreamMemberIDsRequest)(nil),                 // 15: beehive.conversation.StreamMemberIDsRequest
+	(*MemberIDsChunk)(nil),                         // 16: beehive.conversation.MemberIDsChunk
+	(*SetMemberNicknameRequest)(nil),               // 17: beehive.conversation.SetMemberNicknameRequest
+	(*SetMemberNicknameResponse)(nil),              // 18: beehive.conversation.SetMemberNicknameResponse
+	(*SetConversationCapacityRequest)(nil),         // 19: beehive.conversation.SetConversationCapacityRequest
+	(*SetConversationCapacityResponse)(nil),        // 20: beehive.conversation.SetConversationCapacityResponse
+	(*FindOrCreateSingleConversationRequest)(nil),  // 21: beehive.conversation.FindOrCreateSingleConversationRequest
+	(*FindOrCreateSingleConversationResponse)(nil), // 22: beehive.conversation.FindOrCreateSingleConversationResponse
+	(*ApplyJoinGroupRequest)(nil),                  // 23: beehive.conversation.ApplyJoinGroupRequest
+	(*ApplyJoinGroupResponse)(nil),                 // 24: beehive.conversation.ApplyJoinGroupResponse
+	(*ListJoinRequestsRequest)(nil),                // 25: beehive.conversation.ListJoinRequestsRequest
+	(*JoinRequestItem)(nil),                        // 26: beehive.conversation.JoinRequestItem
+	(*ListJoinRequestsResponse)(nil),               // 27: beehive.conversation.ListJoinRequestsResponse
+	(*ApproveJoinRequestRequest)(nil),              // 28: beehive.conversation.ApproveJoinRequestRequest
+	(*ApproveJoinRequestResponse)(nil),             // 29: beehive.conversation.ApproveJoinRequestResponse
+	(*DeclineJoinRequestRequest)(nil),              // 30: beehive.conversation.DeclineJoinRequestRequest
+	(*DeclineJoinRequestResponse)(nil),             // 31: beehive.conversation.DeclineJoinRequestResponse
+	(*SetMessageTTLRequest)(nil),                   // 32: beehive.conversation.SetMessageTTLRequest
+	(*SetMessageTTLResponse)(nil),                  // 33: beehive.conversation.SetMessageTTLResponse
+	(*UpdateMemberSettingsRequest)(nil),            // 34: beehive.conversation.UpdateMemberSettingsRequest
+	(*UpdateMemberSettingsResponse)(nil),           // 35: beehive.conversation.UpdateMemberSettingsResponse
+	(*SetMemberRoleRequest)(nil),                   // 36: beehive.conversation.SetMemberRoleRequest
+	(*SetMemberRoleResponse)(nil),                  // 37: beehive.conversation.SetMemberRoleResponse
+	(*TransferOwnershipRequest)(nil),               // 38: beehive.conversation.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),              // 39: beehive.conversation.TransferOwnershipResponse
+	(*LeaveConversationRequest)(nil),               // 40: beehive.conversation.LeaveConversationRequest
+	(*LeaveConversationResponse)(nil),              // 41: beehive.conversation.LeaveConversationResponse
+	(*UpdateConversationRequest)(nil),              // 42: beehive.conversation.UpdateConversationRequest
+	(*UpdateConversationResponse)(nil),             // 43: beehive.conversation.UpdateConversationResponse
+	(*AnnouncementInfo)(nil),                       // 44: beehive.conversation.AnnouncementInfo
+	(*ListAnnouncementsRequest)(nil),               // 45: beehive.conversation.ListAnnouncementsRequest
+	(*ListAnnouncementsResponse)(nil),              // 46: beehive.conversation.ListAnnouncementsResponse
+	(*InviteLinkInfo)(nil),                         // 47: beehive.conversation.InviteLinkInfo
+	(*CreateInviteLinkRequest)(nil),                // 48: beehive.conversation.CreateInviteLinkRequest
+	(*CreateInviteLinkResponse)(nil),               // 49: beehive.conversation.CreateInviteLinkResponse
+	(*RevokeInviteLinkRequest)(nil),                // 50: beehive.conversation.RevokeInviteLinkRequest
+	(*RevokeInviteLinkResponse)(nil),               // 51: beehive.conversation.RevokeInviteLinkResponse
+	(*ListInviteLinksRequest)(nil),                 // 52: beehive.conversation.ListInviteLinksRequest
+	(*ListInviteLinksResponse)(nil),                // 53: beehive.conversation.ListInviteLinksResponse
+	(*PreviewInviteLinkRequest)(nil),               // 54: beehive.conversation.PreviewInviteLinkRequest
+	(*PreviewInviteLinkResponse)(nil),              // 55: beehive.conversation.PreviewInviteLinkResponse
+	(*JoinByInviteRequest)(nil),                    // 56: beehive.conversation.JoinByInviteRequest
+	(*JoinByInviteResponse)(nil),                   // 57: beehive.conversation.JoinByInviteResponse
+	(*DissolveConversationRequest)(nil),            // 58: beehive.conversation.DissolveConversationRequest
+	(*DissolveConversationResponse)(nil),           // 59: beehive.conversation.DissolveConversationResponse
+	(*BanMemberRequest)(nil),                       // 60: beehive.conversation.BanMemberRequest
+	(*BanMemberResponse)(nil),                      // 61: beehive.conversation.BanMemberResponse
+	(*UnbanMemberRequest)(nil),                     // 62: beehive.conversation.UnbanMemberRequest
+	(*UnbanMemberResponse)(nil),                    // 63: beehive.conversation.UnbanMemberResponse
+	(*ListBannedMembersRequest)(nil),               // 64: beehive.conversation.ListBannedMembersRequest
+	(*ListBannedMembersResponse)(nil),              // 65: beehive.conversation.ListBannedMembersResponse
+	(*SetMuteAllRequest)(nil),                      // 66: beehive.conversation.SetMuteAllRequest
+	(*SetMuteAllResponse)(nil),                     // 67: beehive.conversation.SetMuteAllResponse
+	(*MuteMemberRequest)(nil),                      // 68: beehive.conversation.MuteMemberRequest
+	(*MuteMemberResponse)(nil),                     // 69: beehive.conversation.MuteMemberResponse
+	(*UnmuteMemberRequest)(nil),                    // 70: beehive.conversation.UnmuteMemberRequest
+	(*UnmuteMemberResponse)(nil),                   // 71: beehive.conversation.UnmuteMemberResponse
+	(*SubscribeChannelRequest)(nil),                // 72: beehive.conversation.SubscribeChannelRequest
+	(*SubscribeChannelResponse)(nil),               // 73: beehive.conversation.SubscribeChannelResponse
+	(*UnsubscribeChannelRequest)(nil),              // 74: beehive.conversation.UnsubscribeChannelRequest
+	(*UnsubscribeChannelResponse)(nil),             // 75: beehive.conversation.UnsubscribeChannelResponse
+	(*ListSubscribedChannelsRequest)(nil),          // 76: beehive.conversation.ListSubscribedChannelsRequest
+	(*ListPublicChannelsRequest)(nil),              // 77: beehive.conversation.ListPublicChannelsRequest
+	(*ListChannelsResponse)(nil),                   // 78: beehive.conversation.ListChannelsResponse
+	(*FilterChannelSubscribersRequest)(nil),        // 79: beehive.conversation.FilterChannelSubscribersRequest
+	(*FilterChannelSubscribersResponse)(nil),       // 80: beehive.conversation.FilterChannelSubscribersResponse
+	(*RemoveUserEverywhereRequest)(nil),            // 81: beehive.conversation.RemoveUserEverywhereRequest
+	(*RemoveUserEverywhereResponse)(nil),           // 82: beehive.conversation.RemoveUserEverywhereResponse
+	(*fieldmaskpb.FieldMask)(nil),                  // 83: google.protobuf.FieldMask
 }
 var file_proto_conversation_proto_depIdxs = []int32{
-	6,  // 0: beehive.conversation.ListUserConversationsResponse.items:type_name -> beehive.conversation.ConversationInfo
-	6,  // 1: beehive.conversation.GetConversationResponse.conversation:type_name -> beehive.conversation.ConversationInfo
-	12, // 2: beehive.conversation.ListMembersResponse.items:type_name -> beehive.conversation.MemberInfo
-	19, // 3: beehive.conversation.ListJoinRequestsResponse.items:type_name -> beehive.conversation.JoinRequestItem
-	0,  // 4: beehive.conversation.ConversationService.CreateConversation:input_type -> beehive.conversation.CreateConversationRequest
-	2,  // 5: beehive.conversation.ConversationService.AddMember:input_type -> beehive.conversation.AddMemberRequest
-	4,  // 6: beehive.conversation.ConversationService.RemoveMember:input_type -> beehive.conversation.RemoveMemberRequest
-	7,  // 7: beehive.conversation.ConversationService.ListUserConversations:input_type -> beehive.conversation.ListUserConversationsRequest
-	9,  // 8: beehive.conversation.ConversationService.GetConversation:input_type -> beehive.conversation.GetConversationRequest
-	11, // 9: beehive.conversation.ConversationService.ListMembers:input_type -> beehive.conversation.ListMembersRequest
-	14, // 10: beehive.conversation.ConversationService.FindOrCreateSingleConversation:input_type -> beehive.conversation.FindOrCreateSingleConversationRequest
-	16, // 11: beehive.conversation.ConversationService.ApplyJoinGroup:input_type -> beehive.conversation.ApplyJoinGroupRequest
-	18, // 12: beehive.conversation.ConversationService.ListJoinRequests:input_type -> beehive.conversation.ListJoinRequestsRequest
-	21, // 13: beehive.conversation.ConversationService.ApproveJoinRequest:input_type -> beehive.conversation.ApproveJoinRequestRequest
-	23, // 14: beehive.conversation.ConversationService.DeclineJoinRequest:input_type -> beehive.conversation.DeclineJoinRequestRequest
-	1,  // 15: beehive.conversation.ConversationService.CreateConversation:output_type -> beehive.conversation.CreateConversationResponse
-	3,  // 16: beehive.conversation.ConversationService.AddMember:output_type -> beehive.conversation.AddMemberResponse
-	5,  // 17: beehive.conversation.ConversationService.RemoveMember:output_type -> beehive.conversation.RemoveMemberResponse
-	8,  // 18: beehive.conversation.ConversationService.ListUserConversations:output_type -> beehive.conversation.ListUserConversationsResponse
-	10, // 19: beehive.conversation.ConversationService.GetConversation:output_type -> beehive.conversation.GetConversationResponse
-	13, // 20: beehive.conversation.ConversationService.ListMembers:output_type -> beehive.conversation.ListMembersResponse
-	15, // 21: beehive.conversation.ConversationService.FindOrCreateSingleConversation:output_type -> beehive.conversation.FindOrCreateSingleConversationResponse
-	17, // 22: beehive.conversation.ConversationService.ApplyJoinGroup:output_type -> beehive.conversation.ApplyJoinGroupResponse
-	20, // 23: beehive.conversation.ConversationService.ListJoinRequests:output_type -> beehive.conversation.ListJoinRequestsResponse
-	22, // 24: beehive.conversation.ConversationService.ApproveJoinRequest:output_type -> beehive.conversation.ApproveJoinRequestResponse
-	24, // 25: beehive.conversation.ConversationService.DeclineJoinRequest:output_type -> beehive.conversation.DeclineJoinRequestResponse
-	15, // [15:26] is the sub-list for method output_type
-	4,  // [4:15] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	7,  // 0: beehive.conversation.ConversationInfo.settings:type_name -> beehive.conversation.MemberSettings
+	6,  // 1: beehive.conversation.ListUserConversationsResponse.items:type_name -> beehive.conversation.ConversationInfo
+	6,  // 2: beehive.conversation.GetConversationResponse.conversation:type_name -> beehive.conversation.ConversationInfo
+	13, // 3: beehive.conversation.ListMembersResponse.items:type_name -> beehive.conversation.MemberInfo
+	26, // 4: beehive.conversation.ListJoinRequestsResponse.items:type_name -> beehive.conversation.JoinRequestItem
+	7,  // 5: beehive.conversation.UpdateMemberSettingsResponse.settings:type_name -> beehive.conversation.MemberSettings
+	83, // 6: beehive.conversation.UpdateConversationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	6,  // 7: beehive.conversation.UpdateConversationResponse.conversation:type_name -> beehive.conversation.ConversationInfo
+	44, // 8: beehive.conversation.ListAnnouncementsResponse.items:type_name -> beehive.conversation.AnnouncementInfo
+	47, // 9: beehive.conversation.CreateInviteLinkResponse.link:type_name -> beehive.conversation.InviteLinkInfo
+	47, // 10: beehive.conversation.ListInviteLinksResponse.items:type_name -> beehive.conversation.InviteLinkInfo
+	13, // 11: beehive.conversation.ListBannedMembersResponse.items:type_name -> beehive.conversation.MemberInfo
+	6,  // 12: beehive.conversation.ListChannelsResponse.items:type_name -> beehive.conversation.ConversationInfo
+	0,  // 13: beehive.conversation.ConversationService.CreateConversation:input_type -> beehive.conversation.CreateConversationRequest
+	2,  // 14: beehive.conversation.ConversationService.AddMember:input_type -> beehive.conversation.AddMemberRequest
+	4,  // 15: beehive.conversation.ConversationService.RemoveMember:input_type -> beehive.conversation.RemoveMemberRequest
+	8,  // 16: beehive.conversation.ConversationService.ListUserConversations:input_type -> beehive.conversation.ListUserConversationsRequest
+	10, // 17: beehive.conversation.ConversationService.GetConversation:input_type -> beehive.conversation.GetConversationRequest
+	12, // 18: beehive.conversation.ConversationService.ListMembers:input_type -> beehive.conversation.ListMembersRequest
+	15, // 19: beehive.conversation.ConversationService.StreamMemberIDs:input_type -> beehive.conversation.StreamMemberIDsRequest
+	17, // 20: beehive.conversation.ConversationService.SetMemberNickname:input_type -> beehive.conversation.SetMemberNicknameRequest
+	19, // 21: beehive.conversation.ConversationService.SetConversationCapacity:input_type -> beehive.conversation.SetConversationCapacityRequest
+	21, // 22: beehive.conversation.ConversationService.FindOrCreateSingleConversation:input_type -> beehive.conversation.FindOrCreateSingleConversationRequest
+	23, // 23: beehive.conversation.ConversationService.ApplyJoinGroup:input_type -> beehive.conversation.ApplyJoinGroupRequest
+	25, // 24: beehive.conversation.ConversationService.ListJoinRequests:input_type -> beehive.conversation.ListJoinRequestsRequest
+	28, // 25: beehive.conversation.ConversationService.ApproveJoinRequest:input_type -> beehive.conversation.ApproveJoinRequestRequest
+	30, // 26: beehive.conversation.ConversationService.DeclineJoinRequest:input_type -> beehive.conversation.DeclineJoinRequestRequest
+	32, // 27: beehive.conversation.ConversationService.SetMessageTTL:input_type -> beehive.conversation.SetMessageTTLRequest
+	34, // 28: beehive.conversation.ConversationService.UpdateMemberSettings:input_type -> beehive.conversation.UpdateMemberSettingsRequest
+	36, // 29: beehive.conversation.ConversationService.SetMemberRole:input_type -> beehive.conversation.SetMemberRoleRequest
+	38, // 30: beehive.conversation.ConversationService.TransferOwnership:input_type -> beehive.conversation.TransferOwnershipRequest
+	40, // 31: beehive.conversation.ConversationService.LeaveConversation:input_type -> beehive.conversation.LeaveConversationRequest
+	42, // 32: beehive.conversation.ConversationService.UpdateConversation:input_type -> beehive.conversation.UpdateConversationRequest
+	45, // 33: beehive.conversation.ConversationService.ListAnnouncements:input_type -> beehive.conversation.ListAnnouncementsRequest
+	48, // 34: beehive.conversation.ConversationService.CreateInviteLink:input_type -> beehive.conversation.CreateInviteLinkRequest
+	50, // 35: beehive.conversation.ConversationService.RevokeInviteLink:input_type -> beehive.conversation.RevokeInviteLinkRequest
+	52, // 36: beehive.conversation.ConversationService.ListInviteLinks:input_type -> beehive.conversation.ListInviteLinksRequest
+	54, // 37: beehive.conversation.ConversationService.PreviewInviteLink:input_type -> beehive.conversation.PreviewInviteLinkRequest
+	56, // 38: beehive.conversation.ConversationService.JoinByInvite:input_type -> beehive.conversation.JoinByInviteRequest
+	58, // 39: beehive.conversation.ConversationService.DissolveConversation:input_type -> beehive.conversation.DissolveConversationRequest
+	60, // 40: beehive.conversation.ConversationService.BanMember:input_type -> beehive.conversation.BanMemberRequest
+	62, // 41: beehive.conversation.ConversationService.UnbanMember:input_type -> beehive.conversation.UnbanMemberRequest
+	64, // 42: beehive.conversation.ConversationService.ListBannedMembers:input_type -> beehive.conversation.ListBannedMembersRequest
+	66, // 43: beehive.conversation.ConversationService.SetMuteAll:input_type -> beehive.conversation.SetMuteAllRequest
+	68, // 44: beehive.conversation.ConversationService.MuteMember:input_type -> beehive.conversation.MuteMemberRequest
+	70, // 45: beehive.conversation.ConversationService.UnmuteMember:input_type -> beehive.conversation.UnmuteMemberRequest
+	72, // 46: beehive.conversation.ConversationService.SubscribeChannel:input_type -> beehive.conversation.SubscribeChannelRequest
+	74, // 47: beehive.conversation.ConversationService.UnsubscribeChannel:input_type -> beehive.conversation.UnsubscribeChannelRequest
+	76, // 48: beehive.conversation.ConversationService.ListSubscribedChannels:input_type -> beehive.conversation.ListSubscribedChannelsRequest
+	77, // 49: beehive.conversation.ConversationService.ListPublicChannels:input_type -> beehive.conversation.ListPublicChannelsRequest
+	79, // 50: beehive.conversation.ConversationService.FilterChannelSubscribers:input_type -> beehive.conversation.FilterChannelSubscribersRequest
+	81, // 51: beehive.conversation.ConversationService.RemoveUserEverywhere:input_type -> beehive.conversation.RemoveUserEverywhereRequest
+	1,  // 52: beehive.conversation.ConversationService.CreateConversation:output_type -> beehive.conversation.CreateConversationResponse
+	3,  // 53: beehive.conversation.ConversationService.AddMember:output_type -> beehive.conversation.AddMemberResponse
+	5,  // 54: beehive.conversation.ConversationService.RemoveMember:output_type -> beehive.conversation.RemoveMemberResponse
+	9,  // 55: beehive.conversation.ConversationService.ListUserConversations:output_type -> beehive.conversation.ListUserConversationsResponse
+	11, // 56: beehive.conversation.ConversationService.GetConversation:output_type -> beehive.conversation.GetConversationResponse
+	14, // 57: beehive.conversation.ConversationService.ListMembers:output_type -> beehive.conversation.ListMembersResponse
+	16, // 58: beehive.conversation.ConversationService.StreamMemberIDs:output_type -> beehive.conversation.MemberIDsChunk
+	18, // 59: beehive.conversation.ConversationService.SetMemberNickname:output_type -> beehive.conversation.SetMemberNicknameResponse
+	20, // 60: beehive.conversation.ConversationService.SetConversationCapacity:output_type -> beehive.conversation.SetConversationCapacityResponse
+	22, // 61: beehive.conversation.ConversationService.FindOrCreateSingleConversation:output_type -> beehive.conversation.FindOrCreateSingleConversationResponse
+	24, // 62: beehive.conversation.ConversationService.ApplyJoinGroup:output_type -> beehive.conversation.ApplyJoinGroupResponse
+	27, // 63: beehive.conversation.ConversationService.ListJoinRequests:output_type -> beehive.conversation.ListJoinRequestsResponse
+	29, // 64: beehive.conversation.ConversationService.ApproveJoinRequest:output_type -> beehive.conversation.ApproveJoinRequestResponse
+	31, // 65: beehive.conversation.ConversationService.DeclineJoinRequest:output_type -> beehive.conversation.DeclineJoinRequestResponse
+	33, // 66: beehive.conversation.ConversationService.SetMessageTTL:output_type -> beehive.conversation.SetMessageTTLResponse
+	35, // 67: beehive.conversation.ConversationService.UpdateMemberSettings:output_type -> beehive.conversation.UpdateMemberSettingsResponse
+	37, // 68: beehive.conversation.ConversationService.SetMemberRole:output_type -> beehive.conversation.SetMemberRoleResponse
+	39, // 69: beehive.conversation.ConversationService.TransferOwnership:output_type -> beehive.conversation.TransferOwnershipResponse
+	41, // 70: beehive.conversation.ConversationService.LeaveConversation:output_type -> beehive.conversation.LeaveConversationResponse
+	43, // 71: beehive.conversation.ConversationService.UpdateConversation:output_type -> beehive.conversation.UpdateConversationResponse
+	46, // 72: beehive.conversation.ConversationService.ListAnnouncements:output_type -> beehive.conversation.ListAnnouncementsResponse
+	49, // 73: beehive.conversation.ConversationService.CreateInviteLink:output_type -> beehive.conversation.CreateInviteLinkResponse
+	51, // 74: beehive.conversation.ConversationService.RevokeInviteLink:output_type -> beehive.conversation.RevokeInviteLinkResponse
+	53, // 75: beehive.conversation.ConversationService.ListInviteLinks:output_type -> beehive.conversation.ListInviteLinksResponse
+	55, // 76: beehive.conversation.ConversationService.PreviewInviteLink:output_type -> beehive.conversation.PreviewInviteLinkResponse
+	57, // 77: beehive.conversation.ConversationService.JoinByInvite:output_type -> beehive.conversation.JoinByInviteResponse
+	59, // 78: beehive.conversation.ConversationService.DissolveConversation:output_type -> beehive.conversation.DissolveConversationResponse
+	61, // 79: beehive.conversation.ConversationService.BanMember:output_type -> beehive.conversation.BanMemberResponse
+	63, // 80: beehive.conversation.ConversationService.UnbanMember:output_type -> beehive.conversation.UnbanMemberResponse
+	65, // 81: beehive.conversation.ConversationService.ListBannedMembers:output_type -> beehive.conversation.ListBannedMembersResponse
+	67, // 82: beehive.conversation.ConversationService.SetMuteAll:output_type -> beehive.conversation.SetMuteAllResponse
+	69, // 83: beehive.conversation.ConversationService.MuteMember:output_type -> beehive.conversation.MuteMemberResponse
+	71, // 84: beehive.conversation.ConversationService.UnmuteMember:output_type -> beehive.conversation.UnmuteMemberResponse
+	73, // 85: beehive.conversation.ConversationService.SubscribeChannel:output_type -> beehive.conversation.SubscribeChannelResponse
+	75, // 86: beehive.conversation.ConversationService.UnsubscribeChannel:output_type -> beehive.conversation.UnsubscribeChannelResponse
+	78, // 87: beehive.conversation.ConversationService.ListSubscribedChannels:output_type -> beehive.conversation.ListChannelsResponse
+	78, // 88: beehive.conversation.ConversationService.ListPublicChannels:output_type -> beehive.conversation.ListChannelsResponse
+	80, // 89: beehive.conversation.ConversationService.FilterChannelSubscribers:output_type -> beehive.conversation.FilterChannelSubscribersResponse
+	82, // 90: beehive.conversation.ConversationService.RemoveUserEverywhere:output_type -> beehive.conversation.RemoveUserEverywhereResponse
+	52, // [52:91] is the sub-list for method output_type
+	13, // [13:52] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_conversation_proto_init() }
@@ -1521,13 +5332,15 @@ func file_proto_conversation_proto_init() {
 	if File_proto_conversation_proto != nil {
 		return
 	}
+	file_proto_conversation_proto_msgTypes[19].OneofWrappers = []any{}
+	file_proto_conversation_proto_msgTypes[34].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_conversation_proto_rawDesc), len(file_proto_conversation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
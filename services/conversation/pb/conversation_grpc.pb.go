@@ -25,11 +25,39 @@ const (
 	ConversationService_ListUserConversations_FullMethodName          = "/beehive.conversation.ConversationService/ListUserConversations"
 	ConversationService_GetConversation_FullMethodName                = "/beehive.conversation.ConversationService/GetConversation"
 	ConversationService_ListMembers_FullMethodName                    = "/beehive.conversation.ConversationService/ListMembers"
+	ConversationService_StreamMemberIDs_FullMethodName                = "/beehive.conversation.ConversationService/StreamMemberIDs"
+	ConversationService_SetMemberNickname_FullMethodName              = "/beehive.conversation.ConversationService/SetMemberNickname"
+	ConversationService_SetConversationCapacity_FullMethodName        = "/beehive.conversation.ConversationService/SetConversationCapacity"
 	ConversationService_FindOrCreateSingleConversation_FullMethodName = "/beehive.conversation.ConversationService/FindOrCreateSingleConversation"
 	ConversationService_ApplyJoinGroup_FullMethodName                 = "/beehive.conversation.ConversationService/ApplyJoinGroup"
 	ConversationService_ListJoinRequests_FullMethodName               = "/beehive.conversation.ConversationService/ListJoinRequests"
 	ConversationService_ApproveJoinRequest_FullMethodName             = "/beehive.conversation.ConversationService/ApproveJoinRequest"
 	ConversationService_DeclineJoinRequest_FullMethodName             = "/beehive.conversation.ConversationService/DeclineJoinRequest"
+	ConversationService_SetMessageTTL_FullMethodName                  = "/beehive.conversation.ConversationService/SetMessageTTL"
+	ConversationService_UpdateMemberSettings_FullMethodName           = "/beehive.conversation.ConversationService/UpdateMemberSettings"
+	ConversationService_SetMemberRole_FullMethodName                  = "/beehive.conversation.ConversationService/SetMemberRole"
+	ConversationService_TransferOwnership_FullMethodName              = "/beehive.conversation.ConversationService/TransferOwnership"
+	ConversationService_LeaveConversation_FullMethodName              = "/beehive.conversation.ConversationService/LeaveConversation"
+	ConversationService_UpdateConversation_FullMethodName             = "/beehive.conversation.ConversationService/UpdateConversation"
+	ConversationService_ListAnnouncements_FullMethodName              = "/beehive.conversation.ConversationService/ListAnnouncements"
+	ConversationService_CreateInviteLink_FullMethodName               = "/beehive.conversation.ConversationService/CreateInviteLink"
+	ConversationService_RevokeInviteLink_FullMethodName               = "/beehive.conversation.ConversationService/RevokeInviteLink"
+	ConversationService_ListInviteLinks_FullMethodName                = "/beehive.conversation.ConversationService/ListInviteLinks"
+	ConversationService_PreviewInviteLink_FullMethodName              = "/beehive.conversation.ConversationService/PreviewInviteLink"
+	ConversationService_JoinByInvite_FullMethodName                   = "/beehive.conversation.ConversationService/JoinByInvite"
+	ConversationService_DissolveConversation_FullMethodName           = "/beehive.conversation.ConversationService/DissolveConversation"
+	ConversationService_BanMember_FullMethodName                      = "/beehive.conversation.ConversationService/BanMember"
+	ConversationService_UnbanMember_FullMethodName                    = "/beehive.conversation.ConversationService/UnbanMember"
+	ConversationService_ListBannedMembers_FullMethodName              = "/beehive.conversation.ConversationService/ListBannedMembers"
+	ConversationService_SetMuteAll_FullMethodName                     = "/beehive.conversation.ConversationService/SetMuteAll"
+	ConversationService_MuteMember_FullMethodName                     = "/beehive.conversation.ConversationService/MuteMember"
+	ConversationService_UnmuteMember_FullMethodName                   = "/beehive.conversation.ConversationService/UnmuteMember"
+	ConversationService_SubscribeChannel_FullMethodName               = "/beehive.conversation.ConversationService/SubscribeChannel"
+	ConversationService_UnsubscribeChannel_FullMethodName             = "/beehive.conversation.ConversationService/UnsubscribeChannel"
+	ConversationService_ListSubscribedChannels_FullMethodName         = "/beehive.conversation.ConversationService/ListSubscribedChannels"
+	ConversationService_ListPublicChannels_FullMethodName             = "/beehive.conversation.ConversationService/ListPublicChannels"
+	ConversationService_FilterChannelSubscribers_FullMethodName       = "/beehive.conversation.ConversationService/FilterChannelSubscribers"
+	ConversationService_RemoveUserEverywhere_FullMethodName           = "/beehive.conversation.ConversationService/RemoveUserEverywhere"
 )
 
 // ConversationServiceClient is the client API for ConversationService service.
@@ -41,7 +69,14 @@ type ConversationServiceClient interface {
 	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
 	ListUserConversations(ctx context.Context, in *ListUserConversationsRequest, opts ...grpc.CallOption) (*ListUserConversationsResponse, error)
 	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error)
+	// ListMembers 分页列出成员，可按群昵称/用户 ID 搜索、按角色与状态过滤，可选附带用户资料
 	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	// StreamMemberIDs 分批流式返回 active 成员 ID，供 Gateway 推送 fan-out 使用
+	StreamMemberIDs(ctx context.Context, in *StreamMemberIDsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MemberIDsChunk], error)
+	// 设置本人在群内的昵称
+	SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*SetMemberNicknameResponse, error)
+	// 群人数上限：按档位配置，管理员可为单个群调整档位或设置覆盖值（供 Admin API 调用）
+	SetConversationCapacity(ctx context.Context, in *SetConversationCapacityRequest, opts ...grpc.CallOption) (*SetConversationCapacityResponse, error)
 	// FindOrCreateSingleConversation 查找或创建两人单聊会话，返回 conversation_id
 	FindOrCreateSingleConversation(ctx context.Context, in *FindOrCreateSingleConversationRequest, opts ...grpc.CallOption) (*FindOrCreateSingleConversationResponse, error)
 	// 群申请/审批
@@ -49,6 +84,43 @@ type ConversationServiceClient interface {
 	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
 	ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*ApproveJoinRequestResponse, error)
 	DeclineJoinRequest(ctx context.Context, in *DeclineJoinRequestRequest, opts ...grpc.CallOption) (*DeclineJoinRequestResponse, error)
+	// 设置会话消息自动销毁时长（阅后即焚），仅群主可设置，单聊任一成员可设置
+	SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error)
+	// 更新当前用户对会话的个人设置（免打扰、置顶、归档、隐藏、备注名），只影响本人
+	UpdateMemberSettings(ctx context.Context, in *UpdateMemberSettingsRequest, opts ...grpc.CallOption) (*UpdateMemberSettingsResponse, error)
+	// 群管理：角色层级 owner > admin > member
+	SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*SetMemberRoleResponse, error)
+	TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error)
+	LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*LeaveConversationResponse, error)
+	// 修改群资料（群名、公告、加入方式、头像），仅群主/管理员；成功后发布 conversation.updated 事件
+	UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error)
+	// 群公告历史，按发布时间倒序
+	ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error)
+	// 群邀请链接：群主/管理员创建、撤销、查看；任何用户凭 token 预览或加入
+	CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...grpc.CallOption) (*CreateInviteLinkResponse, error)
+	RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...grpc.CallOption) (*RevokeInviteLinkResponse, error)
+	ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, opts ...grpc.CallOption) (*ListInviteLinksResponse, error)
+	PreviewInviteLink(ctx context.Context, in *PreviewInviteLinkRequest, opts ...grpc.CallOption) (*PreviewInviteLinkResponse, error)
+	JoinByInvite(ctx context.Context, in *JoinByInviteRequest, opts ...grpc.CallOption) (*JoinByInviteResponse, error)
+	// 解散群，仅群主；解散后只读并从会话列表移除
+	DissolveConversation(ctx context.Context, in *DissolveConversationRequest, opts ...grpc.CallOption) (*DissolveConversationResponse, error)
+	// 群封禁名单：群主/管理员封禁、解封、查看；被封禁用户不能再申请、凭邀请链接加入或被拉入群
+	BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error)
+	UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error)
+	ListBannedMembers(ctx context.Context, in *ListBannedMembersRequest, opts ...grpc.CallOption) (*ListBannedMembersResponse, error)
+	// 群禁言：全员禁言开启后仅群主/管理员可发言；单个成员禁言至指定时间。Message 服务发消息时校验
+	SetMuteAll(ctx context.Context, in *SetMuteAllRequest, opts ...grpc.CallOption) (*SetMuteAllResponse, error)
+	MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error)
+	UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error)
+	// 广播频道：订阅者不写成员表；公开频道目录；FilterChannelSubscribers 供 Gateway 向本实例在线订阅者推送摘要
+	SubscribeChannel(ctx context.Context, in *SubscribeChannelRequest, opts ...grpc.CallOption) (*SubscribeChannelResponse, error)
+	UnsubscribeChannel(ctx context.Context, in *UnsubscribeChannelRequest, opts ...grpc.CallOption) (*UnsubscribeChannelResponse, error)
+	ListSubscribedChannels(ctx context.Context, in *ListSubscribedChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	ListPublicChannels(ctx context.Context, in *ListPublicChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	FilterChannelSubscribers(ctx context.Context, in *FilterChannelSubscribersRequest, opts ...grpc.CallOption) (*FilterChannelSubscribersResponse, error)
+	// RemoveUserEverywhere 账号清除时由 User 服务调用：退出所有会话（群主身份转让，最后一名成员时解散群）、
+	// 取消频道订阅、删除入群申请；与主动退群一样写入系统消息与审计日志。可重复调用
+	RemoveUserEverywhere(ctx context.Context, in *RemoveUserEverywhereRequest, opts ...grpc.CallOption) (*RemoveUserEverywhereResponse, error)
 }
 
 type conversationServiceClient struct {
@@ -119,6 +191,45 @@ func (c *conversationServiceClient) ListMembers(ctx context.Context, in *ListMem
 	return out, nil
 }
 
+func (c *conversationServiceClient) StreamMemberIDs(ctx context.Context, in *StreamMemberIDsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MemberIDsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ConversationService_ServiceDesc.Streams[0], ConversationService_StreamMemberIDs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamMemberIDsRequest, MemberIDsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConversationService_StreamMemberIDsClient = grpc.ServerStreamingClient[MemberIDsChunk]
+
+func (c *conversationServiceClient) SetMemberNickname(ctx context.Context, in *SetMemberNicknameRequest, opts ...grpc.CallOption) (*SetMemberNicknameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberNicknameResponse)
+	err := c.cc.Invoke(ctx, ConversationService_SetMemberNickname_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetConversationCapacity(ctx context.Context, in *SetConversationCapacityRequest, opts ...grpc.CallOption) (*SetConversationCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConversationCapacityResponse)
+	err := c.cc.Invoke(ctx, ConversationService_SetConversationCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *conversationServiceClient) FindOrCreateSingleConversation(ctx context.Context, in *FindOrCreateSingleConversationRequest, opts ...grpc.CallOption) (*FindOrCreateSingleConversationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FindOrCreateSingleConversationResponse)
@@ -169,6 +280,256 @@ func (c *conversationServiceClient) DeclineJoinRequest(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *conversationServiceClient) SetMessageTTL(ctx context.Context, in *SetMessageTTLRequest, opts ...grpc.CallOption) (*SetMessageTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMessageTTLResponse)
+	err := c.cc.Invoke(ctx, ConversationService_SetMessageTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) UpdateMemberSettings(ctx context.Context, in *UpdateMemberSettingsRequest, opts ...grpc.CallOption) (*UpdateMemberSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemberSettingsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_UpdateMemberSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetMemberRole(ctx context.Context, in *SetMemberRoleRequest, opts ...grpc.CallOption) (*SetMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMemberRoleResponse)
+	err := c.cc.Invoke(ctx, ConversationService_SetMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) TransferOwnership(ctx context.Context, in *TransferOwnershipRequest, opts ...grpc.CallOption) (*TransferOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, ConversationService_TransferOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*LeaveConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveConversationResponse)
+	err := c.cc.Invoke(ctx, ConversationService_LeaveConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*UpdateConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateConversationResponse)
+	err := c.cc.Invoke(ctx, ConversationService_UpdateConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListAnnouncements(ctx context.Context, in *ListAnnouncementsRequest, opts ...grpc.CallOption) (*ListAnnouncementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListAnnouncements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) CreateInviteLink(ctx context.Context, in *CreateInviteLinkRequest, opts ...grpc.CallOption) (*CreateInviteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInviteLinkResponse)
+	err := c.cc.Invoke(ctx, ConversationService_CreateInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) RevokeInviteLink(ctx context.Context, in *RevokeInviteLinkRequest, opts ...grpc.CallOption) (*RevokeInviteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeInviteLinkResponse)
+	err := c.cc.Invoke(ctx, ConversationService_RevokeInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListInviteLinks(ctx context.Context, in *ListInviteLinksRequest, opts ...grpc.CallOption) (*ListInviteLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInviteLinksResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListInviteLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) PreviewInviteLink(ctx context.Context, in *PreviewInviteLinkRequest, opts ...grpc.CallOption) (*PreviewInviteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewInviteLinkResponse)
+	err := c.cc.Invoke(ctx, ConversationService_PreviewInviteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) JoinByInvite(ctx context.Context, in *JoinByInviteRequest, opts ...grpc.CallOption) (*JoinByInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinByInviteResponse)
+	err := c.cc.Invoke(ctx, ConversationService_JoinByInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) DissolveConversation(ctx context.Context, in *DissolveConversationRequest, opts ...grpc.CallOption) (*DissolveConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DissolveConversationResponse)
+	err := c.cc.Invoke(ctx, ConversationService_DissolveConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) BanMember(ctx context.Context, in *BanMemberRequest, opts ...grpc.CallOption) (*BanMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BanMemberResponse)
+	err := c.cc.Invoke(ctx, ConversationService_BanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) UnbanMember(ctx context.Context, in *UnbanMemberRequest, opts ...grpc.CallOption) (*UnbanMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnbanMemberResponse)
+	err := c.cc.Invoke(ctx, ConversationService_UnbanMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListBannedMembers(ctx context.Context, in *ListBannedMembersRequest, opts ...grpc.CallOption) (*ListBannedMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBannedMembersResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListBannedMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SetMuteAll(ctx context.Context, in *SetMuteAllRequest, opts ...grpc.CallOption) (*SetMuteAllResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMuteAllResponse)
+	err := c.cc.Invoke(ctx, ConversationService_SetMuteAll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) MuteMember(ctx context.Context, in *MuteMemberRequest, opts ...grpc.CallOption) (*MuteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteMemberResponse)
+	err := c.cc.Invoke(ctx, ConversationService_MuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) UnmuteMember(ctx context.Context, in *UnmuteMemberRequest, opts ...grpc.CallOption) (*UnmuteMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteMemberResponse)
+	err := c.cc.Invoke(ctx, ConversationService_UnmuteMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) SubscribeChannel(ctx context.Context, in *SubscribeChannelRequest, opts ...grpc.CallOption) (*SubscribeChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeChannelResponse)
+	err := c.cc.Invoke(ctx, ConversationService_SubscribeChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) UnsubscribeChannel(ctx context.Context, in *UnsubscribeChannelRequest, opts ...grpc.CallOption) (*UnsubscribeChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeChannelResponse)
+	err := c.cc.Invoke(ctx, ConversationService_UnsubscribeChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListSubscribedChannels(ctx context.Context, in *ListSubscribedChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListSubscribedChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) ListPublicChannels(ctx context.Context, in *ListPublicChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, ConversationService_ListPublicChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) FilterChannelSubscribers(ctx context.Context, in *FilterChannelSubscribersRequest, opts ...grpc.CallOption) (*FilterChannelSubscribersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterChannelSubscribersResponse)
+	err := c.cc.Invoke(ctx, ConversationService_FilterChannelSubscribers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationServiceClient) RemoveUserEverywhere(ctx context.Context, in *RemoveUserEverywhereRequest, opts ...grpc.CallOption) (*RemoveUserEverywhereResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveUserEverywhereResponse)
+	err := c.cc.Invoke(ctx, ConversationService_RemoveUserEverywhere_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConversationServiceServer is the server API for ConversationService service.
 // All implementations must embed UnimplementedConversationServiceServer
 // for forward compatibility.
@@ -178,7 +539,14 @@ type ConversationServiceServer interface {
 	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
 	ListUserConversations(context.Context, *ListUserConversationsRequest) (*ListUserConversationsResponse, error)
 	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
+	// ListMembers 分页列出成员，可按群昵称/用户 ID 搜索、按角色与状态过滤，可选附带用户资料
 	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	// StreamMemberIDs 分批流式返回 active 成员 ID，供 Gateway 推送 fan-out 使用
+	StreamMemberIDs(*StreamMemberIDsRequest, grpc.ServerStreamingServer[MemberIDsChunk]) error
+	// 设置本人在群内的昵称
+	SetMemberNickname(context.Context, *SetMemberNicknameRequest) (*SetMemberNicknameResponse, error)
+	// 群人数上限：按档位配置，管理员可为单个群调整档位或设置覆盖值（供 Admin API 调用）
+	SetConversationCapacity(context.Context, *SetConversationCapacityRequest) (*SetConversationCapacityResponse, error)
 	// FindOrCreateSingleConversation 查找或创建两人单聊会话，返回 conversation_id
 	FindOrCreateSingleConversation(context.Context, *FindOrCreateSingleConversationRequest) (*FindOrCreateSingleConversationResponse, error)
 	// 群申请/审批
@@ -186,6 +554,43 @@ type ConversationServiceServer interface {
 	ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error)
 	ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*ApproveJoinRequestResponse, error)
 	DeclineJoinRequest(context.Context, *DeclineJoinRequestRequest) (*DeclineJoinRequestResponse, error)
+	// 设置会话消息自动销毁时长（阅后即焚），仅群主可设置，单聊任一成员可设置
+	SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error)
+	// 更新当前用户对会话的个人设置（免打扰、置顶、归档、隐藏、备注名），只影响本人
+	UpdateMemberSettings(context.Context, *UpdateMemberSettingsRequest) (*UpdateMemberSettingsResponse, error)
+	// 群管理：角色层级 owner > admin > member
+	SetMemberRole(context.Context, *SetMemberRoleRequest) (*SetMemberRoleResponse, error)
+	TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error)
+	LeaveConversation(context.Context, *LeaveConversationRequest) (*LeaveConversationResponse, error)
+	// 修改群资料（群名、公告、加入方式、头像），仅群主/管理员；成功后发布 conversation.updated 事件
+	UpdateConversation(context.Context, *UpdateConversationRequest) (*UpdateConversationResponse, error)
+	// 群公告历史，按发布时间倒序
+	ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error)
+	// 群邀请链接：群主/管理员创建、撤销、查看；任何用户凭 token 预览或加入
+	CreateInviteLink(context.Context, *CreateInviteLinkRequest) (*CreateInviteLinkResponse, error)
+	RevokeInviteLink(context.Context, *RevokeInviteLinkRequest) (*RevokeInviteLinkResponse, error)
+	ListInviteLinks(context.Context, *ListInviteLinksRequest) (*ListInviteLinksResponse, error)
+	PreviewInviteLink(context.Context, *PreviewInviteLinkRequest) (*PreviewInviteLinkResponse, error)
+	JoinByInvite(context.Context, *JoinByInviteRequest) (*JoinByInviteResponse, error)
+	// 解散群，仅群主；解散后只读并从会话列表移除
+	DissolveConversation(context.Context, *DissolveConversationRequest) (*DissolveConversationResponse, error)
+	// 群封禁名单：群主/管理员封禁、解封、查看；被封禁用户不能再申请、凭邀请链接加入或被拉入群
+	BanMember(context.Context, *BanMemberRequest) (*BanMemberResponse, error)
+	UnbanMember(context.Context, *UnbanMemberRequest) (*UnbanMemberResponse, error)
+	ListBannedMembers(context.Context, *ListBannedMembersRequest) (*ListBannedMembersResponse, error)
+	// 群禁言：全员禁言开启后仅群主/管理员可发言；单个成员禁言至指定时间。Message 服务发消息时校验
+	SetMuteAll(context.Context, *SetMuteAllRequest) (*SetMuteAllResponse, error)
+	MuteMember(context.Context, *MuteMemberRequest) (*MuteMemberResponse, error)
+	UnmuteMember(context.Context, *UnmuteMemberRequest) (*UnmuteMemberResponse, error)
+	// 广播频道：订阅者不写成员表；公开频道目录；FilterChannelSubscribers 供 Gateway 向本实例在线订阅者推送摘要
+	SubscribeChannel(context.Context, *SubscribeChannelRequest) (*SubscribeChannelResponse, error)
+	UnsubscribeChannel(context.Context, *UnsubscribeChannelRequest) (*UnsubscribeChannelResponse, error)
+	ListSubscribedChannels(context.Context, *ListSubscribedChannelsRequest) (*ListChannelsResponse, error)
+	ListPublicChannels(context.Context, *ListPublicChannelsRequest) (*ListChannelsResponse, error)
+	FilterChannelSubscribers(context.Context, *FilterChannelSubscribersRequest) (*FilterChannelSubscribersResponse, error)
+	// RemoveUserEverywhere 账号清除时由 User 服务调用：退出所有会话（群主身份转让，最后一名成员时解散群）、
+	// 取消频道订阅、删除入群申请；与主动退群一样写入系统消息与审计日志。可重复调用
+	RemoveUserEverywhere(context.Context, *RemoveUserEverywhereRequest) (*RemoveUserEverywhereResponse, error)
 	mustEmbedUnimplementedConversationServiceServer()
 }
 
@@ -214,6 +619,15 @@ func (UnimplementedConversationServiceServer) GetConversation(context.Context, *
 func (UnimplementedConversationServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMembers not implemented")
 }
+func (UnimplementedConversationServiceServer) StreamMemberIDs(*StreamMemberIDsRequest, grpc.ServerStreamingServer[MemberIDsChunk]) error {
+	return status.Error(codes.Unimplemented, "method StreamMemberIDs not implemented")
+}
+func (UnimplementedConversationServiceServer) SetMemberNickname(context.Context, *SetMemberNicknameRequest) (*SetMemberNicknameResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMemberNickname not implemented")
+}
+func (UnimplementedConversationServiceServer) SetConversationCapacity(context.Context, *SetConversationCapacityRequest) (*SetConversationCapacityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetConversationCapacity not implemented")
+}
 func (UnimplementedConversationServiceServer) FindOrCreateSingleConversation(context.Context, *FindOrCreateSingleConversationRequest) (*FindOrCreateSingleConversationResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method FindOrCreateSingleConversation not implemented")
 }
@@ -229,6 +643,81 @@ func (UnimplementedConversationServiceServer) ApproveJoinRequest(context.Context
 func (UnimplementedConversationServiceServer) DeclineJoinRequest(context.Context, *DeclineJoinRequestRequest) (*DeclineJoinRequestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeclineJoinRequest not implemented")
 }
+func (UnimplementedConversationServiceServer) SetMessageTTL(context.Context, *SetMessageTTLRequest) (*SetMessageTTLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMessageTTL not implemented")
+}
+func (UnimplementedConversationServiceServer) UpdateMemberSettings(context.Context, *UpdateMemberSettingsRequest) (*UpdateMemberSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMemberSettings not implemented")
+}
+func (UnimplementedConversationServiceServer) SetMemberRole(context.Context, *SetMemberRoleRequest) (*SetMemberRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMemberRole not implemented")
+}
+func (UnimplementedConversationServiceServer) TransferOwnership(context.Context, *TransferOwnershipRequest) (*TransferOwnershipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (UnimplementedConversationServiceServer) LeaveConversation(context.Context, *LeaveConversationRequest) (*LeaveConversationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) UpdateConversation(context.Context, *UpdateConversationRequest) (*UpdateConversationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) ListAnnouncements(context.Context, *ListAnnouncementsRequest) (*ListAnnouncementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (UnimplementedConversationServiceServer) CreateInviteLink(context.Context, *CreateInviteLinkRequest) (*CreateInviteLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateInviteLink not implemented")
+}
+func (UnimplementedConversationServiceServer) RevokeInviteLink(context.Context, *RevokeInviteLinkRequest) (*RevokeInviteLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInviteLink not implemented")
+}
+func (UnimplementedConversationServiceServer) ListInviteLinks(context.Context, *ListInviteLinksRequest) (*ListInviteLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInviteLinks not implemented")
+}
+func (UnimplementedConversationServiceServer) PreviewInviteLink(context.Context, *PreviewInviteLinkRequest) (*PreviewInviteLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewInviteLink not implemented")
+}
+func (UnimplementedConversationServiceServer) JoinByInvite(context.Context, *JoinByInviteRequest) (*JoinByInviteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinByInvite not implemented")
+}
+func (UnimplementedConversationServiceServer) DissolveConversation(context.Context, *DissolveConversationRequest) (*DissolveConversationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DissolveConversation not implemented")
+}
+func (UnimplementedConversationServiceServer) BanMember(context.Context, *BanMemberRequest) (*BanMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BanMember not implemented")
+}
+func (UnimplementedConversationServiceServer) UnbanMember(context.Context, *UnbanMemberRequest) (*UnbanMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnbanMember not implemented")
+}
+func (UnimplementedConversationServiceServer) ListBannedMembers(context.Context, *ListBannedMembersRequest) (*ListBannedMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBannedMembers not implemented")
+}
+func (UnimplementedConversationServiceServer) SetMuteAll(context.Context, *SetMuteAllRequest) (*SetMuteAllResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMuteAll not implemented")
+}
+func (UnimplementedConversationServiceServer) MuteMember(context.Context, *MuteMemberRequest) (*MuteMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MuteMember not implemented")
+}
+func (UnimplementedConversationServiceServer) UnmuteMember(context.Context, *UnmuteMemberRequest) (*UnmuteMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnmuteMember not implemented")
+}
+func (UnimplementedConversationServiceServer) SubscribeChannel(context.Context, *SubscribeChannelRequest) (*SubscribeChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubscribeChannel not implemented")
+}
+func (UnimplementedConversationServiceServer) UnsubscribeChannel(context.Context, *UnsubscribeChannelRequest) (*UnsubscribeChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnsubscribeChannel not implemented")
+}
+func (UnimplementedConversationServiceServer) ListSubscribedChannels(context.Context, *ListSubscribedChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSubscribedChannels not implemented")
+}
+func (UnimplementedConversationServiceServer) ListPublicChannels(context.Context, *ListPublicChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPublicChannels not implemented")
+}
+func (UnimplementedConversationServiceServer) FilterChannelSubscribers(context.Context, *FilterChannelSubscribersRequest) (*FilterChannelSubscribersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FilterChannelSubscribers not implemented")
+}
+func (UnimplementedConversationServiceServer) RemoveUserEverywhere(context.Context, *RemoveUserEverywhereRequest) (*RemoveUserEverywhereResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveUserEverywhere not implemented")
+}
 func (UnimplementedConversationServiceServer) mustEmbedUnimplementedConversationServiceServer() {}
 func (UnimplementedConversationServiceServer) testEmbeddedByValue()                             {}
 
@@ -358,62 +847,109 @@ func _ConversationService_ListMembers_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_FindOrCreateSingleConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindOrCreateSingleConversationRequest)
+func _ConversationService_StreamMemberIDs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMemberIDsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ConversationServiceServer).StreamMemberIDs(m, &grpc.GenericServerStream[StreamMemberIDsRequest, MemberIDsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ConversationService_StreamMemberIDsServer = grpc.ServerStreamingServer[MemberIDsChunk]
+
+func _ConversationService_SetMemberNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberNicknameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).FindOrCreateSingleConversation(ctx, in)
+		return srv.(ConversationServiceServer).SetMemberNickname(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_FindOrCreateSingleConversation_FullMethodName,
+		FullMethod: ConversationService_SetMemberNickname_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).FindOrCreateSingleConversation(ctx, req.(*FindOrCreateSingleConversationRequest))
+		return srv.(ConversationServiceServer).SetMemberNickname(ctx, req.(*SetMemberNicknameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_ApplyJoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyJoinGroupRequest)
+func _ConversationService_SetConversationCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationCapacityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).ApplyJoinGroup(ctx, in)
+		return srv.(ConversationServiceServer).SetConversationCapacity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_ApplyJoinGroup_FullMethodName,
+		FullMethod: ConversationService_SetConversationCapacity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).ApplyJoinGroup(ctx, req.(*ApplyJoinGroupRequest))
+		return srv.(ConversationServiceServer).SetConversationCapacity(ctx, req.(*SetConversationCapacityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListJoinRequestsRequest)
+func _ConversationService_FindOrCreateSingleConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOrCreateSingleConversationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConversationServiceServer).ListJoinRequests(ctx, in)
+		return srv.(ConversationServiceServer).FindOrCreateSingleConversation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ConversationService_ListJoinRequests_FullMethodName,
+		FullMethod: ConversationService_FindOrCreateSingleConversation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConversationServiceServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
+		return srv.(ConversationServiceServer).FindOrCreateSingleConversation(ctx, req.(*FindOrCreateSingleConversationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ConversationService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApproveJoinRequestRequest)
+func _ConversationService_ApplyJoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyJoinGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ApplyJoinGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ApplyJoinGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ApplyJoinGroup(ctx, req.(*ApplyJoinGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveJoinRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -448,6 +984,456 @@ func _ConversationService_DeclineJoinRequest_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConversationService_SetMessageTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMessageTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetMessageTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetMessageTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetMessageTTL(ctx, req.(*SetMessageTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_UpdateMemberSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UpdateMemberSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UpdateMemberSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UpdateMemberSettings(ctx, req.(*UpdateMemberSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_SetMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetMemberRole(ctx, req.(*SetMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_TransferOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).TransferOwnership(ctx, req.(*TransferOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_LeaveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).LeaveConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_LeaveConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).LeaveConversation(ctx, req.(*LeaveConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_UpdateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UpdateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UpdateConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UpdateConversation(ctx, req.(*UpdateConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListAnnouncements(ctx, req.(*ListAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_CreateInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).CreateInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_CreateInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).CreateInviteLink(ctx, req.(*CreateInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_RevokeInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).RevokeInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_RevokeInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).RevokeInviteLink(ctx, req.(*RevokeInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInviteLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListInviteLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListInviteLinks(ctx, req.(*ListInviteLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_PreviewInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewInviteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).PreviewInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_PreviewInviteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).PreviewInviteLink(ctx, req.(*PreviewInviteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_JoinByInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinByInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).JoinByInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_JoinByInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).JoinByInvite(ctx, req.(*JoinByInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_DissolveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DissolveConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).DissolveConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_DissolveConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).DissolveConversation(ctx, req.(*DissolveConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_BanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).BanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_BanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).BanMember(ctx, req.(*BanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_UnbanMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UnbanMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UnbanMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UnbanMember(ctx, req.(*UnbanMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListBannedMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBannedMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListBannedMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListBannedMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListBannedMembers(ctx, req.(*ListBannedMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_SetMuteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMuteAllRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SetMuteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SetMuteAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SetMuteAll(ctx, req.(*SetMuteAllRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_MuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).MuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_MuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).MuteMember(ctx, req.(*MuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_UnmuteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UnmuteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UnmuteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UnmuteMember(ctx, req.(*UnmuteMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_SubscribeChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).SubscribeChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_SubscribeChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).SubscribeChannel(ctx, req.(*SubscribeChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_UnsubscribeChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).UnsubscribeChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_UnsubscribeChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).UnsubscribeChannel(ctx, req.(*UnsubscribeChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListSubscribedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListSubscribedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListSubscribedChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListSubscribedChannels(ctx, req.(*ListSubscribedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_ListPublicChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).ListPublicChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_ListPublicChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).ListPublicChannels(ctx, req.(*ListPublicChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_FilterChannelSubscribers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterChannelSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).FilterChannelSubscribers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_FilterChannelSubscribers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).FilterChannelSubscribers(ctx, req.(*FilterChannelSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConversationService_RemoveUserEverywhere_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserEverywhereRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServiceServer).RemoveUserEverywhere(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConversationService_RemoveUserEverywhere_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServiceServer).RemoveUserEverywhere(ctx, req.(*RemoveUserEverywhereRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConversationService_ServiceDesc is the grpc.ServiceDesc for ConversationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -479,6 +1465,14 @@ var ConversationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMembers",
 			Handler:    _ConversationService_ListMembers_Handler,
 		},
+		{
+			MethodName: "SetMemberNickname",
+			Handler:    _ConversationService_SetMemberNickname_Handler,
+		},
+		{
+			MethodName: "SetConversationCapacity",
+			Handler:    _ConversationService_SetConversationCapacity_Handler,
+		},
 		{
 			MethodName: "FindOrCreateSingleConversation",
 			Handler:    _ConversationService_FindOrCreateSingleConversation_Handler,
@@ -499,7 +1493,113 @@ var ConversationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeclineJoinRequest",
 			Handler:    _ConversationService_DeclineJoinRequest_Handler,
 		},
+		{
+			MethodName: "SetMessageTTL",
+			Handler:    _ConversationService_SetMessageTTL_Handler,
+		},
+		{
+			MethodName: "UpdateMemberSettings",
+			Handler:    _ConversationService_UpdateMemberSettings_Handler,
+		},
+		{
+			MethodName: "SetMemberRole",
+			Handler:    _ConversationService_SetMemberRole_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _ConversationService_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "LeaveConversation",
+			Handler:    _ConversationService_LeaveConversation_Handler,
+		},
+		{
+			MethodName: "UpdateConversation",
+			Handler:    _ConversationService_UpdateConversation_Handler,
+		},
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _ConversationService_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "CreateInviteLink",
+			Handler:    _ConversationService_CreateInviteLink_Handler,
+		},
+		{
+			MethodName: "RevokeInviteLink",
+			Handler:    _ConversationService_RevokeInviteLink_Handler,
+		},
+		{
+			MethodName: "ListInviteLinks",
+			Handler:    _ConversationService_ListInviteLinks_Handler,
+		},
+		{
+			MethodName: "PreviewInviteLink",
+			Handler:    _ConversationService_PreviewInviteLink_Handler,
+		},
+		{
+			MethodName: "JoinByInvite",
+			Handler:    _ConversationService_JoinByInvite_Handler,
+		},
+		{
+			MethodName: "DissolveConversation",
+			Handler:    _ConversationService_DissolveConversation_Handler,
+		},
+		{
+			MethodName: "BanMember",
+			Handler:    _ConversationService_BanMember_Handler,
+		},
+		{
+			MethodName: "UnbanMember",
+			Handler:    _ConversationService_UnbanMember_Handler,
+		},
+		{
+			MethodName: "ListBannedMembers",
+			Handler:    _ConversationService_ListBannedMembers_Handler,
+		},
+		{
+			MethodName: "SetMuteAll",
+			Handler:    _ConversationService_SetMuteAll_Handler,
+		},
+		{
+			MethodName: "MuteMember",
+			Handler:    _ConversationService_MuteMember_Handler,
+		},
+		{
+			MethodName: "UnmuteMember",
+			Handler:    _ConversationService_UnmuteMember_Handler,
+		},
+		{
+			MethodName: "SubscribeChannel",
+			Handler:    _ConversationService_SubscribeChannel_Handler,
+		},
+		{
+			MethodName: "UnsubscribeChannel",
+			Handler:    _ConversationService_UnsubscribeChannel_Handler,
+		},
+		{
+			MethodName: "ListSubscribedChannels",
+			Handler:    _ConversationService_ListSubscribedChannels_Handler,
+		},
+		{
+			MethodName: "ListPublicChannels",
+			Handler:    _ConversationService_ListPublicChannels_Handler,
+		},
+		{
+			MethodName: "FilterChannelSubscribers",
+			Handler:    _ConversationService_FilterChannelSubscribers_Handler,
+		},
+		{
+			MethodName: "RemoveUserEverywhere",
+			Handler:    _ConversationService_RemoveUserEverywhere_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMemberIDs",
+			Handler:       _ConversationService_StreamMemberIDs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/conversation.proto",
 }
@@ -31,13 +31,18 @@ type Config struct {
 	RabbitMQExchange string `json:",optional"` // im.events，与 Message 服务发布端一致
 	RabbitMQQueue    string `json:",optional"` // 每实例独立队列，如 gateway.push.gw-1
 	RabbitMQRouteKey string `json:",optional"` // message.created
+	// ChannelDigestIntervalSeconds 频道新消息摘要（channel.digest）推送间隔（秒），默认 5
+	ChannelDigestIntervalSeconds int `json:",optional"`
 
-	// Redis 用于限流（如 message.send 按 userId 限流）；可选，未配置或限流阈值为 0 时不限流。
+	// Redis 用于限流（如 message.send 按 userId 限流）与会话瞬时信号（conversation.typing）的跨实例转发；
+	// 可选，未配置时 conversation.typing 不可用，限流阈值为 0 时不限流。
 	RedisAddr     string `json:",optional"` // 127.0.0.1:6379
 	RedisPassword string `json:",optional"`
 	RedisDB       int    `json:",optional"`
 	// RateLimitMessageSendPerMinute 每用户每分钟最多发送消息条数，0 表示不限制
 	RateLimitMessageSendPerMinute int `json:",optional"`
+	// RateLimitUserSearchPerMinute 每用户每分钟最多 user.search 次数，默认 30，0 表示不限制
+	RateLimitUserSearchPerMinute int `json:",default=30"`
 }
 
 // UserRpcConfigured 判断是否已配置 UserService（Etcd 或 Endpoints），未配置时 Gateway 可不依赖 User 服务启动。
@@ -68,7 +73,17 @@ func (c *Config) PushConsumerConfigured() bool {
 	return c.RabbitMQURL != ""
 }
 
+// SignalConfigured 判断是否启用会话瞬时信号（需要 Redis）。
+func (c *Config) SignalConfigured() bool {
+	return c.RedisAddr != ""
+}
+
 // RateLimitConfigured 判断是否启用 message.send 限流（Redis 已配置且阈值 > 0）。
 func (c *Config) RateLimitConfigured() bool {
 	return c.RedisAddr != "" && c.RateLimitMessageSendPerMinute > 0
+}
+
+// UserSearchRateLimitConfigured 判断是否启用 user.search 限流（Redis 已配置且阈值 > 0）。
+func (c *Config) UserSearchRateLimitConfigured() bool {
+	return c.RedisAddr != "" && c.RateLimitUserSearchPerMinute > 0
 }
\ No newline at end of file
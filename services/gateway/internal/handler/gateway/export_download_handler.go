@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package gateway
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/logic/gateway"
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ExportDownloadHandler 处理 /exports/:id：从 Authorization: Bearer <token> 取 token，由 logic 鉴权后把归档流式写入响应。
+func ExportDownloadHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ExportDownloadReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		var token string
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+			token = strings.TrimPrefix(auth, "Bearer ")
+		}
+		l := gateway.NewExportDownloadLogic(r.Context(), svcCtx)
+		l.ExportDownload(&req, token, w)
+	}
+}
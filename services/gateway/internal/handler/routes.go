@@ -27,6 +27,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/ws",
 				Handler: gateway.WsEntryHandler(serverCtx),
 			},
+			{
+				// Download a personal data export archive (zip). Requires Authorization: Bearer <access_token>.
+				Method:  http.MethodGet,
+				Path:    "/exports/:id",
+				Handler: gateway.ExportDownloadHandler(serverCtx),
+			},
 		},
 	)
 }
@@ -0,0 +1,97 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/HappyLadySauce/Beehive/services/auth/authservice"
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/types"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ExportDownloadLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewExportDownloadLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportDownloadLogic {
+	return &ExportDownloadLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ExportDownload 校验 access token 后从 UserService 分块读取归档并写入响应。
+// 第一个分块到达前出错时按错误返回 401/404/409/503/500；开始写入后出错只能中断连接，客户端会收到不完整的归档。
+func (l *ExportDownloadLogic) ExportDownload(req *types.ExportDownloadReq, token string, w http.ResponseWriter) {
+	if token == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if l.svcCtx.AuthSvc == nil || l.svcCtx.UserSvc == nil {
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	auth, err := l.svcCtx.AuthSvc.ValidateToken(l.ctx, &authservice.ValidateTokenRequest{AccessToken: token})
+	if err != nil {
+		l.Errorf("validate token failed: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if !auth.GetValid() || auth.GetUserId() == "" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	stream, err := l.svcCtx.UserSvc.DownloadDataExport(l.ctx, &userservice.DownloadDataExportRequest{
+		UserId:   auth.GetUserId(),
+		ExportId: req.Id,
+	})
+	if err != nil {
+		l.writeError(w, err)
+		return
+	}
+	chunk, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		l.writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="beehive-export-%s.zip"`, req.Id))
+	w.WriteHeader(http.StatusOK)
+	for chunk != nil {
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return
+		}
+		chunk, err = stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				l.Errorf("download data export %s interrupted: %v", req.Id, err)
+			}
+			return
+		}
+	}
+}
+
+func (l *ExportDownloadLogic) writeError(w http.ResponseWriter, err error) {
+	switch status.Code(err) {
+	case codes.NotFound, codes.InvalidArgument:
+		http.Error(w, "export not found", http.StatusNotFound)
+	case codes.FailedPrecondition:
+		http.Error(w, "export is not ready", http.StatusConflict)
+	case codes.Unavailable:
+		http.Error(w, "service unavailable", http.StatusServiceUnavailable)
+	default:
+		l.Errorf("download data export failed: %v", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}
+}
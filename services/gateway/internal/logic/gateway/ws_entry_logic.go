@@ -6,10 +6,14 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/HappyLadySauce/Beehive/services/auth/authservice"
 	"github.com/HappyLadySauce/Beehive/services/conversation/conversationservice"
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/signal"
 	"github.com/HappyLadySauce/Beehive/services/gateway/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/gateway/internal/ws"
 	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
@@ -17,8 +21,10 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/user/userservice"
 	"github.com/gorilla/websocket"
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type WsEntryLogic struct {
@@ -72,14 +78,52 @@ func (l *WsEntryLogic) dispatch(c *ws.Connection, env *ws.Envelope) {
 	switch env.Type {
 	case "presence.ping":
 		l.handlePresencePing(c, env)
+	case "presence.query":
+		l.handlePresenceQuery(c, env)
 	case "auth.login", "auth.tokenLogin":
 		l.handleAuth(c, env)
 	case "auth.register":
 		l.handleAuthRegister(c, env)
 	case "auth.logout":
 		l.handleAuthLogout(c, env)
+	case "auth.account":
+		l.handleAuthAccount(c, env)
+	case "auth.sendCode":
+		l.handleAuthSendCode(c, env)
+	case "auth.verifyIdentifier":
+		l.handleAuthVerifyIdentifier(c, env)
+	case "auth.changeUsername":
+		l.handleAuthChangeUsername(c, env)
 	case "user.me":
 		l.handleUserMe(c, env)
+	case "user.update":
+		l.handleUserUpdate(c, env)
+	case "user.batchGet":
+		l.handleUserBatchGet(c, env)
+	case "user.block":
+		l.handleUserBlock(c, env)
+	case "user.unblock":
+		l.handleUserUnblock(c, env)
+	case "user.listBlocked":
+		l.handleUserListBlocked(c, env)
+	case "user.setStatus":
+		l.handleUserSetStatus(c, env)
+	case "user.search":
+		l.handleUserSearch(c, env)
+	case "user.getPrivacy":
+		l.handleUserGetPrivacy(c, env)
+	case "user.updatePrivacy":
+		l.handleUserUpdatePrivacy(c, env)
+	case "user.requestDeletion":
+		l.handleUserRequestDeletion(c, env)
+	case "user.cancelDeletion":
+		l.handleUserCancelDeletion(c, env)
+	case "user.getDeletion":
+		l.handleUserGetDeletion(c, env)
+	case "user.requestExport":
+		l.handleUserRequestExport(c, env)
+	case "user.getExport":
+		l.handleUserGetExport(c, env)
 	case "conversation.list":
 		l.handleConversationList(c, env)
 	case "conversation.create":
@@ -88,22 +132,76 @@ func (l *WsEntryLogic) dispatch(c *ws.Connection, env *ws.Envelope) {
 		l.handleConversationAddMember(c, env)
 	case "conversation.removeMember":
 		l.handleConversationRemoveMember(c, env)
+	case "conversation.setMemberRole":
+		l.handleConversationSetMemberRole(c, env)
+	case "conversation.transferOwnership":
+		l.handleConversationTransferOwnership(c, env)
+	case "conversation.leave":
+		l.handleConversationLeave(c, env)
+	case "conversation.dissolve":
+		l.handleConversationDissolve(c, env)
+	case "conversation.ban":
+		l.handleConversationBan(c, env)
+	case "conversation.unban":
+		l.handleConversationUnban(c, env)
+	case "conversation.listBanned":
+		l.handleConversationListBanned(c, env)
+	case "conversation.muteAll":
+		l.handleConversationMuteAll(c, env)
+	case "conversation.muteMember":
+		l.handleConversationMuteMember(c, env)
+	case "conversation.unmuteMember":
+		l.handleConversationUnmuteMember(c, env)
+	case "channel.subscribe":
+		l.handleChannelSubscribe(c, env)
+	case "channel.unsubscribe":
+		l.handleChannelUnsubscribe(c, env)
+	case "channel.listSubscribed":
+		l.handleChannelListSubscribed(c, env)
+	case "channel.directory":
+		l.handleChannelDirectory(c, env)
+	case "conversation.update":
+		l.handleConversationUpdate(c, env)
+	case "conversation.announcements":
+		l.handleConversationAnnouncements(c, env)
 	case "conversation.get":
 		l.handleConversationGet(c, env)
 	case "conversation.listMembers":
 		l.handleConversationListMembers(c, env)
+	case "conversation.setNickname":
+		l.handleConversationSetNickname(c, env)
 	case "message.send":
 		l.handleMessageSend(c, env)
 	case "message.history":
 		l.handleMessageHistory(c, env)
 	case "message.read":
 		l.handleMessageRead(c, env)
+	case "message.scheduledList":
+		l.handleMessageScheduledList(c, env)
+	case "message.cancelScheduled":
+		l.handleMessageCancelScheduled(c, env)
+	case "message.deleteForMe":
+		l.handleMessageDeleteForMe(c, env)
+	case "conversation.setMessageTtl":
+		l.handleConversationSetMessageTtl(c, env)
+	case "conversation.typing":
+		l.handleConversationTyping(c, env)
+	case "conversation.updateSettings":
+		l.handleConversationUpdateSettings(c, env)
+	case "conversation.clearHistory":
+		l.handleConversationClearHistory(c, env)
 	case "contact.list":
 		l.handleContactList(c, env)
 	case "contact.add":
 		l.handleContactAdd(c, env)
 	case "contact.remove":
 		l.handleContactRemove(c, env)
+	case "contact.update":
+		l.handleContactUpdate(c, env)
+	case "contact.setTags":
+		l.handleContactSetTags(c, env)
+	case "contact.suggest":
+		l.handleContactSuggest(c, env)
 	case "contact.request":
 		l.handleContactRequest(c, env)
 	case "contact.requestList":
@@ -112,6 +210,8 @@ func (l *WsEntryLogic) dispatch(c *ws.Connection, env *ws.Envelope) {
 		l.handleContactAccept(c, env)
 	case "contact.decline":
 		l.handleContactDecline(c, env)
+	case "contact.withdraw":
+		l.handleContactWithdraw(c, env)
 	case "group.apply":
 		l.handleGroupApply(c, env)
 	case "group.joinRequestList":
@@ -120,6 +220,16 @@ func (l *WsEntryLogic) dispatch(c *ws.Connection, env *ws.Envelope) {
 		l.handleGroupApprove(c, env)
 	case "group.decline":
 		l.handleGroupDecline(c, env)
+	case "group.createInvite":
+		l.handleGroupCreateInvite(c, env)
+	case "group.revokeInvite":
+		l.handleGroupRevokeInvite(c, env)
+	case "group.listInvites":
+		l.handleGroupListInvites(c, env)
+	case "group.previewInvite":
+		l.handleGroupPreviewInvite(c, env)
+	case "group.joinByInvite":
+		l.handleGroupJoinByInvite(c, env)
 	default:
 		l.sendError(c, env.Tid, "bad_request", "unknown type: "+env.Type)
 	}
@@ -145,431 +255,2713 @@ func (l *WsEntryLogic) handlePresencePing(c *ws.Connection, env *ws.Envelope) {
 }
 
 // handleUserMe 获取当前登录用户资料，需已登录；调用 UserService.GetUser。未配置 UserService 时返回不可用。
+// payload.ifVersion 与当前资料版本一致时只返回 notModified。
 func (l *WsEntryLogic) handleUserMe(c *ws.Connection, env *ws.Envelope) {
 	if l.svcCtx.UserSvc == nil {
 		l.sendError(c, env.Tid, "unavailable", "user service not configured")
 		return
 	}
+	var payload struct {
+		IfVersion int64 `json:"ifVersion"`
+	}
+	if env.Payload != nil && !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
 	l.Infow("rpc call", logx.Field("method", "user.GetUser"), logx.Field("userId", c.UserID))
-	resp, err := l.svcCtx.UserSvc.GetUser(l.ctx, &userservice.GetUserRequest{Id: c.UserID})
+	resp, err := l.svcCtx.UserSvc.GetUser(l.ctx, &userservice.GetUserRequest{Id: c.UserID, IfVersion: payload.IfVersion})
 	if err != nil {
 		l.Errorf("get user me failed for %s: %v", c.UserID, err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
+	if resp.GetNotModified() {
+		_ = c.WriteJSON(&ws.Envelope{
+			Type:    env.Type + ".ok",
+			Tid:     env.Tid,
+			Payload: map[string]any{"notModified": true, "version": payload.IfVersion},
+			Error:   nil,
+		})
+		return
+	}
 	if resp.GetUser() == nil {
 		l.sendError(c, env.Tid, "not_found", "user not found")
 		return
 	}
-	u := resp.User
-	_ = c.WriteJSON(&ws.Envelope{
-		Type: env.Type + ".ok",
-		Tid:  env.Tid,
-		Payload: map[string]any{
-			"id":        u.GetId(),
-			"nickname":  u.GetNickname(),
-			"avatarUrl": u.GetAvatarUrl(),
-			"bio":       u.GetBio(),
-			"status":    u.GetStatus(),
-		},
-		Error: nil,
-	})
-}
-
-func (l *WsEntryLogic) sendError(c *ws.Connection, tid, code, message string) {
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "",
-		Tid:     tid,
-		Payload: nil,
-		Error:   &ws.ErrBody{Code: code, Message: message},
+		Type:    env.Type + ".ok",
+		Tid:     env.Tid,
+		Payload: userProfilePayload(resp.GetUser()),
+		Error:   nil,
 	})
 }
 
-// handleAuthRegister 处理 auth.register，调用 AuthService.Register，成功后与登录一致绑定并返回 token。
-func (l *WsEntryLogic) handleAuthRegister(c *ws.Connection, env *ws.Envelope) {
-	if l.svcCtx.AuthSvc == nil {
-		l.sendError(c, env.Tid, "unavailable", "auth service not configured")
+// handleUserUpdate 修改当前用户的昵称、头像、签名，未传或为空的字段不修改；
+// 有变化时 User 服务发布 user.updated，由推送消费者下发给本人其他设备、联系人与共同会话成员
+func (l *WsEntryLogic) handleUserUpdate(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
 		return
 	}
 	var payload struct {
-		Username string `json:"username"`
-		Password string `json:"password"`
+		Nickname  string `json:"nickname"`
+		AvatarUrl string `json:"avatarUrl"`
+		Bio       string `json:"bio"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	l.Infow("rpc call", logx.Field("method", "auth.Register"), logx.Field("username", payload.Username))
-	resp, err := l.svcCtx.AuthSvc.Register(l.ctx, &authservice.RegisterRequest{
-		Username: payload.Username,
-		Password: payload.Password,
+	resp, err := l.svcCtx.UserSvc.UpdateUser(l.ctx, &userservice.UpdateUserRequest{
+		Id:        c.UserID,
+		Nickname:  payload.Nickname,
+		AvatarUrl: payload.AvatarUrl,
+		Bio:       payload.Bio,
 	})
 	if err != nil {
-		if st, ok := status.FromError(err); ok {
-			switch st.Code() {
-			case codes.AlreadyExists:
-				l.sendError(c, env.Tid, "bad_request", st.Message())
-				return
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
 			case codes.InvalidArgument:
-				l.sendError(c, env.Tid, "bad_request", st.Message())
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
 				return
 			}
 		}
+		l.Errorf("update user failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
-	l.afterAuthSuccess(c, env, resp, "")
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.update.ok",
+		Tid:     env.Tid,
+		Payload: userProfilePayload(resp.GetUser()),
+		Error:   nil,
+	})
 }
 
-// handleAuth 处理 auth.login / auth.tokenLogin。
-func (l *WsEntryLogic) handleAuth(c *ws.Connection, env *ws.Envelope) {
-	if l.svcCtx.AuthSvc == nil {
-		l.sendError(c, env.Tid, "unavailable", "auth service not configured")
+// maxUserBatchGetIDs user.batchGet 单次最多查询的用户数
+const maxUserBatchGetIDs = 100
+
+// handleUserBatchGet 批量获取用户资料；knownVersions 中版本未变化的用户只列入 unchangedIds，客户端据此只刷新有变化的资料
+func (l *WsEntryLogic) handleUserBatchGet(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
 		return
 	}
-	switch env.Type {
-	case "auth.login":
-		var payload struct {
-			Username string `json:"username"`
-			Password string `json:"password"`
-			DeviceID string `json:"deviceId"`
-		}
-		if !l.bindJSONPayload(c, env, &payload) {
-			return
-		}
-		l.Infow("rpc call", logx.Field("method", "auth.Login"), logx.Field("username", payload.Username))
-		resp, err := l.svcCtx.AuthSvc.Login(l.ctx, &authservice.LoginRequest{
-			Username: payload.Username,
-			Password: payload.Password,
-			DeviceId: payload.DeviceID,
-		})
-		if err != nil {
-			l.sendError(c, env.Tid, "unauthorized", err.Error())
-			return
-		}
-		l.afterAuthSuccess(c, env, resp, payload.DeviceID)
-	case "auth.tokenLogin":
-		var payload struct {
-			AccessToken string `json:"accessToken"`
-			DeviceID    string `json:"deviceId"`
-		}
-		if !l.bindJSONPayload(c, env, &payload) {
+	var payload struct {
+		Ids           []string         `json:"ids"`
+		KnownVersions map[string]int64 `json:"knownVersions"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if len(payload.Ids) == 0 {
+		l.sendError(c, env.Tid, "bad_request", "ids is required")
+		return
+	}
+	if len(payload.Ids) > maxUserBatchGetIDs {
+		l.sendError(c, env.Tid, "bad_request", "at most "+strconv.Itoa(maxUserBatchGetIDs)+" ids per request")
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.BatchGetUsers(l.ctx, &userservice.BatchGetUsersRequest{
+		Ids:           payload.Ids,
+		ViewerId:      c.UserID,
+		KnownVersions: payload.KnownVersions,
+	})
+	if err != nil {
+		l.Errorf("batch get users failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	items := make([]map[string]any, 0, len(resp.GetUsers()))
+	for _, u := range resp.GetUsers() {
+		items = append(items, userProfilePayload(u))
+	}
+	unchanged := resp.GetUnchangedIds()
+	if unchanged == nil {
+		unchanged = []string{}
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.batchGet.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"items": items, "unchangedIds": unchanged},
+		Error:   nil,
+	})
+}
+
+func userProfilePayload(u *userservice.User) map[string]any {
+	return map[string]any{
+		"id":           u.GetId(),
+		"nickname":     u.GetNickname(),
+		"avatarUrl":    u.GetAvatarUrl(),
+		"bio":          u.GetBio(),
+		"status":       u.GetStatus(),
+		"lastSeenAt":   u.GetLastSeenAt(),
+		"customStatus": customStatusPayload(u.GetCustomStatus()),
+		"version":      u.GetVersion(),
+		"updatedAt":    u.GetUpdatedAt(),
+	}
+}
+
+// handleUserSetStatus 设置或清除（emoji 与 text 均为空）当前用户的自定义状态
+func (l *WsEntryLogic) handleUserSetStatus(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	var payload struct {
+		Emoji     string `json:"emoji"`
+		Text      string `json:"text"`
+		ExpiresAt int64  `json:"expiresAt"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.SetCustomStatus(l.ctx, &userservice.SetCustomStatusRequest{
+		UserId:    c.UserID,
+		Emoji:     payload.Emoji,
+		Text:      payload.Text,
+		ExpiresAt: payload.ExpiresAt,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
 			return
 		}
-		l.Infow("rpc call", logx.Field("method", "auth.TokenLogin"))
-		resp, err := l.svcCtx.AuthSvc.TokenLogin(l.ctx, &authservice.TokenLoginRequest{
-			AccessToken: payload.AccessToken,
-			DeviceId:    payload.DeviceID,
-		})
-		if err != nil {
-			l.sendError(c, env.Tid, "unauthorized", err.Error())
+		l.Errorf("set custom status failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.setStatus.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"customStatus": customStatusPayload(resp.GetCustomStatus())},
+		Error:   nil,
+	})
+}
+
+// customStatusPayload 自定义状态下行结构，未设置时为 nil
+func customStatusPayload(cs *userservice.CustomStatus) map[string]any {
+	if cs == nil {
+		return nil
+	}
+	return map[string]any{
+		"emoji":     cs.GetEmoji(),
+		"text":      cs.GetText(),
+		"expiresAt": cs.GetExpiresAt(),
+	}
+}
+
+// handlePresenceQuery 查询指定用户是否在线；双方存在拉黑关系时始终返回离线，不暴露会话详情
+func (l *WsEntryLogic) handlePresenceQuery(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.PresenceSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "presence service not configured")
+		return
+	}
+	var payload struct {
+		UserId string `json:"userId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "userId is required")
+		return
+	}
+	resp, err := l.svcCtx.PresenceSvc.GetUserPresence(l.ctx, &presenceservice.GetUserPresenceRequest{
+		UserId:   payload.UserId,
+		ViewerId: c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
 			return
 		}
-		l.afterAuthSuccess(c, env, resp, payload.DeviceID)
-	default:
-		l.sendError(c, env.Tid, "bad_request", "unsupported auth type")
+		l.Errorf("get user presence failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
 	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "presence.query.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"userId": payload.UserId, "online": resp.GetOnline()},
+		Error:   nil,
+	})
 }
 
-// handleAuthLogout 处理 auth.logout。
-func (l *WsEntryLogic) handleAuthLogout(c *ws.Connection, env *ws.Envelope) {
+// handleUserBlock 将指定用户加入黑名单：对方无法再向自己发单聊消息、发起好友申请或查看在线状态
+func (l *WsEntryLogic) handleUserBlock(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
 	var payload struct {
-		AccessToken string `json:"accessToken"`
+		UserId string `json:"userId"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	if l.svcCtx.AuthSvc != nil && payload.AccessToken != "" {
-		l.Infow("rpc call", logx.Field("method", "auth.Logout"))
-		_, _ = l.svcCtx.AuthSvc.Logout(l.ctx, &authservice.LogoutRequest{
-			AccessToken: payload.AccessToken,
-		})
-	}
-	if l.svcCtx.PresenceSvc != nil && c.UserID != "" {
-		l.Infow("rpc call", logx.Field("method", "presence.UnregisterSession"), logx.Field("userId", c.UserID), logx.Field("connId", c.ConnID))
-		_, _ = l.svcCtx.PresenceSvc.UnregisterSession(l.ctx, &presenceservice.UnregisterSessionRequest{
-			UserId: c.UserID,
-			ConnId: c.ConnID,
-		})
+	if payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "userId is required")
+		return
 	}
-	if c.UserID != "" {
-		c.BindUser("")
+	_, err := l.svcCtx.UserSvc.BlockUser(l.ctx, &userservice.BlockUserRequest{
+		UserId:       c.UserID,
+		TargetUserId: payload.UserId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
+		}
+		l.Errorf("block user failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
 	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "auth.logout.ok",
+		Type:    "user.block.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{"ok": true},
+		Payload: map[string]any{"userId": payload.UserId},
 		Error:   nil,
 	})
 }
 
-// afterAuthSuccess 在登录或 tokenLogin 成功后绑定 UserID 并注册 Presence。
-func (l *WsEntryLogic) afterAuthSuccess(c *ws.Connection, env *ws.Envelope, resp *authservice.LoginResponse, deviceID string) {
-	if resp == nil || resp.UserId == "" {
-		l.sendError(c, env.Tid, "internal_error", "empty auth response")
+func (l *WsEntryLogic) handleUserUnblock(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
 		return
 	}
-	if l.svcCtx.PresenceSvc != nil {
-		l.Infow("rpc call", logx.Field("method", "presence.RegisterSession"), logx.Field("userId", resp.UserId), logx.Field("connId", c.ConnID))
-		if _, err := l.svcCtx.PresenceSvc.RegisterSession(l.ctx, &presenceservice.RegisterSessionRequest{
-			UserId:     resp.UserId,
-			GatewayId:  l.svcCtx.Config.GatewayID,
-			ConnId:     c.ConnID,
-			DeviceId:   deviceID,
-			DeviceType: "",
-			Ip:         "",
-		}); err != nil {
-			l.Errorf("register session failed for user %s conn %s: %v", resp.UserId, c.ConnID, err)
-			l.sendError(c, env.Tid, "internal_error", "failed to register session")
+	var payload struct {
+		UserId string `json:"userId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "userId is required")
+		return
+	}
+	_, err := l.svcCtx.UserSvc.UnblockUser(l.ctx, &userservice.UnblockUserRequest{
+		UserId:       c.UserID,
+		TargetUserId: payload.UserId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
 			return
 		}
+		l.Errorf("unblock user failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.unblock.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"userId": payload.UserId},
+		Error:   nil,
+	})
+}
+
+// handleUserListBlocked 按拉黑时间倒序分页列出黑名单
+func (l *WsEntryLogic) handleUserListBlocked(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	var payload struct {
+		Cursor string `json:"cursor"`
+		Limit  int32  `json:"limit"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.ListBlocked(l.ctx, &userservice.ListBlockedRequest{
+		UserId: c.UserID,
+		Cursor: payload.Cursor,
+		Limit:  payload.Limit,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
+			return
+		}
+		l.Errorf("list blocked users failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	items := make([]map[string]any, 0, len(resp.GetItems()))
+	for _, it := range resp.GetItems() {
+		items = append(items, map[string]any{
+			"userId":    it.GetUserId(),
+			"blockedAt": it.GetBlockedAt(),
+		})
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.listBlocked.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"items": items, "nextCursor": resp.GetNextCursor()},
+		Error:   nil,
+	})
+}
+
+// handleUserSearch 按用户名、昵称搜索用户，按 userId 限流；结果不含当前用户、已封禁、关闭被搜索及拉黑了当前用户的用户
+func (l *WsEntryLogic) handleUserSearch(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	if l.svcCtx.UserSearchLimit != nil {
+		allow, err := l.svcCtx.UserSearchLimit.Allow(l.ctx, c.UserID)
+		if err != nil {
+			l.Errorf("rate limit check failed: %v", err)
+			l.sendError(c, env.Tid, "internal_error", "rate limit check failed")
+			return
+		}
+		if !allow {
+			_ = c.WriteJSON(&ws.Envelope{
+				Type:    "user.search.error",
+				Tid:     env.Tid,
+				Payload: nil,
+				Error:   &ws.ErrBody{Code: "rate_limited", Message: "too many requests"},
+			})
+			return
+		}
+	}
+	var payload struct {
+		Query  string `json:"query"`
+		Cursor string `json:"cursor"`
+		Limit  int32  `json:"limit"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if strings.TrimSpace(payload.Query) == "" {
+		l.sendError(c, env.Tid, "bad_request", "query is required")
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.SearchUsers(l.ctx, &userservice.SearchUsersRequest{
+		UserId: c.UserID,
+		Query:  payload.Query,
+		Cursor: payload.Cursor,
+		Limit:  payload.Limit,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
+			return
+		}
+		l.Errorf("search users failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	items := make([]map[string]any, 0, len(resp.GetUsers()))
+	for _, u := range resp.GetUsers() {
+		items = append(items, map[string]any{
+			"id":        u.GetId(),
+			"nickname":  u.GetNickname(),
+			"avatarUrl": u.GetAvatarUrl(),
+			"bio":       u.GetBio(),
+		})
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.search.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"items": items, "nextCursor": resp.GetNextCursor()},
+		Error:   nil,
+	})
+}
+
+func (l *WsEntryLogic) handleUserGetPrivacy(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.GetPrivacySettings(l.ctx, &userservice.GetPrivacySettingsRequest{UserId: c.UserID})
+	if err != nil {
+		l.Errorf("get privacy settings failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.getPrivacy.ok",
+		Tid:     env.Tid,
+		Payload: privacyPayload(resp.GetSettings()),
+		Error:   nil,
+	})
+}
+
+// handleUserUpdatePrivacy 修改隐私设置；payload 中出现的字段才会更新
+func (l *WsEntryLogic) handleUserUpdatePrivacy(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	var payload struct {
+		FindBy             *string `json:"findBy"`
+		ContactRequest     *string `json:"contactRequest"`
+		GroupAdd           *string `json:"groupAdd"`
+		PresenceVisibility *string `json:"presenceVisibility"`
+		Discoverable       *bool   `json:"discoverable"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.UpdatePrivacySettings(l.ctx, &userservice.UpdatePrivacySettingsRequest{
+		UserId:             c.UserID,
+		FindBy:             payload.FindBy,
+		ContactRequest:     payload.ContactRequest,
+		GroupAdd:           payload.GroupAdd,
+		PresenceVisibility: payload.PresenceVisibility,
+		Discoverable:       payload.Discoverable,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
+		}
+		l.Errorf("update privacy settings failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.updatePrivacy.ok",
+		Tid:     env.Tid,
+		Payload: privacyPayload(resp.GetSettings()),
+		Error:   nil,
+	})
+}
+
+func privacyPayload(p *userservice.PrivacySettings) map[string]any {
+	return map[string]any{
+		"findBy":             p.GetFindBy(),
+		"contactRequest":     p.GetContactRequest(),
+		"groupAdd":           p.GetGroupAdd(),
+		"presenceVisibility": p.GetPresenceVisibility(),
+		"discoverable":       p.GetDiscoverable(),
+	}
+}
+
+// handleUserRequestDeletion 申请注销账号，宽限期（由 User 服务配置）内可通过 user.cancelDeletion 撤销
+func (l *WsEntryLogic) handleUserRequestDeletion(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.RequestAccountDeletion(l.ctx, &userservice.RequestAccountDeletionRequest{UserId: c.UserID})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			l.sendError(c, env.Tid, "not_found", s.Message())
+			return
+		}
+		l.Errorf("request account deletion failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.requestDeletion.ok",
+		Tid:     env.Tid,
+		Payload: accountDeletionPayload(resp.GetDeletion()),
+		Error:   nil,
+	})
+}
+
+func (l *WsEntryLogic) handleUserCancelDeletion(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	if _, err := l.svcCtx.UserSvc.CancelAccountDeletion(l.ctx, &userservice.CancelAccountDeletionRequest{UserId: c.UserID}); err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			l.sendError(c, env.Tid, "not_found", s.Message())
+			return
+		}
+		l.Errorf("cancel account deletion failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.cancelDeletion.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{},
+		Error:   nil,
+	})
+}
+
+// handleUserGetDeletion 查询待处理的注销申请，没有时 deletion 为 null
+func (l *WsEntryLogic) handleUserGetDeletion(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.GetAccountDeletion(l.ctx, &userservice.GetAccountDeletionRequest{UserId: c.UserID})
+	if err != nil {
+		l.Errorf("get account deletion failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	var deletion map[string]any
+	if resp.GetDeletion() != nil {
+		deletion = accountDeletionPayload(resp.GetDeletion())
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.getDeletion.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"deletion": deletion},
+		Error:   nil,
+	})
+}
+
+func accountDeletionPayload(d *userservice.AccountDeletion) map[string]any {
+	return map[string]any{
+		"requestedAt": d.GetRequestedAt(),
+		"purgeAt":     d.GetPurgeAt(),
+	}
+}
+
+// handleUserRequestExport 申请导出个人数据，归档异步生成；已有进行中的导出时返回该任务
+func (l *WsEntryLogic) handleUserRequestExport(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.RequestDataExport(l.ctx, &userservice.RequestDataExportRequest{UserId: c.UserID})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			l.sendError(c, env.Tid, "not_found", s.Message())
+			return
+		}
+		l.Errorf("request data export failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.requestExport.ok",
+		Tid:     env.Tid,
+		Payload: dataExportPayload(resp.GetExport()),
+		Error:   nil,
+	})
+}
+
+// handleUserGetExport 查询导出任务状态；status 为 ready 时可通过 HTTP GET /exports/{exportId} 下载
+func (l *WsEntryLogic) handleUserGetExport(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	var payload struct {
+		ExportId string `json:"exportId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ExportId == "" {
+		l.sendError(c, env.Tid, "bad_request", "exportId is required")
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.GetDataExport(l.ctx, &userservice.GetDataExportRequest{
+		UserId:   c.UserID,
+		ExportId: payload.ExportId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			l.sendError(c, env.Tid, "not_found", s.Message())
+			return
+		}
+		l.Errorf("get data export failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "user.getExport.ok",
+		Tid:     env.Tid,
+		Payload: dataExportPayload(resp.GetExport()),
+		Error:   nil,
+	})
+}
+
+func dataExportPayload(e *userservice.DataExport) map[string]any {
+	return map[string]any{
+		"exportId":    e.GetId(),
+		"status":      e.GetStatus(),
+		"sizeBytes":   e.GetSizeBytes(),
+		"error":       e.GetError(),
+		"createdAt":   e.GetCreatedAt(),
+		"completedAt": e.GetCompletedAt(),
+		"expiresAt":   e.GetExpiresAt(),
+	}
+}
+
+func (l *WsEntryLogic) sendError(c *ws.Connection, tid, code, message string) {
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "",
+		Tid:     tid,
+		Payload: nil,
+		Error:   &ws.ErrBody{Code: code, Message: message},
+	})
+}
+
+// handleAuthRegister 处理 auth.register，调用 AuthService.Register，成功后与登录一致绑定并返回 token。
+func (l *WsEntryLogic) handleAuthRegister(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.AuthSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "auth service not configured")
+		return
+	}
+	var payload struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	l.Infow("rpc call", logx.Field("method", "auth.Register"), logx.Field("username", payload.Username))
+	resp, err := l.svcCtx.AuthSvc.Register(l.ctx, &authservice.RegisterRequest{
+		Username: payload.Username,
+		Password: payload.Password,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.AlreadyExists:
+				l.sendError(c, env.Tid, "bad_request", st.Message())
+				return
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", st.Message())
+				return
+			}
+		}
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	l.afterAuthSuccess(c, env, resp, "")
+}
+
+// handleAuth 处理 auth.login / auth.tokenLogin。
+func (l *WsEntryLogic) handleAuth(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.AuthSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "auth service not configured")
+		return
+	}
+	switch env.Type {
+	case "auth.login":
+		var payload struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			DeviceID string `json:"deviceId"`
+		}
+		if !l.bindJSONPayload(c, env, &payload) {
+			return
+		}
+		l.Infow("rpc call", logx.Field("method", "auth.Login"), logx.Field("username", payload.Username))
+		resp, err := l.svcCtx.AuthSvc.Login(l.ctx, &authservice.LoginRequest{
+			Username: payload.Username,
+			Password: payload.Password,
+			DeviceId: payload.DeviceID,
+		})
+		if err != nil {
+			l.sendError(c, env.Tid, "unauthorized", err.Error())
+			return
+		}
+		l.afterAuthSuccess(c, env, resp, payload.DeviceID)
+	case "auth.tokenLogin":
+		var payload struct {
+			AccessToken string `json:"accessToken"`
+			DeviceID    string `json:"deviceId"`
+		}
+		if !l.bindJSONPayload(c, env, &payload) {
+			return
+		}
+		l.Infow("rpc call", logx.Field("method", "auth.TokenLogin"))
+		resp, err := l.svcCtx.AuthSvc.TokenLogin(l.ctx, &authservice.TokenLoginRequest{
+			AccessToken: payload.AccessToken,
+			DeviceId:    payload.DeviceID,
+		})
+		if err != nil {
+			l.sendError(c, env.Tid, "unauthorized", err.Error())
+			return
+		}
+		l.afterAuthSuccess(c, env, resp, payload.DeviceID)
+	default:
+		l.sendError(c, env.Tid, "bad_request", "unsupported auth type")
+	}
+}
+
+// handleAuthLogout 处理 auth.logout。
+func (l *WsEntryLogic) handleAuthLogout(c *ws.Connection, env *ws.Envelope) {
+	var payload struct {
+		AccessToken string `json:"accessToken"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if l.svcCtx.AuthSvc != nil && payload.AccessToken != "" {
+		l.Infow("rpc call", logx.Field("method", "auth.Logout"))
+		_, _ = l.svcCtx.AuthSvc.Logout(l.ctx, &authservice.LogoutRequest{
+			AccessToken: payload.AccessToken,
+		})
+	}
+	if l.svcCtx.PresenceSvc != nil && c.UserID != "" {
+		l.Infow("rpc call", logx.Field("method", "presence.UnregisterSession"), logx.Field("userId", c.UserID), logx.Field("connId", c.ConnID))
+		_, _ = l.svcCtx.PresenceSvc.UnregisterSession(l.ctx, &presenceservice.UnregisterSessionRequest{
+			UserId: c.UserID,
+			ConnId: c.ConnID,
+		})
+	}
+	if c.UserID != "" {
+		c.BindUser("")
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "auth.logout.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"ok": true},
+		Error:   nil,
+	})
+}
+
+// handleAuthAccount 返回当前账号的登录标识：用户名与已验证的邮箱、手机号
+func (l *WsEntryLogic) handleAuthAccount(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.AuthSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "auth service not configured")
+		return
+	}
+	resp, err := l.svcCtx.AuthSvc.GetAccount(l.ctx, &authservice.GetAccountRequest{UserId: c.UserID})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			l.sendError(c, env.Tid, "not_found", s.Message())
+			return
+		}
+		l.Errorf("get account failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "auth.account.ok",
+		Tid:     env.Tid,
+		Payload: accountPayload(resp.GetAccount()),
+		Error:   nil,
+	})
+}
+
+// handleAuthSendCode 向待绑定的邮箱或手机号发送验证码，验证通过前不会写入账号
+func (l *WsEntryLogic) handleAuthSendCode(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.AuthSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "auth service not configured")
+		return
+	}
+	var payload struct {
+		Channel string `json:"channel"`
+		Target  string `json:"target"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.Channel == "" || payload.Target == "" {
+		l.sendError(c, env.Tid, "bad_request", "channel and target are required")
+		return
+	}
+	l.Infow("rpc call", logx.Field("method", "auth.SendVerificationCode"), logx.Field("userId", c.UserID), logx.Field("channel", payload.Channel))
+	resp, err := l.svcCtx.AuthSvc.SendVerificationCode(l.ctx, &authservice.SendVerificationCodeRequest{
+		UserId:  c.UserID,
+		Channel: payload.Channel,
+		Target:  payload.Target,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.ResourceExhausted:
+				// 重发冷却期内
+				l.sendError(c, env.Tid, "rate_limited", s.Message())
+				return
+			case codes.Unavailable:
+				l.sendError(c, env.Tid, "unavailable", s.Message())
+				return
+			}
+		}
+		l.Errorf("send verification code failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type: "auth.sendCode.ok",
+		Tid:  env.Tid,
+		Payload: map[string]any{
+			"expiresIn":   resp.GetExpiresIn(),
+			"resendAfter": resp.GetResendAfter(),
+		},
+		Error: nil,
+	})
+}
+
+// handleAuthVerifyIdentifier 校验验证码，通过后邮箱或手机号绑定到账号并可用于 auth.login
+func (l *WsEntryLogic) handleAuthVerifyIdentifier(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.AuthSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "auth service not configured")
+		return
+	}
+	var payload struct {
+		Channel string `json:"channel"`
+		Code    string `json:"code"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.Channel == "" || payload.Code == "" {
+		l.sendError(c, env.Tid, "bad_request", "channel and code are required")
+		return
+	}
+	l.Infow("rpc call", logx.Field("method", "auth.VerifyIdentifier"), logx.Field("userId", c.UserID), logx.Field("channel", payload.Channel))
+	resp, err := l.svcCtx.AuthSvc.VerifyIdentifier(l.ctx, &authservice.VerifyIdentifierRequest{
+		UserId:  c.UserID,
+		Channel: payload.Channel,
+		Code:    payload.Code,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.ResourceExhausted:
+				// 错误次数过多，需重新发送验证码
+				l.sendError(c, env.Tid, "rate_limited", s.Message())
+				return
+			}
+		}
+		l.Errorf("verify identifier failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "auth.verifyIdentifier.ok",
+		Tid:     env.Tid,
+		Payload: accountPayload(resp.GetAccount()),
+		Error:   nil,
+	})
+}
+
+// handleAuthChangeUsername 修改用户名，旧用户名在保留期内不可被他人注册或改用
+func (l *WsEntryLogic) handleAuthChangeUsername(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.AuthSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "auth service not configured")
+		return
+	}
+	var payload struct {
+		Username string `json:"username"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.Username == "" {
+		l.sendError(c, env.Tid, "bad_request", "username is required")
+		return
+	}
+	l.Infow("rpc call", logx.Field("method", "auth.ChangeUsername"), logx.Field("userId", c.UserID), logx.Field("username", payload.Username))
+	resp, err := l.svcCtx.AuthSvc.ChangeUsername(l.ctx, &authservice.ChangeUsernameRequest{
+		UserId:   c.UserID,
+		Username: payload.Username,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
+		}
+		l.Errorf("change username failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "auth.changeUsername.ok",
+		Tid:     env.Tid,
+		Payload: accountPayload(resp.GetAccount()),
+		Error:   nil,
+	})
+}
+
+// accountPayload 未绑定的邮箱、手机号为空字符串，对应时间为 0
+func accountPayload(a *authservice.Account) map[string]any {
+	return map[string]any{
+		"userId":            a.GetUserId(),
+		"username":          a.GetUsername(),
+		"email":             a.GetEmail(),
+		"emailVerifiedAt":   a.GetEmailVerifiedAt(),
+		"phone":             a.GetPhone(),
+		"phoneVerifiedAt":   a.GetPhoneVerifiedAt(),
+		"usernameChangedAt": a.GetUsernameChangedAt(),
+		"createdAt":         a.GetCreatedAt(),
+	}
+}
+
+// afterAuthSuccess 在登录或 tokenLogin 成功后绑定 UserID 并注册 Presence。
+func (l *WsEntryLogic) afterAuthSuccess(c *ws.Connection, env *ws.Envelope, resp *authservice.LoginResponse, deviceID string) {
+	if resp == nil || resp.UserId == "" {
+		l.sendError(c, env.Tid, "internal_error", "empty auth response")
+		return
+	}
+	if l.svcCtx.PresenceSvc != nil {
+		l.Infow("rpc call", logx.Field("method", "presence.RegisterSession"), logx.Field("userId", resp.UserId), logx.Field("connId", c.ConnID))
+		if _, err := l.svcCtx.PresenceSvc.RegisterSession(l.ctx, &presenceservice.RegisterSessionRequest{
+			UserId:     resp.UserId,
+			GatewayId:  l.svcCtx.Config.GatewayID,
+			ConnId:     c.ConnID,
+			DeviceId:   deviceID,
+			DeviceType: "",
+			Ip:         "",
+		}); err != nil {
+			l.Errorf("register session failed for user %s conn %s: %v", resp.UserId, c.ConnID, err)
+			l.sendError(c, env.Tid, "internal_error", "failed to register session")
+			return
+		}
+	}
+	c.BindUser(resp.UserId)
+	// 返回 auth.login.ok 或 auth.tokenLogin.ok
+	okType := env.Type + ".ok"
+	_ = c.WriteJSON(&ws.Envelope{
+		Type: okType,
+		Tid:  env.Tid,
+		Payload: map[string]any{
+			"userId":       resp.UserId,
+			"accessToken":  resp.AccessToken,
+			"refreshToken": resp.RefreshToken,
+			"expiresIn":    resp.ExpiresIn,
+		},
+		Error: nil,
+	})
+}
+
+// bindJSONPayload 将 Envelope.Payload 反序列化为给定结构体。
+func (l *WsEntryLogic) bindJSONPayload(c *ws.Connection, env *ws.Envelope, v interface{}) bool {
+	if env.Payload == nil {
+		l.sendError(c, env.Tid, "bad_request", "missing payload")
+		return false
+	}
+	raw, err := json.Marshal(env.Payload)
+	if err != nil {
+		l.sendError(c, env.Tid, "bad_request", "invalid payload format")
+		return false
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		l.sendError(c, env.Tid, "bad_request", "invalid payload json")
+		return false
+	}
+	return true
+}
+
+func (l *WsEntryLogic) handleConversationList(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		Cursor        string `json:"cursor"`
+		Limit         int32  `json:"limit"`
+		Archived      bool   `json:"archived"`
+		IncludeHidden bool   `json:"includeHidden"`
+		SyncToken     string `json:"syncToken"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.Limit <= 0 {
+		payload.Limit = 50
+	}
+	if payload.Limit > 100 {
+		payload.Limit = 100
+	}
+	resp, err := l.svcCtx.ConversationSvc.ListUserConversations(l.ctx, &conversationservice.ListUserConversationsRequest{
+		UserId:        c.UserID,
+		Cursor:        payload.Cursor,
+		Limit:         payload.Limit,
+		Archived:      payload.Archived,
+		IncludeHidden: payload.IncludeHidden,
+		SyncToken:     payload.SyncToken,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
+			return
+		}
+		l.Errorf("list user conversations failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	// 增量模式只为有新消息且仍在其中的会话查询最后一条消息与未读数
+	incremental := payload.SyncToken != ""
+	convIDs := make([]string, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		if incremental && (!item.ActivityChanged || item.MemberStatus != "active") {
+			continue
+		}
+		convIDs = append(convIDs, item.Id)
+	}
+	var lastMessages map[string]*messageservice.MessageRecord
+	var unreadCounts map[string]int32
+	if l.svcCtx.MessageSvc != nil && len(convIDs) > 0 {
+		lastResp, _ := l.svcCtx.MessageSvc.GetLastMessages(l.ctx, &messageservice.GetLastMessagesRequest{ConversationIds: convIDs, UserId: c.UserID})
+		if lastResp != nil {
+			lastMessages = lastResp.LastMessages
+		}
+		unreadResp, _ := l.svcCtx.MessageSvc.GetUnreadCounts(l.ctx, &messageservice.GetUnreadCountsRequest{UserId: c.UserID, ConversationIds: convIDs})
+		if unreadResp != nil && unreadResp.Counts != nil {
+			unreadCounts = unreadResp.Counts
+		}
+	}
+	items := make([]map[string]any, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		unread := int(0)
+		if unreadCounts != nil {
+			if n, ok := unreadCounts[item.Id]; ok {
+				unread = int(n)
+			}
+		}
+		joinType := item.JoinType
+		if joinType == "" {
+			joinType = "approval"
+		}
+		entry := map[string]any{
+			"id":            item.Id,
+			"name":          item.Name,
+			"avatar":        item.AvatarUrl,
+			"type":          item.Type,
+			"memberCount":   item.MemberCount,
+			"announcement":  item.Announcement,
+			"joinType":      joinType,
+			"messageTtlSeconds": item.MessageTtlSeconds,
+			"unreadCount":   unread,
+			"lastActiveAt":  item.LastActiveAt,
+			"settings":      memberSettingsPayload(item.Settings),
+		}
+		if incremental {
+			entry["status"] = item.Status
+			entry["memberStatus"] = item.MemberStatus
+			entry["activityChanged"] = item.ActivityChanged
+			if !item.ActivityChanged || item.MemberStatus != "active" {
+				// 未查询未读数，客户端沿用本地值
+				delete(entry, "unreadCount")
+			}
+		}
+		if lastMessages != nil {
+			if lm, ok := lastMessages[item.Id]; ok && lm != nil {
+				preview := ""
+				if lm.Body != nil {
+					preview = lm.Body.Text
+				}
+				lastMessage := map[string]any{
+					"serverMsgId": lm.ServerMsgId,
+					"preview":     preview,
+					"serverTime":  lm.ServerTime,
+				}
+				if lm.Body != nil && lm.Body.System != nil {
+					lastMessage["system"] = systemPayload(lm.Body.System)
+				}
+				entry["lastMessage"] = lastMessage
+			}
+		}
+		items = append(items, entry)
+	}
+	var nextCursor interface{}
+	if resp.NextCursor != "" {
+		nextCursor = resp.NextCursor
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.list.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"items": items, "nextCursor": nextCursor, "syncToken": resp.SyncToken, "hasMore": resp.HasMore},
+		Error:   nil,
+	})
+}
+
+func (l *WsEntryLogic) handleConversationCreate(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		Type       string   `json:"type"`
+		Name       string   `json:"name"`
+		MemberIds  []string `json:"memberIds"`
+		ToUsername string   `json:"toUsername"`
+		ToAccount  string   `json:"toAccount"`
+		IsPublic   bool     `json:"isPublic"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	memberIds := payload.MemberIds
+	if payload.Type == "single" && len(memberIds) == 0 && (payload.ToUsername != "" || payload.ToAccount != "") {
+		if l.svcCtx.UserSvc == nil {
+			l.sendError(c, env.Tid, "unavailable", "user service not configured for resolve")
+			return
+		}
+		var toUserId string
+		if payload.ToAccount != "" {
+			u, err := l.svcCtx.UserSvc.GetUser(l.ctx, &userservice.GetUserRequest{Id: payload.ToAccount, ViewerId: c.UserID})
+			if err != nil || u.GetUser() == nil {
+				l.sendError(c, env.Tid, "not_found", "user not found")
+				return
+			}
+			toUserId = u.User.Id
+		} else {
+			u, err := l.svcCtx.UserSvc.GetUserByUsername(l.ctx, &userservice.GetUserByUsernameRequest{Username: payload.ToUsername, ViewerId: c.UserID})
+			if err != nil || u.GetUser() == nil {
+				l.sendError(c, env.Tid, "not_found", "user not found")
+				return
+			}
+			toUserId = u.User.Id
+		}
+		if toUserId == c.UserID {
+			l.sendError(c, env.Tid, "bad_request", "cannot create chat with self")
+			return
+		}
+		memberIds = []string{c.UserID, toUserId}
+	}
+	var conversationId string
+	if payload.Type == "single" && len(memberIds) == 2 {
+		// 单聊：同一对用户只保留一个会话，查找或创建
+		findResp, err := l.svcCtx.ConversationSvc.FindOrCreateSingleConversation(l.ctx, &conversationservice.FindOrCreateSingleConversationRequest{
+			UserId_1: memberIds[0],
+			UserId_2: memberIds[1],
+		})
+		if err != nil {
+			if s, ok := status.FromError(err); ok {
+				switch s.Code() {
+				case codes.InvalidArgument, codes.FailedPrecondition:
+					l.sendError(c, env.Tid, "bad_request", s.Message())
+					return
+				case codes.PermissionDenied:
+					l.sendError(c, env.Tid, "forbidden", s.Message())
+					return
+				}
+			}
+			l.Errorf("find or create single conversation failed: %v", err)
+			l.sendError(c, env.Tid, "internal_error", err.Error())
+			return
+		}
+		conversationId = findResp.ConversationId
+	} else {
+		resp, err := l.svcCtx.ConversationSvc.CreateConversation(l.ctx, &conversationservice.CreateConversationRequest{
+			Type:       payload.Type,
+			Name:       payload.Name,
+			MemberIds:  memberIds,
+			OperatorId: c.UserID,
+			IsPublic:   payload.IsPublic,
+		})
+		if err != nil {
+			if s, ok := status.FromError(err); ok {
+				switch s.Code() {
+				case codes.InvalidArgument:
+					l.sendError(c, env.Tid, "bad_request", s.Message())
+					return
+				case codes.AlreadyExists:
+					l.sendError(c, env.Tid, "bad_request", s.Message())
+					return
+				case codes.PermissionDenied:
+					l.sendError(c, env.Tid, "forbidden", s.Message())
+					return
+				}
+			}
+			l.Errorf("create conversation failed: %v", err)
+			l.sendError(c, env.Tid, "internal_error", err.Error())
+			return
+		}
+		conversationId = resp.ConversationId
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.create.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": conversationId},
+		Error:   nil,
+	})
+}
+
+func (l *WsEntryLogic) handleConversationAddMember(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		UserId         string `json:"userId"`
+		Role           string `json:"role"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and userId are required")
+		return
+	}
+	if payload.Role == "" {
+		payload.Role = "member"
+	}
+	_, err := l.svcCtx.ConversationSvc.AddMember(l.ctx, &conversationservice.AddMemberRequest{
+		ConversationId: payload.ConversationId,
+		UserId:         payload.UserId,
+		Role:           payload.Role,
+		OperatorId:     c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.AlreadyExists:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("add member failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.addMember.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{},
+		Error:   nil,
+	})
+}
+
+func (l *WsEntryLogic) handleConversationRemoveMember(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		UserId         string `json:"userId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and userId are required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.RemoveMember(l.ctx, &conversationservice.RemoveMemberRequest{
+		ConversationId: payload.ConversationId,
+		UserId:         payload.UserId,
+		OperatorId:     c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("remove member failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.removeMember.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{},
+		Error:   nil,
+	})
+}
+
+// handleConversationSetMemberRole 群主将成员设为管理员或普通成员
+func (l *WsEntryLogic) handleConversationSetMemberRole(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		UserId         string `json:"userId"`
+		Role           string `json:"role"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.UserId == "" || payload.Role == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId, userId and role are required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.SetMemberRole(l.ctx, &conversationservice.SetMemberRoleRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		UserId:         payload.UserId,
+		Role:           payload.Role,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("set member role failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.setMemberRole.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId, "userId": payload.UserId, "role": payload.Role},
+		Error:   nil,
+	})
+}
+
+// handleConversationTransferOwnership 群主转让群主身份，原群主降为管理员
+func (l *WsEntryLogic) handleConversationTransferOwnership(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		NewOwnerId     string `json:"newOwnerId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.NewOwnerId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and newOwnerId are required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.TransferOwnership(l.ctx, &conversationservice.TransferOwnershipRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		NewOwnerId:     payload.NewOwnerId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("transfer ownership failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.transferOwnership.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId, "newOwnerId": payload.NewOwnerId},
+		Error:   nil,
+	})
+}
+
+// handleConversationLeave 当前用户退出群聊；群主需先转让群主（最后一名成员除外）
+func (l *WsEntryLogic) handleConversationLeave(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.LeaveConversation(l.ctx, &conversationservice.LeaveConversationRequest{
+		ConversationId: payload.ConversationId,
+		UserId:         c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("leave conversation failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.leave.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId},
+		Error:   nil,
+	})
+}
+
+// handleConversationDissolve 群主解散群；成功后由 conversation.dissolved 推送给所有在线成员，群变为只读
+func (l *WsEntryLogic) handleConversationDissolve(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.DissolveConversation(l.ctx, &conversationservice.DissolveConversationRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("dissolve conversation failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	payloadOut := map[string]any{"conversationId": payload.ConversationId}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.dissolve.ok",
+		Tid:     env.Tid,
+		Payload: payloadOut,
+		Error:   nil,
+	})
+}
+
+// handleConversationBan 群主/管理员封禁用户（可封禁非成员）；被封禁者移出群且不能再加入
+func (l *WsEntryLogic) handleConversationBan(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		UserId         string `json:"userId"`
+		Reason         string `json:"reason"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and userId are required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.BanMember(l.ctx, &conversationservice.BanMemberRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		UserId:         payload.UserId,
+		Reason:         payload.Reason,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("ban member failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	payloadOut := map[string]any{"conversationId": payload.ConversationId, "userId": payload.UserId}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.ban.ok",
+		Tid:     env.Tid,
+		Payload: payloadOut,
+		Error:   nil,
+	})
+}
+
+// handleConversationUnban 群主/管理员解除封禁
+func (l *WsEntryLogic) handleConversationUnban(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		UserId         string `json:"userId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and userId are required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.UnbanMember(l.ctx, &conversationservice.UnbanMemberRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		UserId:         payload.UserId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("unban member failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	payloadOut := map[string]any{"conversationId": payload.ConversationId, "userId": payload.UserId}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.unban.ok",
+		Tid:     env.Tid,
+		Payload: payloadOut,
+		Error:   nil,
+	})
+}
+
+// handleConversationListBanned 群主/管理员查看封禁名单
+func (l *WsEntryLogic) handleConversationListBanned(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.ListBannedMembers(l.ctx, &conversationservice.ListBannedMembersRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.AlreadyExists, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("list banned members failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	members := make([]map[string]any, 0, len(resp.Items))
+	for _, m := range resp.Items {
+		members = append(members, map[string]any{
+			"userId":   m.UserId,
+			"joinedAt": m.JoinedAt,
+			"status":   m.Status,
+		})
+	}
+	payloadOut := map[string]any{"conversationId": payload.ConversationId, "members": members}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.listBanned.ok",
+		Tid:     env.Tid,
+		Payload: payloadOut,
+		Error:   nil,
+	})
+}
+
+// handleConversationMuteAll 群主/管理员开启或关闭全员禁言；变更由 conversation.muteAllChanged 推送给在线成员
+func (l *WsEntryLogic) handleConversationMuteAll(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		Enabled        bool   `json:"enabled"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.SetMuteAll(l.ctx, &conversationservice.SetMuteAllRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		Enabled:        payload.Enabled,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("set mute all failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.muteAll.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId, "muteAll": payload.Enabled},
+		Error:   nil,
+	})
+}
+
+// handleConversationMuteMember 群主/管理员禁言成员 durationSeconds 秒；变更由 conversation.memberMuted 推送给在线成员
+func (l *WsEntryLogic) handleConversationMuteMember(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId  string `json:"conversationId"`
+		UserId          string `json:"userId"`
+		DurationSeconds int64  `json:"durationSeconds"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and userId are required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.MuteMember(l.ctx, &conversationservice.MuteMemberRequest{
+		ConversationId:  payload.ConversationId,
+		OperatorId:      c.UserID,
+		UserId:          payload.UserId,
+		DurationSeconds: payload.DurationSeconds,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("mute member failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.muteMember.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId, "userId": payload.UserId, "mutedUntil": resp.SpeakMutedUntil},
+		Error:   nil,
+	})
+}
+
+// handleConversationUnmuteMember 群主/管理员解除成员禁言
+func (l *WsEntryLogic) handleConversationUnmuteMember(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		UserId         string `json:"userId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.UserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and userId are required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.UnmuteMember(l.ctx, &conversationservice.UnmuteMemberRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		UserId:         payload.UserId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("unmute member failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.unmuteMember.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId, "userId": payload.UserId},
+		Error:   nil,
+	})
+}
+
+// errorInfo 返回 gRPC 错误中 Reason 为 reason 的 ErrorInfo，没有时返回 nil
+func errorInfo(s *status.Status, reason string) *errdetails.ErrorInfo {
+	for _, d := range s.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Reason == reason {
+			return info
+		}
+	}
+	return nil
+}
+
+// handleChannelSubscribe 订阅频道；订阅后在线时收到 channel.digest，历史消息通过 message.history 拉取
+func (l *WsEntryLogic) handleChannelSubscribe(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.SubscribeChannel(l.ctx, &conversationservice.SubscribeChannelRequest{
+		ConversationId: payload.ConversationId,
+		UserId:         c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
+		}
+		l.Errorf("subscribe channel failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "channel.subscribe.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId, "subscriberCount": resp.SubscriberCount},
+		Error:   nil,
+	})
+}
+
+// handleChannelUnsubscribe 取消订阅频道
+func (l *WsEntryLogic) handleChannelUnsubscribe(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.UnsubscribeChannel(l.ctx, &conversationservice.UnsubscribeChannelRequest{
+		ConversationId: payload.ConversationId,
+		UserId:         c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
+		}
+		l.Errorf("unsubscribe channel failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "channel.unsubscribe.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId},
+		Error:   nil,
+	})
+}
+
+// handleChannelListSubscribed 当前用户订阅的频道列表
+func (l *WsEntryLogic) handleChannelListSubscribed(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		Cursor string `json:"cursor"`
+		Limit  int32  `json:"limit"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.ListSubscribedChannels(l.ctx, &conversationservice.ListSubscribedChannelsRequest{
+		UserId: c.UserID,
+		Cursor: payload.Cursor,
+		Limit:  payload.Limit,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
+			return
+		}
+		l.Errorf("list subscribed channels failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "channel.listSubscribed.ok",
+		Tid:     env.Tid,
+		Payload: channelListPayload(resp),
+		Error:   nil,
+	})
+}
+
+// handleChannelDirectory 公开频道目录，按订阅数倒序，可按名称搜索
+func (l *WsEntryLogic) handleChannelDirectory(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		Query  string `json:"query"`
+		Cursor string `json:"cursor"`
+		Limit  int32  `json:"limit"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.ListPublicChannels(l.ctx, &conversationservice.ListPublicChannelsRequest{
+		Query:  payload.Query,
+		Cursor: payload.Cursor,
+		Limit:  payload.Limit,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
+			return
+		}
+		l.Errorf("list public channels failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "channel.directory.ok",
+		Tid:     env.Tid,
+		Payload: channelListPayload(resp),
+		Error:   nil,
+	})
+}
+
+// channelListPayload 频道列表响应：items 与 nextCursor（无更多时为 null）
+func channelListPayload(resp *conversationservice.ListChannelsResponse) map[string]any {
+	items := make([]map[string]any, 0, len(resp.Items))
+	for _, ch := range resp.Items {
+		items = append(items, map[string]any{
+			"id":              ch.Id,
+			"name":            ch.Name,
+			"avatar":          ch.AvatarUrl,
+			"announcement":    ch.Announcement,
+			"isPublic":        ch.IsPublic,
+			"subscriberCount": ch.SubscriberCount,
+			"publisherCount":  ch.MemberCount,
+			"createdAt":       ch.CreatedAt,
+			"lastActiveAt":    ch.LastActiveAt,
+		})
+	}
+	var nextCursor interface{}
+	if resp.NextCursor != "" {
+		nextCursor = resp.NextCursor
+	}
+	return map[string]any{"items": items, "nextCursor": nextCursor}
+}
+
+// handleConversationSetNickname 设置本人在群内的昵称，空串清除
+func (l *WsEntryLogic) handleConversationSetNickname(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		Nickname       string `json:"nickname"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.SetMemberNickname(l.ctx, &conversationservice.SetMemberNicknameRequest{
+		ConversationId: payload.ConversationId,
+		UserId:         c.UserID,
+		Nickname:       payload.Nickname,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("set member nickname failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.setNickname.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId, "nickname": strings.TrimSpace(payload.Nickname)},
+		Error:   nil,
+	})
+}
+
+// handleConversationUpdate 群主/管理员修改群资料；payload 中出现的字段才会更新，成功后由 conversation.updated 推送给所有在线成员
+func (l *WsEntryLogic) handleConversationUpdate(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string  `json:"conversationId"`
+		Name           *string `json:"name"`
+		Announcement   *string `json:"announcement"`
+		JoinType       *string `json:"joinType"`
+		Avatar         *string `json:"avatar"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	req := &conversationservice.UpdateConversationRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		UpdateMask:     &fieldmaskpb.FieldMask{},
+	}
+	if payload.Name != nil {
+		req.Name = *payload.Name
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "name")
+	}
+	if payload.Announcement != nil {
+		req.Announcement = *payload.Announcement
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "announcement")
+	}
+	if payload.JoinType != nil {
+		req.JoinType = *payload.JoinType
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "join_type")
+	}
+	if payload.Avatar != nil {
+		req.AvatarUrl = *payload.Avatar
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "avatar_url")
+	}
+	if len(req.UpdateMask.Paths) == 0 {
+		l.sendError(c, env.Tid, "bad_request", "nothing to update")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.UpdateConversation(l.ctx, req)
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("update conversation failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	conv := resp.Conversation
+	_ = c.WriteJSON(&ws.Envelope{
+		Type: "conversation.update.ok",
+		Tid:  env.Tid,
+		Payload: map[string]any{
+			"id":           conv.Id,
+			"name":         conv.Name,
+			"avatar":       conv.AvatarUrl,
+			"announcement": conv.Announcement,
+			"joinType":     conv.JoinType,
+		},
+		Error: nil,
+	})
+}
+
+// handleConversationAnnouncements 拉取群公告历史（作者、发布时间），按时间倒序
+func (l *WsEntryLogic) handleConversationAnnouncements(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		Limit          int32  `json:"limit"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.ListAnnouncements(l.ctx, &conversationservice.ListAnnouncementsRequest{
+		ConversationId: payload.ConversationId,
+		UserId:         c.UserID,
+		Limit:          payload.Limit,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("list announcements failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	items := make([]map[string]any, 0, len(resp.Items))
+	for _, a := range resp.Items {
+		items = append(items, map[string]any{
+			"id":        a.Id,
+			"content":   a.Content,
+			"authorId":  a.AuthorId,
+			"createdAt": a.CreatedAt,
+		})
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "conversation.announcements.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": payload.ConversationId, "items": items},
+		Error:   nil,
+	})
+}
+
+func (l *WsEntryLogic) handleConversationGet(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		Id string `json:"id"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.Id == "" {
+		l.sendError(c, env.Tid, "bad_request", "id is required")
+		return
+	}
+	// 只借 operator_id 校验调用者为 active 成员，取 1 条即可
+	_, err := l.svcCtx.ConversationSvc.ListMembers(l.ctx, &conversationservice.ListMembersRequest{
+		ConversationId: payload.Id,
+		OperatorId:     c.UserID,
+		Limit:          1,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", "not a member of this conversation")
+				return
+			}
+		}
+		l.Errorf("list members for get failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.GetConversation(l.ctx, &conversationservice.GetConversationRequest{Id: payload.Id})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			l.sendError(c, env.Tid, "not_found", s.Message())
+			return
+		}
+		l.Errorf("get conversation failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	conv := resp.Conversation
+	joinType := conv.GetJoinType()
+	if joinType == "" {
+		joinType = "approval"
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type: "conversation.get.ok",
+		Tid:  env.Tid,
+		Payload: map[string]any{
+			"id":            conv.Id,
+			"type":          conv.Type,
+			"name":          conv.Name,
+			"avatar":        conv.AvatarUrl,
+			"memberCount":   conv.MemberCount,
+			"announcement":  conv.Announcement,
+			"joinType":      joinType,
+			"messageTtlSeconds": conv.MessageTtlSeconds,
+			"status":       conv.Status,
+			"muteAll":      conv.MuteAll,
+			"isPublic":     conv.IsPublic,
+			"subscriberCount": conv.SubscriberCount,
+			"maxMembers":   conv.MaxMembers,
+			"createdAt":    conv.CreatedAt,
+			"lastActiveAt": conv.LastActiveAt,
+		},
+		Error: nil,
+	})
+}
+
+func (l *WsEntryLogic) handleConversationListMembers(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string   `json:"conversationId"`
+		Cursor         string   `json:"cursor"`
+		Limit          int32    `json:"limit"`
+		Query          string   `json:"query"`
+		Roles          []string `json:"roles"`
+		Statuses       []string `json:"statuses"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.ListMembers(l.ctx, &conversationservice.ListMembersRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		Cursor:         payload.Cursor,
+		Limit:          payload.Limit,
+		Query:          payload.Query,
+		Roles:          payload.Roles,
+		Statuses:       payload.Statuses,
+		WithProfile:    true,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", "not a member of this conversation")
+				return
+			}
+		}
+		l.Errorf("list members failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	members := make([]map[string]any, 0, len(resp.Items))
+	for _, m := range resp.Items {
+		members = append(members, map[string]any{
+			"userId":          m.UserId,
+			"role":            m.Role,
+			"joinedAt":        m.JoinedAt,
+			"status":          m.Status,
+			"speakMutedUntil": m.SpeakMutedUntil,
+			"nickname":        m.Nickname,
+			"profileNickname": m.ProfileNickname,
+			"avatar":          m.AvatarUrl,
+		})
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type: "conversation.listMembers.ok",
+		Tid:  env.Tid,
+		Payload: map[string]any{
+			"members":    members,
+			"nextCursor": resp.NextCursor,
+		},
+		Error: nil,
+	})
+}
+
+func (l *WsEntryLogic) handleMessageSend(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.MessageSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "message service not configured")
+		return
+	}
+	if l.svcCtx.MessageSendLimit != nil {
+		allow, err := l.svcCtx.MessageSendLimit.Allow(l.ctx, c.UserID)
+		if err != nil {
+			l.Errorf("rate limit check failed: %v", err)
+			l.sendError(c, env.Tid, "internal_error", "rate limit check failed")
+			return
+		}
+		if !allow {
+			_ = c.WriteJSON(&ws.Envelope{
+				Type:    "message.send.error",
+				Tid:     env.Tid,
+				Payload: nil,
+				Error:   &ws.ErrBody{Code: "rate_limited", Message: "too many requests"},
+			})
+			return
+		}
+	}
+	var payload struct {
+		ClientMsgId     string `json:"clientMsgId"`
+		ConversationId string `json:"conversationId"`
+		ToUserId        string `json:"toUserId"`
+		Body            struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"body"`
+		SendAt int64 `json:"sendAt"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	conversationId := payload.ConversationId
+	if conversationId == "" {
+		if payload.ToUserId == "" {
+			l.sendError(c, env.Tid, "bad_request", "conversationId or toUserId is required")
+			return
+		}
+		if l.svcCtx.ConversationSvc == nil {
+			l.sendError(c, env.Tid, "unavailable", "conversation service not configured for single chat")
+			return
+		}
+		findResp, err := l.svcCtx.ConversationSvc.FindOrCreateSingleConversation(l.ctx, &conversationservice.FindOrCreateSingleConversationRequest{
+			UserId_1: c.UserID,
+			UserId_2: payload.ToUserId,
+		})
+		if err != nil {
+			if s, ok := status.FromError(err); ok {
+				switch s.Code() {
+				case codes.InvalidArgument, codes.FailedPrecondition:
+					l.sendError(c, env.Tid, "bad_request", s.Message())
+					return
+				case codes.PermissionDenied:
+					l.sendError(c, env.Tid, "forbidden", s.Message())
+					return
+				}
+			}
+			l.Errorf("find or create single conversation failed: %v", err)
+			l.sendError(c, env.Tid, "internal_error", err.Error())
+			return
+		}
+		conversationId = findResp.ConversationId
+	}
+	if payload.Body.Type == "" {
+		payload.Body.Type = "text"
+	}
+	if payload.Body.Type == "system" {
+		l.sendError(c, env.Tid, "bad_request", "system messages cannot be sent by clients")
+		return
+	}
+	resp, err := l.svcCtx.MessageSvc.PostMessage(l.ctx, &messageservice.PostMessageRequest{
+		ClientMsgId:     payload.ClientMsgId,
+		ConversationId:  conversationId,
+		FromUserId:     c.UserID,
+		ToUserId:       payload.ToUserId,
+		Body:           &messageservice.MessageBody{Type: payload.Body.Type, Text: payload.Body.Text},
+		SendAt:         payload.SendAt,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				// 禁言：message.send.error 带 muted 错误码，payload 说明禁言范围与截止时间
+				if info := errorInfo(s, "MUTED"); info != nil {
+					mutedUntil, _ := strconv.ParseInt(info.Metadata["mutedUntil"], 10, 64)
+					_ = c.WriteJSON(&ws.Envelope{
+						Type:    "message.send.error",
+						Tid:     env.Tid,
+						Payload: map[string]any{"conversationId": conversationId, "scope": info.Metadata["scope"], "mutedUntil": mutedUntil},
+						Error:   &ws.ErrBody{Code: "muted", Message: s.Message()},
+					})
+					return
+				}
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("post message failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "message.send.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{
+			"serverMsgId":    resp.ServerMsgId,
+			"serverTime":     resp.ServerTime,
+			"conversationId": resp.ConversationId,
+			"scheduled":      resp.Scheduled,
+		},
+		Error: nil,
+	})
+}
+
+func (l *WsEntryLogic) handleMessageHistory(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.MessageSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "message service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		Before         int64  `json:"before"`
+		Limit          int32  `json:"limit"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	if payload.Limit <= 0 {
+		payload.Limit = 50
+	}
+	if payload.Limit > 100 {
+		payload.Limit = 100
+	}
+	resp, err := l.svcCtx.MessageSvc.GetHistory(l.ctx, &messageservice.GetHistoryRequest{
+		ConversationId: payload.ConversationId,
+		BeforeTime:    payload.Before,
+		Limit:         payload.Limit,
+		UserId:        c.UserID,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
+			return
+		}
+		l.Errorf("get history failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	items := make([]map[string]any, 0, len(resp.Items))
+	for _, m := range resp.Items {
+		body := map[string]any{"type": "text"}
+		if m.Body != nil {
+			body["type"] = m.Body.Type
+			body["text"] = m.Body.Text
+			if m.Body.System != nil {
+				body["system"] = systemPayload(m.Body.System)
+			}
+		}
+		items = append(items, map[string]any{
+			"serverMsgId":    m.ServerMsgId,
+			"clientMsgId":    nil,
+			"conversationId": m.ConversationId,
+			"fromUserId":     m.FromUserId,
+			"toUserId":       m.ToUserId,
+			"body":           body,
+			"serverTime":     m.ServerTime,
+			"expiresAt":      m.ExpiresAt,
+		})
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "message.history.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"items": items, "hasMore": resp.HasMore},
+		Error:   nil,
+	})
+}
+
+func (l *WsEntryLogic) handleMessageRead(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.MessageSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "message service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		ServerMsgId    string `json:"serverMsgId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.ServerMsgId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and serverMsgId are required")
+		return
+	}
+	_, err := l.svcCtx.MessageSvc.MarkRead(l.ctx, &messageservice.MarkReadRequest{
+		UserId:         c.UserID,
+		ConversationId:  payload.ConversationId,
+		ServerMsgId:     payload.ServerMsgId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
+		}
+		l.Errorf("mark read failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
 	}
-	c.BindUser(resp.UserId)
-	// 返回 auth.login.ok 或 auth.tokenLogin.ok
-	okType := env.Type + ".ok"
 	_ = c.WriteJSON(&ws.Envelope{
-		Type: okType,
-		Tid:  env.Tid,
-		Payload: map[string]any{
-			"userId":       resp.UserId,
-			"accessToken":  resp.AccessToken,
-			"refreshToken": resp.RefreshToken,
-			"expiresIn":    resp.ExpiresIn,
-		},
-		Error: nil,
+		Type:    "message.read.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{},
+		Error:   nil,
 	})
 }
 
-// bindJSONPayload 将 Envelope.Payload 反序列化为给定结构体。
-func (l *WsEntryLogic) bindJSONPayload(c *ws.Connection, env *ws.Envelope, v interface{}) bool {
-	if env.Payload == nil {
-		l.sendError(c, env.Tid, "bad_request", "missing payload")
-		return false
-	}
-	raw, err := json.Marshal(env.Payload)
-	if err != nil {
-		l.sendError(c, env.Tid, "bad_request", "invalid payload format")
-		return false
-	}
-	if err := json.Unmarshal(raw, v); err != nil {
-		l.sendError(c, env.Tid, "bad_request", "invalid payload json")
-		return false
-	}
-	return true
-}
-
-func (l *WsEntryLogic) handleConversationList(c *ws.Connection, env *ws.Envelope) {
-	if l.svcCtx.ConversationSvc == nil {
-		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+// handleMessageScheduledList 列出当前用户尚未发出的定时消息，可按会话过滤。
+func (l *WsEntryLogic) handleMessageScheduledList(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.MessageSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "message service not configured")
 		return
 	}
 	var payload struct {
-		Cursor string `json:"cursor"`
-		Limit  int32  `json:"limit"`
+		ConversationId string `json:"conversationId"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	if payload.Limit <= 0 {
-		payload.Limit = 50
-	}
-	if payload.Limit > 100 {
-		payload.Limit = 100
-	}
-	resp, err := l.svcCtx.ConversationSvc.ListUserConversations(l.ctx, &conversationservice.ListUserConversationsRequest{
-		UserId: c.UserID,
-		Cursor: payload.Cursor,
-		Limit:  payload.Limit,
+	resp, err := l.svcCtx.MessageSvc.ListScheduledMessages(l.ctx, &messageservice.ListScheduledMessagesRequest{
+		UserId:         c.UserID,
+		ConversationId: payload.ConversationId,
 	})
 	if err != nil {
 		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
 			l.sendError(c, env.Tid, "bad_request", s.Message())
 			return
 		}
-		l.Errorf("list user conversations failed: %v", err)
+		l.Errorf("list scheduled messages failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
-	convIDs := make([]string, 0, len(resp.Items))
-	for _, item := range resp.Items {
-		convIDs = append(convIDs, item.Id)
-	}
-	var lastMessages map[string]*messageservice.MessageRecord
-	var unreadCounts map[string]int32
-	if l.svcCtx.MessageSvc != nil && len(convIDs) > 0 {
-		lastResp, _ := l.svcCtx.MessageSvc.GetLastMessages(l.ctx, &messageservice.GetLastMessagesRequest{ConversationIds: convIDs})
-		if lastResp != nil {
-			lastMessages = lastResp.LastMessages
-		}
-		unreadResp, _ := l.svcCtx.MessageSvc.GetUnreadCounts(l.ctx, &messageservice.GetUnreadCountsRequest{UserId: c.UserID, ConversationIds: convIDs})
-		if unreadResp != nil && unreadResp.Counts != nil {
-			unreadCounts = unreadResp.Counts
-		}
-	}
 	items := make([]map[string]any, 0, len(resp.Items))
-	for _, item := range resp.Items {
-		unread := int(0)
-		if unreadCounts != nil {
-			if n, ok := unreadCounts[item.Id]; ok {
-				unread = int(n)
-			}
-		}
-		joinType := item.JoinType
-		if joinType == "" {
-			joinType = "approval"
-		}
-		entry := map[string]any{
-			"id":            item.Id,
-			"name":          item.Name,
-			"avatar":        "",
-			"type":          item.Type,
-			"memberCount":   item.MemberCount,
-			"announcement":  item.Announcement,
-			"joinType":      joinType,
-			"unreadCount":   unread,
-			"lastActiveAt":  item.LastActiveAt,
-		}
-		if lastMessages != nil {
-			if lm, ok := lastMessages[item.Id]; ok && lm != nil {
-				preview := ""
-				if lm.Body != nil {
-					preview = lm.Body.Text
-				}
-				entry["lastMessage"] = map[string]any{
-					"serverMsgId": lm.ServerMsgId,
-					"preview":     preview,
-					"serverTime":  lm.ServerTime,
-				}
-			}
+	for _, m := range resp.Items {
+		body := map[string]any{"type": "text"}
+		if m.Body != nil {
+			body["type"] = m.Body.Type
+			body["text"] = m.Body.Text
 		}
-		items = append(items, entry)
-	}
-	var nextCursor interface{}
-	if resp.NextCursor != "" {
-		nextCursor = resp.NextCursor
+		items = append(items, map[string]any{
+			"serverMsgId":    m.ServerMsgId,
+			"clientMsgId":    m.ClientMsgId,
+			"conversationId": m.ConversationId,
+			"toUserId":       m.ToUserId,
+			"body":           body,
+			"sendAt":         m.SendAt,
+			"createdAt":      m.CreatedAt,
+		})
 	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "conversation.list.ok",
+		Type:    "message.scheduledList.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{"items": items, "nextCursor": nextCursor},
+		Payload: map[string]any{"items": items},
 		Error:   nil,
 	})
 }
 
-func (l *WsEntryLogic) handleConversationCreate(c *ws.Connection, env *ws.Envelope) {
-	if l.svcCtx.ConversationSvc == nil {
-		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+// handleMessageCancelScheduled 取消一条尚未发出的定时消息。
+func (l *WsEntryLogic) handleMessageCancelScheduled(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.MessageSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "message service not configured")
 		return
 	}
 	var payload struct {
-		Type       string   `json:"type"`
-		Name       string   `json:"name"`
-		MemberIds  []string `json:"memberIds"`
-		ToUsername string   `json:"toUsername"`
-		ToAccount  string   `json:"toAccount"`
+		ServerMsgId string `json:"serverMsgId"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	memberIds := payload.MemberIds
-	if payload.Type == "single" && len(memberIds) == 0 && (payload.ToUsername != "" || payload.ToAccount != "") {
-		if l.svcCtx.UserSvc == nil {
-			l.sendError(c, env.Tid, "unavailable", "user service not configured for resolve")
-			return
-		}
-		var toUserId string
-		if payload.ToAccount != "" {
-			u, err := l.svcCtx.UserSvc.GetUser(l.ctx, &userservice.GetUserRequest{Id: payload.ToAccount})
-			if err != nil || u.GetUser() == nil {
-				l.sendError(c, env.Tid, "not_found", "user not found")
-				return
-			}
-			toUserId = u.User.Id
-		} else {
-			u, err := l.svcCtx.UserSvc.GetUserByUsername(l.ctx, &userservice.GetUserByUsernameRequest{Username: payload.ToUsername})
-			if err != nil || u.GetUser() == nil {
-				l.sendError(c, env.Tid, "not_found", "user not found")
-				return
-			}
-			toUserId = u.User.Id
-		}
-		if toUserId == c.UserID {
-			l.sendError(c, env.Tid, "bad_request", "cannot create chat with self")
-			return
-		}
-		memberIds = []string{c.UserID, toUserId}
+	if payload.ServerMsgId == "" {
+		l.sendError(c, env.Tid, "bad_request", "serverMsgId is required")
+		return
 	}
-	var conversationId string
-	if payload.Type == "single" && len(memberIds) == 2 {
-		// 单聊：同一对用户只保留一个会话，查找或创建
-		findResp, err := l.svcCtx.ConversationSvc.FindOrCreateSingleConversation(l.ctx, &conversationservice.FindOrCreateSingleConversationRequest{
-			UserId_1: memberIds[0],
-			UserId_2: memberIds[1],
-		})
-		if err != nil {
-			if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+	_, err := l.svcCtx.MessageSvc.CancelScheduledMessage(l.ctx, &messageservice.CancelScheduledMessageRequest{
+		UserId:      c.UserID,
+		ServerMsgId: payload.ServerMsgId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
 				l.sendError(c, env.Tid, "bad_request", s.Message())
 				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
 			}
-			l.Errorf("find or create single conversation failed: %v", err)
-			l.sendError(c, env.Tid, "internal_error", err.Error())
-			return
-		}
-		conversationId = findResp.ConversationId
-	} else {
-		resp, err := l.svcCtx.ConversationSvc.CreateConversation(l.ctx, &conversationservice.CreateConversationRequest{
-			Type:      payload.Type,
-			Name:      payload.Name,
-			MemberIds: memberIds,
-		})
-		if err != nil {
-			if s, ok := status.FromError(err); ok {
-				switch s.Code() {
-				case codes.InvalidArgument:
-					l.sendError(c, env.Tid, "bad_request", s.Message())
-					return
-				case codes.AlreadyExists:
-					l.sendError(c, env.Tid, "bad_request", s.Message())
-					return
-				}
-			}
-			l.Errorf("create conversation failed: %v", err)
-			l.sendError(c, env.Tid, "internal_error", err.Error())
-			return
 		}
-		conversationId = resp.ConversationId
+		l.Errorf("cancel scheduled message failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
 	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "conversation.create.ok",
+		Type:    "message.cancelScheduled.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{"conversationId": conversationId},
+		Payload: map[string]any{},
 		Error:   nil,
 	})
 }
 
-func (l *WsEntryLogic) handleConversationAddMember(c *ws.Connection, env *ws.Envelope) {
-	if l.svcCtx.ConversationSvc == nil {
-		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+// handleMessageDeleteForMe 仅对当前用户删除消息；其他设备通过 message.deletedForMe 推送同步。
+func (l *WsEntryLogic) handleMessageDeleteForMe(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.MessageSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "message service not configured")
 		return
 	}
 	var payload struct {
-		ConversationId string `json:"conversationId"`
-		UserId         string `json:"userId"`
-		Role           string `json:"role"`
+		ConversationId string   `json:"conversationId"`
+		ServerMsgIds   []string `json:"serverMsgIds"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	if payload.ConversationId == "" || payload.UserId == "" {
-		l.sendError(c, env.Tid, "bad_request", "conversationId and userId are required")
+	if payload.ConversationId == "" || len(payload.ServerMsgIds) == 0 {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and serverMsgIds are required")
 		return
 	}
-	if payload.Role == "" {
-		payload.Role = "member"
-	}
-	_, err := l.svcCtx.ConversationSvc.AddMember(l.ctx, &conversationservice.AddMemberRequest{
+	resp, err := l.svcCtx.MessageSvc.DeleteForMe(l.ctx, &messageservice.DeleteForMeRequest{
+		UserId:         c.UserID,
 		ConversationId: payload.ConversationId,
-		UserId:         payload.UserId,
-		Role:           payload.Role,
+		ServerMsgIds:   payload.ServerMsgIds,
 	})
 	if err != nil {
 		if s, ok := status.FromError(err); ok {
@@ -577,45 +2969,44 @@ func (l *WsEntryLogic) handleConversationAddMember(c *ws.Connection, env *ws.Env
 			case codes.InvalidArgument:
 				l.sendError(c, env.Tid, "bad_request", s.Message())
 				return
-			case codes.NotFound:
-				l.sendError(c, env.Tid, "not_found", s.Message())
-				return
-			case codes.AlreadyExists:
-				l.sendError(c, env.Tid, "bad_request", s.Message())
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
 				return
 			}
 		}
-		l.Errorf("add member failed: %v", err)
+		l.Errorf("delete for me failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "conversation.addMember.ok",
+		Type:    "message.deleteForMe.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{},
+		Payload: map[string]any{"deleted": resp.Deleted},
 		Error:   nil,
 	})
 }
 
-func (l *WsEntryLogic) handleConversationRemoveMember(c *ws.Connection, env *ws.Envelope) {
+// handleConversationSetMessageTtl 设置会话消息自动销毁时长（阅后即焚），ttlSeconds 为 0 表示关闭。
+func (l *WsEntryLogic) handleConversationSetMessageTtl(c *ws.Connection, env *ws.Envelope) {
 	if l.svcCtx.ConversationSvc == nil {
 		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
 		return
 	}
 	var payload struct {
 		ConversationId string `json:"conversationId"`
-		UserId         string `json:"userId"`
+		TtlSeconds     int32  `json:"ttlSeconds"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	if payload.ConversationId == "" || payload.UserId == "" {
-		l.sendError(c, env.Tid, "bad_request", "conversationId and userId are required")
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
 		return
 	}
-	_, err := l.svcCtx.ConversationSvc.RemoveMember(l.ctx, &conversationservice.RemoveMemberRequest{
+	_, err := l.svcCtx.ConversationSvc.SetMessageTTL(l.ctx, &conversationservice.SetMessageTTLRequest{
 		ConversationId: payload.ConversationId,
-		UserId:         payload.UserId,
+		UserId:         c.UserID,
+		TtlSeconds:     payload.TtlSeconds,
 	})
 	if err != nil {
 		if s, ok := status.FromError(err); ok {
@@ -626,95 +3017,88 @@ func (l *WsEntryLogic) handleConversationRemoveMember(c *ws.Connection, env *ws.
 			case codes.NotFound:
 				l.sendError(c, env.Tid, "not_found", s.Message())
 				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
 			}
 		}
-		l.Errorf("remove member failed: %v", err)
+		l.Errorf("set message ttl failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "conversation.removeMember.ok",
+		Type:    "conversation.setMessageTtl.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{},
+		Payload: map[string]any{"conversationId": payload.ConversationId, "ttlSeconds": payload.TtlSeconds},
 		Error:   nil,
 	})
 }
 
-func (l *WsEntryLogic) handleConversationGet(c *ws.Connection, env *ws.Envelope) {
-	if l.svcCtx.ConversationSvc == nil {
-		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+// handleConversationTyping 发送「正在输入/正在录音」等瞬时信号，经 Redis Pub/Sub 转发给会话其他在线成员，不落库。
+// 节流窗口内的重复信号直接丢弃并仍返回 ok，客户端无需处理。
+func (l *WsEntryLogic) handleConversationTyping(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.SignalBus == nil {
+		l.sendError(c, env.Tid, "unavailable", "signal bus not configured")
 		return
 	}
 	var payload struct {
-		Id string `json:"id"`
+		ConversationId string `json:"conversationId"`
+		State          string `json:"state"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	if payload.Id == "" {
-		l.sendError(c, env.Tid, "bad_request", "id is required")
-		return
-	}
-	membersResp, err := l.svcCtx.ConversationSvc.ListMembers(l.ctx, &conversationservice.ListMembersRequest{ConversationId: payload.Id})
-	if err != nil {
-		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
-			l.sendError(c, env.Tid, "not_found", s.Message())
-			return
-		}
-		l.Errorf("list members for get failed: %v", err)
-		l.sendError(c, env.Tid, "internal_error", err.Error())
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
 		return
 	}
-	var isMember bool
-	for _, m := range membersResp.Items {
-		if m.UserId == c.UserID && m.Status == "active" {
-			isMember = true
-			break
-		}
+	if payload.State == "" {
+		payload.State = signal.StateTyping
 	}
-	if !isMember {
-		l.sendError(c, env.Tid, "forbidden", "not a member of this conversation")
+	if !signal.ValidState(payload.State) {
+		l.sendError(c, env.Tid, "bad_request", "state must be typing, recording or idle")
 		return
 	}
-	resp, err := l.svcCtx.ConversationSvc.GetConversation(l.ctx, &conversationservice.GetConversationRequest{Id: payload.Id})
-	if err != nil {
+	err := l.svcCtx.SignalBus.Publish(l.ctx, &signal.Signal{
+		ConversationId: payload.ConversationId,
+		UserId:         c.UserID,
+		State:          payload.State,
+	})
+	switch {
+	case err == nil, errors.Is(err, signal.ErrThrottled):
+	case errors.Is(err, signal.ErrNotMember):
+		l.sendError(c, env.Tid, "forbidden", err.Error())
+		return
+	default:
 		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
 			l.sendError(c, env.Tid, "not_found", s.Message())
 			return
 		}
-		l.Errorf("get conversation failed: %v", err)
+		l.Errorf("publish typing signal failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
-	conv := resp.Conversation
-	joinType := conv.GetJoinType()
-	if joinType == "" {
-		joinType = "approval"
-	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type: "conversation.get.ok",
-		Tid:  env.Tid,
-		Payload: map[string]any{
-			"id":            conv.Id,
-			"type":          conv.Type,
-			"name":          conv.Name,
-			"memberCount":   conv.MemberCount,
-			"announcement":  conv.Announcement,
-			"joinType":      joinType,
-			"createdAt":    conv.CreatedAt,
-			"lastActiveAt": conv.LastActiveAt,
-		},
-		Error: nil,
+		Type:    "conversation.typing.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{},
+		Error:   nil,
 	})
 }
 
-func (l *WsEntryLogic) handleConversationListMembers(c *ws.Connection, env *ws.Envelope) {
+// handleConversationUpdateSettings 更新当前用户对会话的个人设置；payload 中未出现的字段保持不变。
+func (l *WsEntryLogic) handleConversationUpdateSettings(c *ws.Connection, env *ws.Envelope) {
 	if l.svcCtx.ConversationSvc == nil {
 		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
 		return
 	}
 	var payload struct {
-		ConversationId string `json:"conversationId"`
+		ConversationId string  `json:"conversationId"`
+		MutedUntil     *int64  `json:"mutedUntil"`
+		Pinned         *bool   `json:"pinned"`
+		Archived       *bool   `json:"archived"`
+		Hidden         *bool   `json:"hidden"`
+		Alias          *string `json:"alias"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
@@ -723,112 +3107,84 @@ func (l *WsEntryLogic) handleConversationListMembers(c *ws.Connection, env *ws.E
 		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
 		return
 	}
-	resp, err := l.svcCtx.ConversationSvc.ListMembers(l.ctx, &conversationservice.ListMembersRequest{ConversationId: payload.ConversationId})
+	resp, err := l.svcCtx.ConversationSvc.UpdateMemberSettings(l.ctx, &conversationservice.UpdateMemberSettingsRequest{
+		ConversationId: payload.ConversationId,
+		UserId:         c.UserID,
+		MutedUntil:     payload.MutedUntil,
+		Pinned:         payload.Pinned,
+		Archived:       payload.Archived,
+		Hidden:         payload.Hidden,
+		Alias:          payload.Alias,
+	})
 	if err != nil {
-		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
-			l.sendError(c, env.Tid, "not_found", s.Message())
-			return
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
 		}
-		l.Errorf("list members failed: %v", err)
+		l.Errorf("update member settings failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
-	var isMember bool
-	for _, m := range resp.Items {
-		if m.UserId == c.UserID && m.Status == "active" {
-			isMember = true
-			break
-		}
-	}
-	if !isMember {
-		l.sendError(c, env.Tid, "forbidden", "not a member of this conversation")
-		return
-	}
-	members := make([]map[string]any, 0, len(resp.Items))
-	for _, m := range resp.Items {
-		members = append(members, map[string]any{
-			"userId":   m.UserId,
-			"role":     m.Role,
-			"joinedAt": m.JoinedAt,
-			"status":   m.Status,
-		})
-	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "conversation.listMembers.ok",
+		Type:    "conversation.updateSettings.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{"members": members},
+		Payload: map[string]any{"conversationId": payload.ConversationId, "settings": memberSettingsPayload(resp.Settings)},
 		Error:   nil,
 	})
 }
 
-func (l *WsEntryLogic) handleMessageSend(c *ws.Connection, env *ws.Envelope) {
+// systemPayload 将系统消息的结构化内容转换为下行 body.system，字段与 message.push 一致
+func systemPayload(p *messageservice.SystemPayload) map[string]any {
+	return map[string]any{
+		"event":        p.Event,
+		"operatorId":   p.OperatorId,
+		"userIds":      p.UserIds,
+		"role":         p.Role,
+		"name":         p.Name,
+		"announcement": p.Announcement,
+	}
+}
+
+// memberSettingsPayload 将成员个人设置转为 WS payload
+func memberSettingsPayload(s *conversationservice.MemberSettings) map[string]any {
+	if s == nil {
+		s = &conversationservice.MemberSettings{}
+	}
+	return map[string]any{
+		"mutedUntil": s.MutedUntil,
+		"pinned":     s.Pinned,
+		"pinnedAt":   s.PinnedAt,
+		"archived":   s.Archived,
+		"hidden":     s.Hidden,
+		"alias":      s.Alias,
+	}
+}
+
+// handleConversationClearHistory 为当前用户清空会话历史；其他设备通过 conversation.historyCleared 推送同步。
+func (l *WsEntryLogic) handleConversationClearHistory(c *ws.Connection, env *ws.Envelope) {
 	if l.svcCtx.MessageSvc == nil {
 		l.sendError(c, env.Tid, "unavailable", "message service not configured")
 		return
 	}
-	if l.svcCtx.MessageSendLimit != nil {
-		allow, err := l.svcCtx.MessageSendLimit.Allow(l.ctx, c.UserID)
-		if err != nil {
-			l.Errorf("rate limit check failed: %v", err)
-			l.sendError(c, env.Tid, "internal_error", "rate limit check failed")
-			return
-		}
-		if !allow {
-			_ = c.WriteJSON(&ws.Envelope{
-				Type:    "message.send.error",
-				Tid:     env.Tid,
-				Payload: nil,
-				Error:   &ws.ErrBody{Code: "rate_limited", Message: "too many requests"},
-			})
-			return
-		}
-	}
 	var payload struct {
-		ClientMsgId     string `json:"clientMsgId"`
 		ConversationId string `json:"conversationId"`
-		ToUserId        string `json:"toUserId"`
-		Body            struct {
-			Type string `json:"type"`
-			Text string `json:"text"`
-		} `json:"body"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	conversationId := payload.ConversationId
-	if conversationId == "" {
-		if payload.ToUserId == "" {
-			l.sendError(c, env.Tid, "bad_request", "conversationId or toUserId is required")
-			return
-		}
-		if l.svcCtx.ConversationSvc == nil {
-			l.sendError(c, env.Tid, "unavailable", "conversation service not configured for single chat")
-			return
-		}
-		findResp, err := l.svcCtx.ConversationSvc.FindOrCreateSingleConversation(l.ctx, &conversationservice.FindOrCreateSingleConversationRequest{
-			UserId_1: c.UserID,
-			UserId_2: payload.ToUserId,
-		})
-		if err != nil {
-			if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
-				l.sendError(c, env.Tid, "bad_request", s.Message())
-				return
-			}
-			l.Errorf("find or create single conversation failed: %v", err)
-			l.sendError(c, env.Tid, "internal_error", err.Error())
-			return
-		}
-		conversationId = findResp.ConversationId
-	}
-	if payload.Body.Type == "" {
-		payload.Body.Type = "text"
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
 	}
-	resp, err := l.svcCtx.MessageSvc.PostMessage(l.ctx, &messageservice.PostMessageRequest{
-		ClientMsgId:     payload.ClientMsgId,
-		ConversationId:  conversationId,
-		FromUserId:     c.UserID,
-		ToUserId:       payload.ToUserId,
-		Body:           &messageservice.MessageBody{Type: payload.Body.Type, Text: payload.Body.Text},
+	resp, err := l.svcCtx.MessageSvc.ClearHistory(l.ctx, &messageservice.ClearHistoryRequest{
+		UserId:         c.UserID,
+		ConversationId: payload.ConversationId,
 	})
 	if err != nil {
 		if s, ok := status.FromError(err); ok {
@@ -836,109 +3192,144 @@ func (l *WsEntryLogic) handleMessageSend(c *ws.Connection, env *ws.Envelope) {
 			case codes.InvalidArgument:
 				l.sendError(c, env.Tid, "bad_request", s.Message())
 				return
-			case codes.NotFound:
-				l.sendError(c, env.Tid, "not_found", s.Message())
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
 				return
 			}
 		}
-		l.Errorf("post message failed: %v", err)
+		l.Errorf("clear history failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "message.send.ok",
+		Type:    "conversation.clearHistory.ok",
 		Tid:     env.Tid,
+		Payload: map[string]any{"clearedAt": resp.ClearedAt, "clearedServerMsgId": resp.ClearedServerMsgId},
+		Error:   nil,
+	})
+}
+
+// handleContactList 分页拉取联系人（含备注、标签与资料）；携带 syncToken 时为增量同步
+func (l *WsEntryLogic) handleContactList(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	var payload struct {
+		Cursor      string `json:"cursor"`
+		Limit       int32  `json:"limit"`
+		StarredOnly bool   `json:"starredOnly"`
+		Tag         string `json:"tag"`
+		SyncToken   string `json:"syncToken"`
+	}
+	if env.Payload != nil && !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.ListContacts(l.ctx, &userservice.ListContactsRequest{
+		OwnerId:     c.UserID,
+		Cursor:      payload.Cursor,
+		Limit:       payload.Limit,
+		StarredOnly: payload.StarredOnly,
+		Tag:         payload.Tag,
+		SyncToken:   payload.SyncToken,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
+			return
+		}
+		l.Errorf("list contacts failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	items := make([]map[string]any, 0, len(resp.GetItems()))
+	for _, it := range resp.GetItems() {
+		items = append(items, contactPayload(it))
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type: "contact.list.ok",
+		Tid:  env.Tid,
 		Payload: map[string]any{
-			"serverMsgId":    resp.ServerMsgId,
-			"serverTime":     resp.ServerTime,
-			"conversationId": resp.ConversationId,
+			"contactUserIds": resp.GetContactUserIds(),
+			"items":          items,
+			"nextCursor":     resp.GetNextCursor(),
+			"syncToken":      resp.GetSyncToken(),
+			"hasMore":        resp.GetHasMore(),
 		},
 		Error: nil,
 	})
 }
 
-func (l *WsEntryLogic) handleMessageHistory(c *ws.Connection, env *ws.Envelope) {
-	if l.svcCtx.MessageSvc == nil {
-		l.sendError(c, env.Tid, "unavailable", "message service not configured")
+// handleContactUpdate 修改联系人备注、星标、备忘；payload 中出现的字段才会更新
+func (l *WsEntryLogic) handleContactUpdate(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
 		return
 	}
 	var payload struct {
-		ConversationId string `json:"conversationId"`
-		Before         int64  `json:"before"`
-		Limit          int32  `json:"limit"`
+		ContactUserId string  `json:"contactUserId"`
+		Remark        *string `json:"remark"`
+		Starred       *bool   `json:"starred"`
+		Note          *string `json:"note"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	if payload.ConversationId == "" {
-		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+	if payload.ContactUserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "contactUserId is required")
 		return
 	}
-	if payload.Limit <= 0 {
-		payload.Limit = 50
-	}
-	if payload.Limit > 100 {
-		payload.Limit = 100
-	}
-	resp, err := l.svcCtx.MessageSvc.GetHistory(l.ctx, &messageservice.GetHistoryRequest{
-		ConversationId: payload.ConversationId,
-		BeforeTime:    payload.Before,
-		Limit:         payload.Limit,
+	resp, err := l.svcCtx.UserSvc.UpdateContact(l.ctx, &userservice.UpdateContactRequest{
+		OwnerId:       c.UserID,
+		ContactUserId: payload.ContactUserId,
+		Remark:        payload.Remark,
+		Starred:       payload.Starred,
+		Note:          payload.Note,
 	})
 	if err != nil {
-		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
-			l.sendError(c, env.Tid, "bad_request", s.Message())
-			return
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
 		}
-		l.Errorf("get history failed: %v", err)
+		l.Errorf("update contact failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
-	items := make([]map[string]any, 0, len(resp.Items))
-	for _, m := range resp.Items {
-		body := map[string]any{"type": "text"}
-		if m.Body != nil {
-			body["type"] = m.Body.Type
-			body["text"] = m.Body.Text
-		}
-		items = append(items, map[string]any{
-			"serverMsgId":    m.ServerMsgId,
-			"clientMsgId":    nil,
-			"conversationId": m.ConversationId,
-			"fromUserId":     m.FromUserId,
-			"toUserId":       m.ToUserId,
-			"body":           body,
-			"serverTime":     m.ServerTime,
-		})
-	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "message.history.ok",
+		Type:    "contact.update.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{"items": items, "hasMore": resp.HasMore},
+		Payload: contactPayload(resp.GetContact()),
 		Error:   nil,
 	})
 }
 
-func (l *WsEntryLogic) handleMessageRead(c *ws.Connection, env *ws.Envelope) {
-	if l.svcCtx.MessageSvc == nil {
-		l.sendError(c, env.Tid, "unavailable", "message service not configured")
+// handleContactSetTags 整体替换联系人标签
+func (l *WsEntryLogic) handleContactSetTags(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
 		return
 	}
 	var payload struct {
-		ConversationId string `json:"conversationId"`
-		ServerMsgId    string `json:"serverMsgId"`
+		ContactUserId string   `json:"contactUserId"`
+		Tags          []string `json:"tags"`
 	}
 	if !l.bindJSONPayload(c, env, &payload) {
 		return
 	}
-	if payload.ConversationId == "" || payload.ServerMsgId == "" {
-		l.sendError(c, env.Tid, "bad_request", "conversationId and serverMsgId are required")
+	if payload.ContactUserId == "" {
+		l.sendError(c, env.Tid, "bad_request", "contactUserId is required")
 		return
 	}
-	_, err := l.svcCtx.MessageSvc.MarkRead(l.ctx, &messageservice.MarkReadRequest{
-		UserId:         c.UserID,
-		ConversationId:  payload.ConversationId,
-		ServerMsgId:     payload.ServerMsgId,
+	resp, err := l.svcCtx.UserSvc.SetContactTags(l.ctx, &userservice.SetContactTagsRequest{
+		OwnerId:       c.UserID,
+		ContactUserId: payload.ContactUserId,
+		Tags:          payload.Tags,
 	})
 	if err != nil {
 		if s, ok := status.FromError(err); ok {
@@ -951,37 +3342,92 @@ func (l *WsEntryLogic) handleMessageRead(c *ws.Connection, env *ws.Envelope) {
 				return
 			}
 		}
-		l.Errorf("mark read failed: %v", err)
+		l.Errorf("set contact tags failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "message.read.ok",
+		Type:    "contact.setTags.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{},
+		Payload: contactPayload(resp.GetContact()),
 		Error:   nil,
 	})
 }
 
-func (l *WsEntryLogic) handleContactList(c *ws.Connection, env *ws.Envelope) {
+// handleContactSuggest 分页拉取好友推荐，按共同好友数、共同群数排序
+func (l *WsEntryLogic) handleContactSuggest(c *ws.Connection, env *ws.Envelope) {
 	if l.svcCtx.UserSvc == nil {
 		l.sendError(c, env.Tid, "unavailable", "user service not configured")
 		return
 	}
-	resp, err := l.svcCtx.UserSvc.ListContacts(l.ctx, &userservice.ListContactsRequest{OwnerId: c.UserID})
+	var payload struct {
+		Cursor string `json:"cursor"`
+		Limit  int32  `json:"limit"`
+	}
+	if env.Payload != nil && !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.SuggestContacts(l.ctx, &userservice.SuggestContactsRequest{
+		UserId: c.UserID,
+		Cursor: payload.Cursor,
+		Limit:  payload.Limit,
+	})
 	if err != nil {
-		l.Errorf("list contacts failed: %v", err)
+		if s, ok := status.FromError(err); ok && s.Code() == codes.InvalidArgument {
+			l.sendError(c, env.Tid, "bad_request", s.Message())
+			return
+		}
+		l.Errorf("suggest contacts failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
 		return
 	}
+	items := make([]map[string]any, 0, len(resp.GetItems()))
+	for _, it := range resp.GetItems() {
+		u := it.GetUser()
+		items = append(items, map[string]any{
+			"id":             u.GetId(),
+			"nickname":       u.GetNickname(),
+			"avatarUrl":      u.GetAvatarUrl(),
+			"bio":            u.GetBio(),
+			"mutualContacts": it.GetMutualContacts(),
+			"sharedGroups":   it.GetSharedGroups(),
+		})
+	}
 	_ = c.WriteJSON(&ws.Envelope{
-		Type:    "contact.list.ok",
+		Type:    "contact.suggest.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{"contactUserIds": resp.ContactUserIds},
+		Payload: map[string]any{"items": items, "nextCursor": resp.GetNextCursor()},
 		Error:   nil,
 	})
 }
 
+// contactPayload 联系人下行结构；profile 仅在 ListContacts 中返回，已删除的联系人没有 profile
+func contactPayload(ct *userservice.ContactInfo) map[string]any {
+	item := map[string]any{
+		"contactUserId": ct.GetContactUserId(),
+		"status":        ct.GetStatus(),
+		"remark":        ct.GetRemark(),
+		"tags":          ct.GetTags(),
+		"starred":       ct.GetStarred(),
+		"note":          ct.GetNote(),
+		"createdAt":     ct.GetCreatedAt(),
+		"updatedAt":     ct.GetUpdatedAt(),
+	}
+	if u := ct.GetUser(); u != nil {
+		item["profile"] = map[string]any{
+			"nickname":     u.GetNickname(),
+			"avatarUrl":    u.GetAvatarUrl(),
+			"bio":          u.GetBio(),
+			"status":       u.GetStatus(),
+			"lastSeenAt":   u.GetLastSeenAt(),
+			"customStatus": customStatusPayload(u.GetCustomStatus()),
+			"version":      u.GetVersion(),
+			"updatedAt":    u.GetUpdatedAt(),
+		}
+	}
+	return item
+}
+
 func (l *WsEntryLogic) handleContactAdd(c *ws.Connection, env *ws.Envelope) {
 	if l.svcCtx.UserSvc == nil {
 		l.sendError(c, env.Tid, "unavailable", "user service not configured")
@@ -997,7 +3443,7 @@ func (l *WsEntryLogic) handleContactAdd(c *ws.Connection, env *ws.Envelope) {
 	}
 	contactUserID := payload.ToUserId
 	if contactUserID == "" && payload.ToAccount != "" {
-		u, err := l.svcCtx.UserSvc.GetUser(l.ctx, &userservice.GetUserRequest{Id: payload.ToAccount})
+		u, err := l.svcCtx.UserSvc.GetUser(l.ctx, &userservice.GetUserRequest{Id: payload.ToAccount, ViewerId: c.UserID})
 		if err != nil || u.GetUser() == nil {
 			l.sendError(c, env.Tid, "not_found", "user not found")
 			return
@@ -1005,7 +3451,7 @@ func (l *WsEntryLogic) handleContactAdd(c *ws.Connection, env *ws.Envelope) {
 		contactUserID = u.User.Id
 	}
 	if contactUserID == "" && payload.ToUsername != "" {
-		u, err := l.svcCtx.UserSvc.GetUserByUsername(l.ctx, &userservice.GetUserByUsernameRequest{Username: payload.ToUsername})
+		u, err := l.svcCtx.UserSvc.GetUserByUsername(l.ctx, &userservice.GetUserByUsernameRequest{Username: payload.ToUsername, ViewerId: c.UserID})
 		if err != nil || u.GetUser() == nil {
 			l.sendError(c, env.Tid, "not_found", "user not found")
 			return
@@ -1094,14 +3540,14 @@ func (l *WsEntryLogic) handleContactRequest(c *ws.Connection, env *ws.Envelope)
 	case payload.ToUserId != "":
 		toUserID = payload.ToUserId
 	case payload.ToAccount != "":
-		u, err := l.svcCtx.UserSvc.GetUser(l.ctx, &userservice.GetUserRequest{Id: payload.ToAccount})
+		u, err := l.svcCtx.UserSvc.GetUser(l.ctx, &userservice.GetUserRequest{Id: payload.ToAccount, ViewerId: c.UserID})
 		if err != nil || u.GetUser() == nil {
 			l.sendError(c, env.Tid, "not_found", "to user not found")
 			return
 		}
 		toUserID = u.GetUser().GetId()
 	case payload.ToUsername != "":
-		u, err := l.svcCtx.UserSvc.GetUserByUsername(l.ctx, &userservice.GetUserByUsernameRequest{Username: payload.ToUsername})
+		u, err := l.svcCtx.UserSvc.GetUserByUsername(l.ctx, &userservice.GetUserByUsernameRequest{Username: payload.ToUsername, ViewerId: c.UserID})
 		if err != nil || u.GetUser() == nil {
 			l.sendError(c, env.Tid, "not_found", "to user not found")
 			return
@@ -1119,12 +3565,24 @@ func (l *WsEntryLogic) handleContactRequest(c *ws.Connection, env *ws.Envelope)
 	if err != nil {
 		if s, ok := status.FromError(err); ok {
 			switch s.Code() {
-			case codes.AlreadyExists:
+			case codes.AlreadyExists, codes.FailedPrecondition:
 				l.sendError(c, env.Tid, "bad_request", s.Message())
 				return
 			case codes.NotFound:
 				l.sendError(c, env.Tid, "not_found", s.Message())
 				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			case codes.ResourceExhausted:
+				// 被拒绝或撤回后的冷却期内
+				_ = c.WriteJSON(&ws.Envelope{
+					Type:    "contact.request.error",
+					Tid:     env.Tid,
+					Payload: nil,
+					Error:   &ws.ErrBody{Code: "rate_limited", Message: s.Message()},
+				})
+				return
 			}
 		}
 		l.Errorf("create contact request failed: %v", err)
@@ -1134,7 +3592,7 @@ func (l *WsEntryLogic) handleContactRequest(c *ws.Connection, env *ws.Envelope)
 	_ = c.WriteJSON(&ws.Envelope{
 		Type:    "contact.request.ok",
 		Tid:     env.Tid,
-		Payload: map[string]any{"requestId": resp.GetRequestId()},
+		Payload: map[string]any{"requestId": resp.GetRequestId(), "expiresAt": resp.GetExpiresAt()},
 		Error:   nil,
 	})
 }
@@ -1144,7 +3602,13 @@ func (l *WsEntryLogic) handleContactRequestList(c *ws.Connection, env *ws.Envelo
 		l.sendError(c, env.Tid, "unavailable", "user service not configured")
 		return
 	}
-	resp, err := l.svcCtx.UserSvc.ListContactRequests(l.ctx, &userservice.ListContactRequestsRequest{UserId: c.UserID})
+	var payload struct {
+		Sent bool `json:"sent"`
+	}
+	if env.Payload != nil && !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	resp, err := l.svcCtx.UserSvc.ListContactRequests(l.ctx, &userservice.ListContactRequestsRequest{UserId: c.UserID, Sent: payload.Sent})
 	if err != nil {
 		l.Errorf("list contact requests failed: %v", err)
 		l.sendError(c, env.Tid, "internal_error", err.Error())
@@ -1158,6 +3622,7 @@ func (l *WsEntryLogic) handleContactRequestList(c *ws.Connection, env *ws.Envelo
 			"toUserId":   it.GetToUserId(),
 			"message":    it.GetMessage(),
 			"createdAt":  it.GetCreatedAt(),
+			"expiresAt":  it.GetExpiresAt(),
 		})
 	}
 	_ = c.WriteJSON(&ws.Envelope{
@@ -1240,6 +3705,43 @@ func (l *WsEntryLogic) handleContactDecline(c *ws.Connection, env *ws.Envelope)
 	})
 }
 
+// handleContactWithdraw 发起方撤回自己发出的待处理好友申请
+func (l *WsEntryLogic) handleContactWithdraw(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.UserSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "user service not configured")
+		return
+	}
+	var payload struct {
+		RequestId string `json:"requestId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.RequestId == "" {
+		l.sendError(c, env.Tid, "bad_request", "requestId is required")
+		return
+	}
+	_, err := l.svcCtx.UserSvc.WithdrawContactRequest(l.ctx, &userservice.WithdrawContactRequestRequest{
+		UserId:    c.UserID,
+		RequestId: payload.RequestId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok && s.Code() == codes.NotFound {
+			l.sendError(c, env.Tid, "not_found", s.Message())
+			return
+		}
+		l.Errorf("withdraw contact request failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "contact.withdraw.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{},
+		Error:   nil,
+	})
+}
+
 func (l *WsEntryLogic) handleGroupApply(c *ws.Connection, env *ws.Envelope) {
 	if l.svcCtx.ConversationSvc == nil {
 		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
@@ -1267,9 +3769,12 @@ func (l *WsEntryLogic) handleGroupApply(c *ws.Connection, env *ws.Envelope) {
 			case codes.NotFound:
 				l.sendError(c, env.Tid, "not_found", s.Message())
 				return
-			case codes.AlreadyExists:
+			case codes.AlreadyExists, codes.FailedPrecondition:
 				l.sendError(c, env.Tid, "bad_request", s.Message())
 				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
 			}
 		}
 		l.Errorf("apply join group failed: %v", err)
@@ -1364,6 +3869,9 @@ func (l *WsEntryLogic) handleGroupApprove(c *ws.Connection, env *ws.Envelope) {
 			case codes.NotFound:
 				l.sendError(c, env.Tid, "not_found", s.Message())
 				return
+			case codes.FailedPrecondition:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
 			}
 		}
 		l.Errorf("approve join request failed: %v", err)
@@ -1421,3 +3929,262 @@ func (l *WsEntryLogic) handleGroupDecline(c *ws.Connection, env *ws.Envelope) {
 		Error:   nil,
 	})
 }
+
+// handleGroupCreateInvite 群主/管理员创建邀请链接
+func (l *WsEntryLogic) handleGroupCreateInvite(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		ExpiresAt      int64  `json:"expiresAt"`
+		MaxUses        int32  `json:"maxUses"`
+		BypassApproval bool   `json:"bypassApproval"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.CreateInviteLink(l.ctx, &conversationservice.CreateInviteLinkRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		ExpiresAt:      payload.ExpiresAt,
+		MaxUses:        payload.MaxUses,
+		BypassApproval: payload.BypassApproval,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("create invite link failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "group.createInvite.ok",
+		Tid:     env.Tid,
+		Payload: inviteLinkPayload(resp.Link),
+		Error:   nil,
+	})
+}
+
+// handleGroupRevokeInvite 群主/管理员撤销邀请链接
+func (l *WsEntryLogic) handleGroupRevokeInvite(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId string `json:"conversationId"`
+		LinkId         string `json:"linkId"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" || payload.LinkId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId and linkId are required")
+		return
+	}
+	_, err := l.svcCtx.ConversationSvc.RevokeInviteLink(l.ctx, &conversationservice.RevokeInviteLinkRequest{
+		ConversationId: payload.ConversationId,
+		OperatorId:     c.UserID,
+		LinkId:         payload.LinkId,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("revoke invite link failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "group.revokeInvite.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"linkId": payload.LinkId},
+		Error:   nil,
+	})
+}
+
+// handleGroupListInvites 群主/管理员查看邀请链接
+func (l *WsEntryLogic) handleGroupListInvites(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		ConversationId  string `json:"conversationId"`
+		IncludeInactive bool   `json:"includeInactive"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.ConversationId == "" {
+		l.sendError(c, env.Tid, "bad_request", "conversationId is required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.ListInviteLinks(l.ctx, &conversationservice.ListInviteLinksRequest{
+		ConversationId:  payload.ConversationId,
+		OperatorId:      c.UserID,
+		IncludeInactive: payload.IncludeInactive,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			case codes.PermissionDenied:
+				l.sendError(c, env.Tid, "forbidden", s.Message())
+				return
+			}
+		}
+		l.Errorf("list invite links failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	items := make([]map[string]any, 0, len(resp.Items))
+	for _, link := range resp.Items {
+		items = append(items, inviteLinkPayload(link))
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "group.listInvites.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"items": items},
+		Error:   nil,
+	})
+}
+
+// handleGroupPreviewInvite 凭 token 预览群资料，无需是群成员
+func (l *WsEntryLogic) handleGroupPreviewInvite(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		Token string `json:"token"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.Token == "" {
+		l.sendError(c, env.Tid, "bad_request", "token is required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.PreviewInviteLink(l.ctx, &conversationservice.PreviewInviteLinkRequest{Token: payload.Token})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition, codes.AlreadyExists:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
+		}
+		l.Errorf("preview invite link failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type: "group.previewInvite.ok",
+		Tid:  env.Tid,
+		Payload: map[string]any{
+			"conversationId": resp.ConversationId,
+			"name":           resp.Name,
+			"avatar":         resp.AvatarUrl,
+			"memberCount":    resp.MemberCount,
+			"bypassApproval": resp.BypassApproval,
+			"expiresAt":      resp.ExpiresAt,
+		},
+		Error: nil,
+	})
+}
+
+// handleGroupJoinByInvite 凭邀请链接入群；需审批时提交入群申请，joined 为 false
+func (l *WsEntryLogic) handleGroupJoinByInvite(c *ws.Connection, env *ws.Envelope) {
+	if l.svcCtx.ConversationSvc == nil {
+		l.sendError(c, env.Tid, "unavailable", "conversation service not configured")
+		return
+	}
+	var payload struct {
+		Token   string `json:"token"`
+		Message string `json:"message"`
+	}
+	if !l.bindJSONPayload(c, env, &payload) {
+		return
+	}
+	if payload.Token == "" {
+		l.sendError(c, env.Tid, "bad_request", "token is required")
+		return
+	}
+	resp, err := l.svcCtx.ConversationSvc.JoinByInvite(l.ctx, &conversationservice.JoinByInviteRequest{
+		Token:   payload.Token,
+		UserId:  c.UserID,
+		Message: payload.Message,
+	})
+	if err != nil {
+		if s, ok := status.FromError(err); ok {
+			switch s.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition, codes.AlreadyExists:
+				l.sendError(c, env.Tid, "bad_request", s.Message())
+				return
+			case codes.NotFound:
+				l.sendError(c, env.Tid, "not_found", s.Message())
+				return
+			}
+		}
+		l.Errorf("join by invite failed: %v", err)
+		l.sendError(c, env.Tid, "internal_error", err.Error())
+		return
+	}
+	_ = c.WriteJSON(&ws.Envelope{
+		Type:    "group.joinByInvite.ok",
+		Tid:     env.Tid,
+		Payload: map[string]any{"conversationId": resp.ConversationId, "joined": resp.Joined},
+		Error:   nil,
+	})
+}
+
+func inviteLinkPayload(link *conversationservice.InviteLinkInfo) map[string]any {
+	return map[string]any{
+		"id":             link.Id,
+		"conversationId": link.ConversationId,
+		"token":          link.Token,
+		"createdBy":      link.CreatedBy,
+		"expiresAt":      link.ExpiresAt,
+		"maxUses":        link.MaxUses,
+		"useCount":       link.UseCount,
+		"bypassApproval": link.BypassApproval,
+		"revoked":        link.Revoked,
+		"createdAt":      link.CreatedAt,
+	}
+}
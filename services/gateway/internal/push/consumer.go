@@ -3,15 +3,18 @@ package push
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"sync"
 
 	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/zeromicro/go-zero/core/collection"
 	"github.com/zeromicro/go-zero/core/logx"
 
 	"github.com/HappyLadySauce/Beehive/services/conversation/conversationservice"
 	"github.com/HappyLadySauce/Beehive/services/gateway/internal/config"
 	"github.com/HappyLadySauce/Beehive/services/gateway/internal/ws"
 	"github.com/HappyLadySauce/Beehive/services/presence/presenceservice"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
 )
 
 // messageCreatedEvent 与 Message 服务 PostMessage 发布的 JSON 一致。
@@ -23,23 +26,71 @@ type messageCreatedEvent struct {
 	ToUserId      string                 `json:"toUserId"`
 	Body          map[string]interface{} `json:"body"`
 	ServerTime    float64                `json:"serverTime"` // JSON number
+	ExpiresAt     float64                `json:"expiresAt"`  // 阅后即焚消息的过期时间，0 表示不过期
 }
 
-// Consumer 消费 message.created 并向本实例连接推送 message.push。
+// userEvent 只推送给单个用户所有在线设备的事件（多端同步），payload 原样透传给客户端。
+type userEvent struct {
+	UserId string `json:"userId"`
+}
+
+// userEventRouteKeys 按用户推送的事件路由键；推送给客户端的 Envelope.type 与路由键相同。
+var userEventRouteKeys = []string{
+	"message.deletedForMe",
+	"conversation.historyCleared",
+	"message.scheduledFailed",
+	"contact.request.received",
+	"contact.request.accepted",
+	"account.deleted",
+}
+
+// conversationEvent 推送给会话全部 active 成员的事件，payload 原样透传给客户端。
+type conversationEvent struct {
+	ConversationId string `json:"conversationId"`
+	UserId         string `json:"userId"`
+}
+
+// conversationEventRouteKeys 按会话推送的事件路由键；推送给客户端的 Envelope.type 与路由键相同。
+var conversationEventRouteKeys = []string{
+	"message.expired",
+	"conversation.updated",
+	"conversation.dissolved",
+	"conversation.memberBanned",
+	"conversation.memberUnbanned",
+	"conversation.muteAllChanged",
+	"conversation.memberMuted",
+}
+
+// userUpdatedRouteKey 用户资料变更事件，推送给 UserService.StreamProfileAudience 返回的用户（本人、联系人、共同会话成员）。
+const userUpdatedRouteKey = "user.updated"
+
+// targetUserRouteKeys 除会话 active 成员外还需推送给 payload.userId 本人的事件（被封禁/解封者已不是 active 成员）。
+var targetUserRouteKeys = map[string]bool{
+	"conversation.memberBanned":   true,
+	"conversation.memberUnbanned": true,
+}
+
+// Consumer 消费 message.created 并向本实例连接推送 message.push；同时消费 userEventRouteKeys、conversationEventRouteKeys 中的事件推送给对应用户或会话成员，
+// 以及 user.updated 推送给关注该用户资料的用户。
 type Consumer struct {
 	cfg       config.Config
+	routeKey  string
 	hub       *ws.Hub
 	conv      conversationservice.ConversationService
 	pres      presenceservice.PresenceService
+	user      userservice.UserService
 	conn      *amqp.Connection
 	ch        *amqp.Channel
 	queueName string
 	closed    bool
 	mu        sync.Mutex
+	// convTypes 缓存会话类型，用于识别频道消息
+	convTypes *collection.Cache
+	digest    *channelDigest
 }
 
 // NewConsumer 创建推送消费者；调用方需在退出时调用 Close。
-func NewConsumer(cfg config.Config, hub *ws.Hub, conv conversationservice.ConversationService, pres presenceservice.PresenceService) (*Consumer, error) {
+func NewConsumer(cfg config.Config, hub *ws.Hub, conv conversationservice.ConversationService, pres presenceservice.PresenceService, user userservice.UserService) (*Consumer, error) {
 	exchange := cfg.RabbitMQExchange
 	if exchange == "" {
 		exchange = "im.events"
@@ -76,13 +127,26 @@ func NewConsumer(cfg config.Config, hub *ws.Hub, conv conversationservice.Conver
 		_ = conn.Close()
 		return nil, err
 	}
-	if err := ch.QueueBind(q.Name, routeKey, exchange, false, nil); err != nil {
+	keys := append([]string{routeKey}, userEventRouteKeys...)
+	keys = append(keys, conversationEventRouteKeys...)
+	keys = append(keys, userUpdatedRouteKey)
+	for _, key := range keys {
+		if err := ch.QueueBind(q.Name, key, exchange, false, nil); err != nil {
+			_ = ch.Close()
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+
+	convTypes, err := collection.NewCache(convTypeCacheTTL, collection.WithName("push-conv-types"))
+	if err != nil {
 		_ = ch.Close()
 		_ = conn.Close()
 		return nil, err
 	}
 
-	return &Consumer{cfg: cfg, hub: hub, conv: conv, pres: pres, conn: conn, ch: ch, queueName: q.Name}, nil
+	return &Consumer{cfg: cfg, routeKey: routeKey, hub: hub, conv: conv, pres: pres, user: user, conn: conn, ch: ch, queueName: q.Name,
+		convTypes: convTypes, digest: newChannelDigest()}, nil
 }
 
 // Run 在调用方 goroutine 中阻塞消费；返回时表示连接关闭或 Close 被调用。
@@ -92,6 +156,7 @@ func (c *Consumer) Run(ctx context.Context) {
 		logx.Errorf("push consumer: Consume failed: %v", err)
 		return
 	}
+	go c.runDigest(ctx)
 	for {
 		select {
 		case <-ctx.Done():
@@ -106,6 +171,19 @@ func (c *Consumer) Run(ctx context.Context) {
 }
 
 func (c *Consumer) handleDelivery(ctx context.Context, d *amqp.Delivery) {
+	switch {
+	case d.RoutingKey == "" || d.RoutingKey == c.routeKey:
+		c.handleMessageCreated(ctx, d)
+	case isConversationEvent(d.RoutingKey):
+		c.handleConversationEvent(ctx, d)
+	case d.RoutingKey == userUpdatedRouteKey:
+		c.handleUserUpdated(ctx, d)
+	default:
+		c.handleUserEvent(ctx, d)
+	}
+}
+
+func (c *Consumer) handleMessageCreated(ctx context.Context, d *amqp.Delivery) {
 	var ev messageCreatedEvent
 	if err := json.Unmarshal(d.Body, &ev); err != nil {
 		logx.Errorf("push consumer: unmarshal message.created failed: %v", err)
@@ -117,17 +195,6 @@ func (c *Consumer) handleDelivery(ctx context.Context, d *amqp.Delivery) {
 		return
 	}
 
-	membersResp, err := c.conv.ListMembers(ctx, &conversationservice.ListMembersRequest{ConversationId: ev.ConversationId})
-	if err != nil {
-		logx.Errorf("push consumer: ListMembers failed conversationId=%s: %v", ev.ConversationId, err)
-		_ = d.Nack(false, true)
-		return
-	}
-
-	gatewayID := c.cfg.GatewayID
-	if gatewayID == "" {
-		gatewayID = "gateway-1"
-	}
 	serverTime := int64(ev.ServerTime)
 	payload := map[string]interface{}{
 		"serverMsgId":    ev.ServerMsgId,
@@ -138,45 +205,223 @@ func (c *Consumer) handleDelivery(ctx context.Context, d *amqp.Delivery) {
 		"body":          ev.Body,
 		"serverTime":    serverTime,
 	}
-
-	seenConn := make(map[string]struct{})
-	for _, m := range membersResp.Items {
-		if m == nil || m.UserId == "" {
-			continue
+	if ev.ExpiresAt > 0 {
+		payload["expiresAt"] = int64(ev.ExpiresAt)
+	}
+	env := &ws.Envelope{
+		Type:    "message.push",
+		Tid:     ev.ClientMsgId,
+		Payload: payload,
+		Error:   nil,
+	}
+	// 免打扰的成员仍然收到消息用于同步，但标记 muted=true，客户端据此不弹通知
+	mutedPayload := make(map[string]interface{}, len(payload)+1)
+	for k, v := range payload {
+		mutedPayload[k] = v
+	}
+	mutedPayload["muted"] = true
+	mutedEnv := &ws.Envelope{
+		Type:    "message.push",
+		Tid:     ev.ClientMsgId,
+		Payload: mutedPayload,
+		Error:   nil,
+	}
+	envFor := func(muted bool) *ws.Envelope {
+		if muted {
+			return mutedEnv
 		}
-		if m.Status != "" && m.Status != "active" {
-			continue
+		return env
+	}
+	if err := c.pushToMembers(ctx, ev.ConversationId, envFor); err != nil {
+		_ = d.Nack(false, true)
+		return
+	}
+	// 频道订阅者不是成员，不逐条推送，由 runDigest 定期推送摘要
+	if c.isChannel(ctx, ev.ConversationId) {
+		c.digest.add(ev.ConversationId, map[string]interface{}{
+			"serverMsgId": ev.ServerMsgId,
+			"fromUserId":  ev.FromUserId,
+			"body":        ev.Body,
+			"serverTime":  serverTime,
+		})
+	}
+	_ = d.Ack(false)
+}
+
+// handleConversationEvent 处理按会话推送的事件：将 payload 原样推送给会话中在本实例上线的 active 成员。
+func (c *Consumer) handleConversationEvent(ctx context.Context, d *amqp.Delivery) {
+	var ev conversationEvent
+	if err := json.Unmarshal(d.Body, &ev); err != nil {
+		logx.Errorf("push consumer: unmarshal %s failed: %v", d.RoutingKey, err)
+		_ = d.Nack(false, false)
+		return
+	}
+	if ev.ConversationId == "" {
+		_ = d.Ack(false)
+		return
+	}
+	env := &ws.Envelope{
+		Type:    d.RoutingKey,
+		Payload: json.RawMessage(d.Body),
+		Error:   nil,
+	}
+	envFor := func(bool) *ws.Envelope { return env }
+	if err := c.pushToMembers(ctx, ev.ConversationId, envFor); err != nil {
+		_ = d.Nack(false, true)
+		return
+	}
+	if targetUserRouteKeys[d.RoutingKey] && ev.UserId != "" {
+		c.pushToUser(ctx, ev.UserId, env)
+	}
+	_ = d.Ack(false)
+}
+
+// pushToMembers 通过 StreamMemberIDs 分批读取会话 active 成员，向每人推送 envFor 为其生成的 Envelope（muted 表示该成员处于免打扰）；
+// 拉取成员失败时返回错误，由调用方决定是否重投。
+func (c *Consumer) pushToMembers(ctx context.Context, conversationID string, envFor func(muted bool) *ws.Envelope) error {
+	stream, err := c.conv.StreamMemberIDs(ctx, &conversationservice.StreamMemberIDsRequest{ConversationId: conversationID})
+	if err != nil {
+		logx.Errorf("push consumer: StreamMemberIDs failed conversationId=%s: %v", conversationID, err)
+		return err
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
 		}
-		sessResp, err := c.pres.GetOnlineSessions(ctx, &presenceservice.GetOnlineSessionsRequest{UserId: m.UserId})
 		if err != nil {
-			logx.Errorf("push consumer: GetOnlineSessions failed userId=%s: %v", m.UserId, err)
-			continue
+			logx.Errorf("push consumer: StreamMemberIDs recv failed conversationId=%s: %v", conversationID, err)
+			return err
 		}
-		for _, s := range sessResp.Sessions {
-			if s == nil || s.GatewayId != gatewayID {
-				continue
-			}
-			if _, ok := seenConn[s.ConnId]; ok {
-				continue
-			}
-			seenConn[s.ConnId] = struct{}{}
-			conn := c.hub.Get(s.ConnId)
-			if conn == nil {
+		muted := make(map[string]struct{}, len(chunk.MutedUserIds))
+		for _, id := range chunk.MutedUserIds {
+			muted[id] = struct{}{}
+		}
+		for _, id := range chunk.UserIds {
+			if id == "" {
 				continue
 			}
-			if err := conn.WriteJSON(&ws.Envelope{
-				Type:    "message.push",
-				Tid:     ev.ClientMsgId,
-				Payload: payload,
-				Error:   nil,
-			}); err != nil {
-				logx.Errorf("push consumer: WriteJSON failed connId=%s: %v", s.ConnId, err)
+			_, isMuted := muted[id]
+			c.pushToUser(ctx, id, envFor(isMuted))
+		}
+	}
+}
+
+func isConversationEvent(routeKey string) bool {
+	for _, k := range conversationEventRouteKeys {
+		if k == routeKey {
+			return true
+		}
+	}
+	return false
+}
+
+// handleUserEvent 处理按用户推送的事件：将 payload 原样推送给该用户在本实例上的所有连接。
+func (c *Consumer) handleUserEvent(ctx context.Context, d *amqp.Delivery) {
+	var ev userEvent
+	if err := json.Unmarshal(d.Body, &ev); err != nil {
+		logx.Errorf("push consumer: unmarshal %s failed: %v", d.RoutingKey, err)
+		_ = d.Nack(false, false)
+		return
+	}
+	if ev.UserId == "" {
+		_ = d.Ack(false)
+		return
+	}
+	c.pushToUser(ctx, ev.UserId, &ws.Envelope{
+		Type:    d.RoutingKey,
+		Payload: json.RawMessage(d.Body),
+		Error:   nil,
+	})
+	// 账号已清除：通知后断开该用户在本实例上的全部连接
+	if d.RoutingKey == "account.deleted" {
+		for _, conn := range c.hub.OnlineUsers()[ev.UserId] {
+			_ = conn.Close()
+		}
+	}
+	_ = d.Ack(false)
+}
+
+// handleUserUpdated 处理资料变更：通过 StreamProfileAudience 分批读取推送对象，写给其中在本实例上线的连接。
+// 推送对象可能很多，直接按本实例 Hub 的在线快照匹配，不逐人查询 Presence；本实例没有在线用户时不发起 RPC。
+func (c *Consumer) handleUserUpdated(ctx context.Context, d *amqp.Delivery) {
+	var ev userEvent
+	if err := json.Unmarshal(d.Body, &ev); err != nil {
+		logx.Errorf("push consumer: unmarshal %s failed: %v", d.RoutingKey, err)
+		_ = d.Nack(false, false)
+		return
+	}
+	if ev.UserId == "" {
+		_ = d.Ack(false)
+		return
+	}
+	env := &ws.Envelope{
+		Type:    d.RoutingKey,
+		Payload: json.RawMessage(d.Body),
+		Error:   nil,
+	}
+	if c.user == nil {
+		c.pushToUser(ctx, ev.UserId, env)
+		_ = d.Ack(false)
+		return
+	}
+	online := c.hub.OnlineUsers()
+	if len(online) == 0 {
+		_ = d.Ack(false)
+		return
+	}
+	stream, err := c.user.StreamProfileAudience(ctx, &userservice.StreamProfileAudienceRequest{UserId: ev.UserId})
+	if err != nil {
+		logx.Errorf("push consumer: StreamProfileAudience failed userId=%s: %v", ev.UserId, err)
+		_ = d.Nack(false, true)
+		return
+	}
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logx.Errorf("push consumer: StreamProfileAudience recv failed userId=%s: %v", ev.UserId, err)
+			_ = d.Nack(false, true)
+			return
+		}
+		for _, id := range chunk.UserIds {
+			for _, conn := range online[id] {
+				if err := conn.WriteJSON(env); err != nil {
+					logx.Errorf("push consumer: WriteJSON failed connId=%s: %v", conn.ConnID, err)
+				}
 			}
 		}
 	}
 	_ = d.Ack(false)
 }
 
+// pushToUser 查询用户在线会话，向其中属于本 Gateway 实例的连接写入 env。
+func (c *Consumer) pushToUser(ctx context.Context, userID string, env *ws.Envelope) {
+	gatewayID := c.cfg.GatewayID
+	if gatewayID == "" {
+		gatewayID = "gateway-1"
+	}
+	sessResp, err := c.pres.GetOnlineSessions(ctx, &presenceservice.GetOnlineSessionsRequest{UserId: userID})
+	if err != nil {
+		logx.Errorf("push consumer: GetOnlineSessions failed userId=%s: %v", userID, err)
+		return
+	}
+	for _, s := range sessResp.Sessions {
+		if s == nil || s.GatewayId != gatewayID {
+			continue
+		}
+		conn := c.hub.Get(s.ConnId)
+		if conn == nil {
+			continue
+		}
+		if err := conn.WriteJSON(env); err != nil {
+			logx.Errorf("push consumer: WriteJSON failed connId=%s: %v", s.ConnId, err)
+		}
+	}
+}
+
 // Close 关闭连接与 channel。
 func (c *Consumer) Close() error {
 	c.mu.Lock()
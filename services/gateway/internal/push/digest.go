@@ -0,0 +1,141 @@
+package push
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/conversationservice"
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/ws"
+)
+
+const (
+	// convTypeCacheTTL 会话类型缓存时长；会话类型创建后不会改变
+	convTypeCacheTTL = 10 * time.Minute
+	// defaultDigestInterval 频道摘要默认推送间隔
+	defaultDigestInterval = 5 * time.Second
+	// filterBatchSize 每次 FilterChannelSubscribers 携带的用户数
+	filterBatchSize = 1000
+)
+
+// digestEntry 一个推送窗口内某频道的新消息数与最后一条消息
+type digestEntry struct {
+	count       int
+	lastMessage map[string]interface{}
+}
+
+// channelDigest 汇总频道新消息。频道订阅者可能非常多，不逐条扇出：每个窗口每频道只推送一次
+// channel.digest，客户端收到后按需拉取历史消息。
+type channelDigest struct {
+	mu      sync.Mutex
+	pending map[string]*digestEntry
+}
+
+func newChannelDigest() *channelDigest {
+	return &channelDigest{pending: make(map[string]*digestEntry)}
+}
+
+func (d *channelDigest) add(conversationID string, lastMessage map[string]interface{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e := d.pending[conversationID]
+	if e == nil {
+		e = &digestEntry{}
+		d.pending[conversationID] = e
+	}
+	e.count++
+	e.lastMessage = lastMessage
+}
+
+// take 取出并清空当前窗口的汇总
+func (d *channelDigest) take() map[string]*digestEntry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := d.pending
+	d.pending = make(map[string]*digestEntry)
+	return out
+}
+
+// isChannel 判断会话是否为频道，结果缓存 convTypeCacheTTL；查询失败时按非频道处理
+func (c *Consumer) isChannel(ctx context.Context, conversationID string) bool {
+	v, err := c.convTypes.Take(conversationID, func() (any, error) {
+		resp, err := c.conv.GetConversation(ctx, &conversationservice.GetConversationRequest{Id: conversationID})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetConversation().GetType(), nil
+	})
+	if err != nil {
+		logx.Errorf("push consumer: GetConversation failed conversationId=%s: %v", conversationID, err)
+		return false
+	}
+	return v.(string) == "channel"
+}
+
+// runDigest 按 ChannelDigestIntervalSeconds 定期推送频道摘要，直到 ctx 结束
+func (c *Consumer) runDigest(ctx context.Context) {
+	interval := defaultDigestInterval
+	if c.cfg.ChannelDigestIntervalSeconds > 0 {
+		interval = time.Duration(c.cfg.ChannelDigestIntervalSeconds) * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.flushDigest(ctx)
+		}
+	}
+}
+
+// flushDigest 将本窗口的频道摘要推送给本实例在线的订阅者：用本实例在线用户分批筛选订阅关系，
+// 代价只与本实例连接数有关，与频道订阅总数无关。
+func (c *Consumer) flushDigest(ctx context.Context) {
+	pending := c.digest.take()
+	if len(pending) == 0 {
+		return
+	}
+	online := c.hub.OnlineUsers()
+	if len(online) == 0 {
+		return
+	}
+	userIDs := make([]string, 0, len(online))
+	for uid := range online {
+		userIDs = append(userIDs, uid)
+	}
+	for conversationID, e := range pending {
+		env := &ws.Envelope{
+			Type: "channel.digest",
+			Payload: map[string]interface{}{
+				"conversationId": conversationID,
+				"count":          e.count,
+				"lastMessage":    e.lastMessage,
+			},
+		}
+		for start := 0; start < len(userIDs); start += filterBatchSize {
+			end := start + filterBatchSize
+			if end > len(userIDs) {
+				end = len(userIDs)
+			}
+			resp, err := c.conv.FilterChannelSubscribers(ctx, &conversationservice.FilterChannelSubscribersRequest{
+				ConversationId: conversationID,
+				UserIds:        userIDs[start:end],
+			})
+			if err != nil {
+				logx.Errorf("push consumer: FilterChannelSubscribers failed conversationId=%s: %v", conversationID, err)
+				break
+			}
+			for _, uid := range resp.UserIds {
+				for _, conn := range online[uid] {
+					if err := conn.WriteJSON(env); err != nil {
+						logx.Errorf("push consumer: WriteJSON failed connId=%s: %v", conn.ConnID, err)
+					}
+				}
+			}
+		}
+	}
+}
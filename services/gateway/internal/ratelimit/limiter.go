@@ -8,24 +8,30 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-// MessageSendLimiter 按 userId 对 message.send 做固定窗口限流（每分钟 N 条）
-type MessageSendLimiter struct {
-	rdb   *redis.Client
-	limit int
+// Limiter 按 userId 对某类请求做固定窗口限流（每分钟 N 次），如 message.send、user.search
+type Limiter struct {
+	rdb    *redis.Client
+	action string
+	limit  int
 }
 
-// NewMessageSendLimiter 创建限流器，limit 为每用户每分钟允许的条数
-func NewMessageSendLimiter(rdb *redis.Client, limit int) *MessageSendLimiter {
-	return &MessageSendLimiter{rdb: rdb, limit: limit}
+// NewLimiter 创建限流器，action 为请求类型（作为 Redis key 的一部分），limit 为每用户每分钟允许的次数
+func NewLimiter(rdb *redis.Client, action string, limit int) *Limiter {
+	return &Limiter{rdb: rdb, action: action, limit: limit}
 }
 
-// Allow 检查是否允许该用户发送一条消息；若超限返回 false
-func (l *MessageSendLimiter) Allow(ctx context.Context, userID string) (bool, error) {
+// NewMessageSendLimiter 创建 message.send 限流器，limit 为每用户每分钟允许的条数
+func NewMessageSendLimiter(rdb *redis.Client, limit int) *Limiter {
+	return NewLimiter(rdb, "message.send", limit)
+}
+
+// Allow 检查是否允许该用户再发起一次请求；若超限返回 false
+func (l *Limiter) Allow(ctx context.Context, userID string) (bool, error) {
 	if l.rdb == nil || l.limit <= 0 {
 		return true, nil
 	}
 	window := time.Now().Unix() / 60
-	key := fmt.Sprintf("rate:message.send:%s:%d", userID, window)
+	key := fmt.Sprintf("rate:%s:%s:%d", l.action, userID, window)
 	pipe := l.rdb.Pipeline()
 	incr := pipe.Incr(ctx, key)
 	pipe.Expire(ctx, key, 90*time.Second) // 窗口 key 保留 90 秒
@@ -36,8 +42,8 @@ func (l *MessageSendLimiter) Allow(ctx context.Context, userID string) (bool, er
 	return incr.Val() <= int64(l.limit), nil
 }
 
-// Close 关闭限流器持有的 Redis 连接池，应在进程退出或不再使用限流时调用
-func (l *MessageSendLimiter) Close() error {
+// Close 关闭限流器持有的 Redis 连接池，应在进程退出或不再使用限流时调用；多个限流器共用连接池时只需关闭一次
+func (l *Limiter) Close() error {
 	if l.rdb == nil {
 		return nil
 	}
@@ -0,0 +1,201 @@
+// Package signal 通过 Redis Pub/Sub 在 Gateway 实例间转发瞬时会话信号（正在输入、正在录音等）。
+// 信号不落库、不进入 RabbitMQ，订阅时不在线的实例直接丢弃；客户端按 ttlSeconds 自行过期。
+package signal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/HappyLadySauce/Beehive/services/conversation/conversationservice"
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/ws"
+	"github.com/HappyLadySauce/Beehive/services/presence/presenceservice"
+)
+
+const (
+	channel = "im:signal"
+	// throttle 同一用户在同一会话发送同一状态信号的最小间隔
+	throttle = 3 * time.Second
+	// signalTTL 客户端在未收到新信号时自动清除状态的时长
+	signalTTL = 6 * time.Second
+	// membersCacheTTL 会话成员缓存时长，避免每次信号都查询 Conversation 服务
+	membersCacheTTL = 30 * time.Second
+)
+
+// 支持的信号状态；StateIdle 表示主动结束（停止输入/取消录音），不受节流限制
+const (
+	StateTyping    = "typing"
+	StateRecording = "recording"
+	StateIdle      = "idle"
+)
+
+var (
+	// ErrThrottled 信号在节流窗口内被丢弃
+	ErrThrottled = errors.New("signal throttled")
+	// ErrNotMember 发送者不是会话的 active 成员
+	ErrNotMember = errors.New("not a member of this conversation")
+)
+
+// Signal 在实例间传递的信号。
+type Signal struct {
+	ConversationId string `json:"conversationId"`
+	UserId         string `json:"userId"`
+	State          string `json:"state"`
+}
+
+// Bus 发布与订阅会话信号，并推送给本实例上在线的会话成员（不含发送者）。
+type Bus struct {
+	rdb       *redis.Client
+	hub       *ws.Hub
+	conv      conversationservice.ConversationService
+	pres      presenceservice.PresenceService
+	gatewayID string
+	members   *collection.Cache
+}
+
+// NewBus 创建信号总线；调用方需在后台运行 Run。
+func NewBus(rdb *redis.Client, hub *ws.Hub, gatewayID string, conv conversationservice.ConversationService, pres presenceservice.PresenceService) (*Bus, error) {
+	if gatewayID == "" {
+		gatewayID = "gateway-1"
+	}
+	members, err := collection.NewCache(membersCacheTTL, collection.WithName("signal-members"))
+	if err != nil {
+		return nil, err
+	}
+	return &Bus{rdb: rdb, hub: hub, conv: conv, pres: pres, gatewayID: gatewayID, members: members}, nil
+}
+
+// ValidState 判断是否为支持的信号状态。
+func ValidState(state string) bool {
+	return state == StateTyping || state == StateRecording || state == StateIdle
+}
+
+// Publish 校验发送者为会话成员并按用户+会话+状态节流后发布信号；节流时返回 ErrThrottled。
+func (b *Bus) Publish(ctx context.Context, sig *Signal) error {
+	members, err := b.activeMembers(ctx, sig.ConversationId)
+	if err != nil {
+		return err
+	}
+	if !contains(members, sig.UserId) {
+		return ErrNotMember
+	}
+	if sig.State != StateIdle {
+		key := fmt.Sprintf("signal:throttle:%s:%s:%s", sig.UserId, sig.ConversationId, sig.State)
+		ok, err := b.rdb.SetNX(ctx, key, 1, throttle).Result()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrThrottled
+		}
+	}
+	body, err := json.Marshal(sig)
+	if err != nil {
+		return err
+	}
+	return b.rdb.Publish(ctx, channel, body).Err()
+}
+
+// Run 订阅信号频道并推送，阻塞直到 ctx 取消。
+func (b *Bus) Run(ctx context.Context) {
+	sub := b.rdb.Subscribe(ctx, channel)
+	defer sub.Close()
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var sig Signal
+			if err := json.Unmarshal([]byte(msg.Payload), &sig); err != nil {
+				logx.Errorf("signal bus: unmarshal signal failed: %v", err)
+				continue
+			}
+			b.deliver(ctx, &sig)
+		}
+	}
+}
+
+// Close 关闭 Redis 连接。
+func (b *Bus) Close() error {
+	return b.rdb.Close()
+}
+
+func (b *Bus) deliver(ctx context.Context, sig *Signal) {
+	members, err := b.activeMembers(ctx, sig.ConversationId)
+	if err != nil {
+		logx.Errorf("signal bus: list members failed conversationId=%s: %v", sig.ConversationId, err)
+		return
+	}
+	env := &ws.Envelope{
+		Type: "conversation.typing",
+		Payload: map[string]any{
+			"conversationId": sig.ConversationId,
+			"userId":         sig.UserId,
+			"state":          sig.State,
+			"ttlSeconds":     int(signalTTL / time.Second),
+		},
+	}
+	for _, userID := range members {
+		if userID == sig.UserId {
+			continue
+		}
+		sessResp, err := b.pres.GetOnlineSessions(ctx, &presenceservice.GetOnlineSessionsRequest{UserId: userID})
+		if err != nil {
+			logx.Errorf("signal bus: GetOnlineSessions failed userId=%s: %v", userID, err)
+			continue
+		}
+		for _, s := range sessResp.Sessions {
+			if s == nil || s.GatewayId != b.gatewayID {
+				continue
+			}
+			if conn := b.hub.Get(s.ConnId); conn != nil {
+				_ = conn.WriteJSON(env)
+			}
+		}
+	}
+}
+
+// activeMembers 返回会话 active 成员 ID，结果缓存 membersCacheTTL。
+func (b *Bus) activeMembers(ctx context.Context, conversationID string) ([]string, error) {
+	v, err := b.members.Take(conversationID, func() (any, error) {
+		stream, err := b.conv.StreamMemberIDs(ctx, &conversationservice.StreamMemberIDsRequest{ConversationId: conversationID})
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for {
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				return ids, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, chunk.UserIds...)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]string), nil
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
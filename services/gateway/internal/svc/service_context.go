@@ -9,6 +9,7 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/gateway/internal/config"
 	"github.com/HappyLadySauce/Beehive/services/gateway/internal/push"
 	"github.com/HappyLadySauce/Beehive/services/gateway/internal/ratelimit"
+	"github.com/HappyLadySauce/Beehive/services/gateway/internal/signal"
 	"github.com/HappyLadySauce/Beehive/services/gateway/internal/ws"
 	"github.com/HappyLadySauce/Beehive/services/message/messageservice"
 	"github.com/HappyLadySauce/Beehive/services/presence/presenceservice"
@@ -27,7 +28,9 @@ type ServiceContext struct {
 	ConversationSvc  conversationservice.ConversationService  // 可选，未配置时为 nil
 	MessageSvc       messageservice.MessageService             // 可选，未配置时为 nil
 	PushConsumer     *push.Consumer                             // 可选，未配置 RabbitMQ 时为 nil
-	MessageSendLimit *ratelimit.MessageSendLimiter              // 可选，未配置 Redis/限流时为 nil
+	MessageSendLimit *ratelimit.Limiter                         // 可选，未配置 Redis/限流时为 nil
+	UserSearchLimit  *ratelimit.Limiter                         // 可选，未配置 Redis/限流时为 nil
+	SignalBus        *signal.Bus                                // 可选，未配置 Redis 时为 nil
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -60,19 +63,37 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		ctx.MessageSvc = messageservice.NewMessageService(msgCli)
 	}
 	if c.PushConsumerConfigured() && ctx.ConversationSvc != nil && ctx.PresenceSvc != nil {
-		consumer, err := push.NewConsumer(c, ctx.Hub, ctx.ConversationSvc, ctx.PresenceSvc)
+		consumer, err := push.NewConsumer(c, ctx.Hub, ctx.ConversationSvc, ctx.PresenceSvc, ctx.UserSvc)
 		if err != nil {
 			panic("push consumer: " + err.Error())
 		}
 		ctx.PushConsumer = consumer
 	}
-	if c.RateLimitConfigured() {
+	if c.SignalConfigured() && ctx.ConversationSvc != nil && ctx.PresenceSvc != nil {
 		rdb := redis.NewClient(&redis.Options{
 			Addr:     c.RedisAddr,
 			Password: c.RedisPassword,
 			DB:       c.RedisDB,
 		})
-		ctx.MessageSendLimit = ratelimit.NewMessageSendLimiter(rdb, c.RateLimitMessageSendPerMinute)
+		bus, err := signal.NewBus(rdb, ctx.Hub, c.GatewayID, ctx.ConversationSvc, ctx.PresenceSvc)
+		if err != nil {
+			panic("signal bus: " + err.Error())
+		}
+		ctx.SignalBus = bus
+	}
+	if c.RateLimitConfigured() || c.UserSearchRateLimitConfigured() {
+		// 各限流器共用一个 Redis 连接池
+		rdb := redis.NewClient(&redis.Options{
+			Addr:     c.RedisAddr,
+			Password: c.RedisPassword,
+			DB:       c.RedisDB,
+		})
+		if c.RateLimitConfigured() {
+			ctx.MessageSendLimit = ratelimit.NewMessageSendLimiter(rdb, c.RateLimitMessageSendPerMinute)
+		}
+		if c.UserSearchRateLimitConfigured() {
+			ctx.UserSearchLimit = ratelimit.NewLimiter(rdb, "user.search", c.RateLimitUserSearchPerMinute)
+		}
 	}
 	return ctx
 }
\ No newline at end of file
@@ -7,3 +7,7 @@ type HealthResp struct {
 	Code    int64  `json:"code"`
 	Message string `json:"message"`
 }
+
+type ExportDownloadReq struct {
+	Id string `path:"id"`
+}
@@ -64,6 +64,20 @@ func (h *Hub) Get(connID string) *Connection {
 	return h.conns[connID]
 }
 
+// OnlineUsers 返回本实例已登录连接按 UserID 分组的快照。
+func (h *Hub) OnlineUsers() map[string][]*Connection {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	out := make(map[string][]*Connection)
+	for _, c := range h.conns {
+		if c.UserID == "" {
+			continue
+		}
+		out[c.UserID] = append(out[c.UserID], c)
+	}
+	return out
+}
+
 // BindUser 在登录成功后绑定 UserID。
 func (c *Connection) BindUser(userID string) {
 	c.UserID = userID
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
 	"github.com/HappyLadySauce/Beehive/services/message/internal/config"
+	"github.com/HappyLadySauce/Beehive/services/message/internal/scheduler"
 	"github.com/HappyLadySauce/Beehive/services/message/internal/server"
 	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/message/pb"
@@ -25,6 +27,12 @@ func main() {
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
 
+	// 后台任务：释放到期的定时消息、清理过期的阅后即焚消息，随进程退出停止
+	schedCtx, cancelSched := context.WithCancel(context.Background())
+	defer cancelSched()
+	go scheduler.NewScheduler(ctx).Run(schedCtx)
+	go scheduler.NewReaper(ctx).Run(schedCtx)
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterMessageServiceServer(grpcServer, server.NewMessageServiceServer(ctx))
 
@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 被对方拉黑时的消息处理策略
+const (
+	blockedPolicyReject = "reject"
+	blockedPolicyDrop   = "drop"
+)
+
+// checkBlockPolicy 校验单聊双方的黑名单关系：发送者拉黑了对方返回 FailedPrecondition；
+// 被对方拉黑时按 BlockedMessagePolicy 处理，reject 返回 PermissionDenied，drop 返回 dropped=true 由调用方静默丢弃。
+// 非单聊、未配置 UserService 或调用失败时放行，黑名单不可用不应阻断消息发送。
+func checkBlockPolicy(ctx context.Context, svcCtx *svc.ServiceContext, p *model.SpeakPolicy, fromUserID string) (bool, error) {
+	if svcCtx.UserSvc == nil || p.Type != "single" || p.PeerID == "" {
+		return false, nil
+	}
+	resp, err := svcCtx.UserSvc.CheckBlock(ctx, &userservice.CheckBlockRequest{UserId: fromUserID, PeerId: p.PeerID})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("check block failed: %v", err)
+		return false, nil
+	}
+	if resp.GetBlockedByUser() {
+		return false, status.Error(codes.FailedPrecondition, "you have blocked this user")
+	}
+	if resp.GetBlockedByPeer() {
+		if svcCtx.Config.BlockedMessagePolicy == blockedPolicyDrop {
+			return true, nil
+		}
+		return false, status.Error(codes.PermissionDenied, "blocked by recipient")
+	}
+	return false, nil
+}
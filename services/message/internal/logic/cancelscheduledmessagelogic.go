@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/message/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type CancelScheduledMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelScheduledMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelScheduledMessageLogic {
+	return &CancelScheduledMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CancelScheduledMessage 取消当前用户的一条待发定时消息；已发出或已取消时返回 NotFound。
+func (l *CancelScheduledMessageLogic) CancelScheduledMessage(in *pb.CancelScheduledMessageRequest) (*pb.CancelScheduledMessageResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if in.GetServerMsgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "server_msg_id is required")
+	}
+	if err := l.svcCtx.Scheduled.Cancel(in.GetUserId(), in.GetServerMsgId()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "scheduled message not found")
+		}
+		l.Errorf("cancel scheduled message failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "cancel scheduled message failed: %v", err)
+	}
+	return &pb.CancelScheduledMessageResponse{}, nil
+}
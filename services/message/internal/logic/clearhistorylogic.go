@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/message/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ClearHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewClearHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClearHistoryLogic {
+	return &ClearHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ClearHistory 为 active 成员清空会话历史：以当前最后一条消息为水位，该消息及之前的消息对该用户不再可见；发布 conversation.historyCleared 供多端同步。
+func (l *ClearHistoryLogic) ClearHistory(in *pb.ClearHistoryRequest) (*pb.ClearHistoryResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if in.GetConversationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	if err := requireMember(l.svcCtx, in.GetConversationId(), in.GetUserId()); err != nil {
+		return nil, err
+	}
+	last, err := l.svcCtx.Visibility.ClearConversation(in.GetUserId(), in.GetConversationId(), time.Now())
+	if err != nil {
+		l.Errorf("clear conversation failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "clear history failed: %v", err)
+	}
+	resp := &pb.ClearHistoryResponse{}
+	if last == nil {
+		// 会话没有消息，无需清空
+		return resp, nil
+	}
+	resp.ClearedAt, resp.ClearedServerMsgId = last.ServerTime, last.ServerMsgID
+	event := map[string]interface{}{
+		"userId":             in.GetUserId(),
+		"conversationId":     in.GetConversationId(),
+		"clearedAt":          resp.ClearedAt,
+		"clearedServerMsgId": resp.ClearedServerMsgId,
+	}
+	if err := l.svcCtx.MQ.PublishJSONWithKey("conversation.historyCleared", event); err != nil {
+		l.Errorf("publish conversation.historyCleared failed: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/message/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxDeleteForMeBatch 单次「仅对自己删除」允许的最大消息数
+const maxDeleteForMeBatch = 100
+
+type DeleteForMeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteForMeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteForMeLogic {
+	return &DeleteForMeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeleteForMe 仅对当前用户隐藏指定消息，要求其为会话 active 成员，其他成员不受影响；成功后发布 message.deletedForMe 供该用户的其他设备同步。
+func (l *DeleteForMeLogic) DeleteForMe(in *pb.DeleteForMeRequest) (*pb.DeleteForMeResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if in.GetConversationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "conversation_id is required")
+	}
+	ids := make([]string, 0, len(in.GetServerMsgIds()))
+	for _, id := range in.GetServerMsgIds() {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "server_msg_ids is required")
+	}
+	if len(ids) > maxDeleteForMeBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d server_msg_ids per request", maxDeleteForMeBatch)
+	}
+	if err := requireMember(l.svcCtx, in.GetConversationId(), in.GetUserId()); err != nil {
+		return nil, err
+	}
+	n, err := l.svcCtx.Visibility.HideMessages(in.GetUserId(), in.GetConversationId(), ids)
+	if err != nil {
+		l.Errorf("hide messages failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "delete for me failed: %v", err)
+	}
+	event := map[string]interface{}{
+		"userId":         in.GetUserId(),
+		"conversationId": in.GetConversationId(),
+		"serverMsgIds":   ids,
+	}
+	if err := l.svcCtx.MQ.PublishJSONWithKey("message.deletedForMe", event); err != nil {
+		l.Errorf("publish message.deletedForMe failed: %v", err)
+	}
+	return &pb.DeleteForMeResponse{Deleted: int32(n)}, nil
+}
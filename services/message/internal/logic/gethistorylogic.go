@@ -35,7 +35,7 @@ func (l *GetHistoryLogic) GetHistory(in *pb.GetHistoryRequest) (*pb.GetHistoryRe
 	if limit > 100 {
 		limit = 100
 	}
-	list, err := l.svcCtx.Msg.GetHistory(in.GetConversationId(), in.GetBeforeTime(), limit+1)
+	list, err := l.svcCtx.Msg.GetHistory(in.GetConversationId(), in.GetUserId(), in.GetBeforeTime(), limit+1)
 	if err != nil {
 		l.Errorf("get history failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "get history failed: %v", err)
@@ -55,8 +55,9 @@ func (l *GetHistoryLogic) GetHistory(in *pb.GetHistoryRequest) (*pb.GetHistoryRe
 			ConversationId: m.ConversationID,
 			FromUserId:     m.FromUserID,
 			ToUserId:       toUserID,
-			Body:           &pb.MessageBody{Type: m.BodyType, Text: m.BodyText},
+			Body:           toMessageBody(m.BodyType, m.BodyText),
 			ServerTime:     m.ServerTime,
+			ExpiresAt:      expiresAtUnix(m.ExpiresAt),
 		})
 	}
 	return &pb.GetHistoryResponse{Items: items, HasMore: hasMore}, nil
@@ -29,7 +29,7 @@ func (l *GetLastMessagesLogic) GetLastMessages(in *pb.GetLastMessagesRequest) (*
 	if len(ids) == 0 {
 		return &pb.GetLastMessagesResponse{LastMessages: nil}, nil
 	}
-	m, err := l.svcCtx.Msg.GetLastByConversations(ids)
+	m, err := l.svcCtx.Msg.GetLastByConversations(ids, in.GetUserId())
 	if err != nil {
 		l.Errorf("get last messages failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "get last messages failed: %v", err)
@@ -45,8 +45,9 @@ func (l *GetLastMessagesLogic) GetLastMessages(in *pb.GetLastMessagesRequest) (*
 			ConversationId: msg.ConversationID,
 			FromUserId:     msg.FromUserID,
 			ToUserId:       toUserID,
-			Body:           &pb.MessageBody{Type: msg.BodyType, Text: msg.BodyText},
+			Body:           toMessageBody(msg.BodyType, msg.BodyText),
 			ServerTime:     msg.ServerTime,
+			ExpiresAt:      expiresAtUnix(msg.ExpiresAt),
 		}
 	}
 	return &pb.GetLastMessagesResponse{LastMessages: lastMessages}, nil
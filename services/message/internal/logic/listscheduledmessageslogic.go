@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/message/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListScheduledMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListScheduledMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListScheduledMessagesLogic {
+	return &ListScheduledMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListScheduledMessagesLogic) ListScheduledMessages(in *pb.ListScheduledMessagesRequest) (*pb.ListScheduledMessagesResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	list, err := l.svcCtx.Scheduled.ListPending(in.GetUserId(), in.GetConversationId())
+	if err != nil {
+		l.Errorf("list scheduled messages failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list scheduled messages failed: %v", err)
+	}
+	items := make([]*pb.ScheduledMessage, 0, len(list))
+	for _, s := range list {
+		toUserID := ""
+		if s.ToUserID != nil {
+			toUserID = *s.ToUserID
+		}
+		items = append(items, &pb.ScheduledMessage{
+			ServerMsgId:    s.ServerMsgID,
+			ClientMsgId:    s.ClientMsgID,
+			ConversationId: s.ConversationID,
+			FromUserId:     s.FromUserID,
+			ToUserId:       toUserID,
+			Body:           &pb.MessageBody{Type: s.BodyType, Text: s.BodyText},
+			SendAt:         s.SendAt,
+			CreatedAt:      s.CreatedAt.Unix(),
+		})
+	}
+	return &pb.ListScheduledMessagesResponse{Items: items}, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"encoding/json"
+
+	"github.com/HappyLadySauce/Beehive/services/message/pb"
+)
+
+// bodyTypeSystem 系统消息类型，仅允许服务端（如 Conversation 服务）写入
+const bodyTypeSystem = "system"
+
+// systemPayload 系统消息在 body_text 中的 JSON 存储格式；字段名与推送给客户端的 body.system 一致
+type systemPayload struct {
+	Event        string   `json:"event"`
+	OperatorId   string   `json:"operatorId"`
+	UserIds      []string `json:"userIds"`
+	Role         string   `json:"role"`
+	Name         string   `json:"name"`
+	Announcement string   `json:"announcement"`
+}
+
+// encodeSystemPayload 将 pb.SystemPayload 编码为 body_text 存储的 JSON
+func encodeSystemPayload(p *pb.SystemPayload) (string, error) {
+	b, err := json.Marshal(&systemPayload{
+		Event:        p.GetEvent(),
+		OperatorId:   p.GetOperatorId(),
+		UserIds:      p.GetUserIds(),
+		Role:         p.GetRole(),
+		Name:         p.GetName(),
+		Announcement: p.GetAnnouncement(),
+	})
+	return string(b), err
+}
+
+// toMessageBody 由存储的 body_type / body_text 还原 pb.MessageBody；系统消息解析为 System，Text 为空
+func toMessageBody(bodyType, bodyText string) *pb.MessageBody {
+	if bodyType != bodyTypeSystem {
+		return &pb.MessageBody{Type: bodyType, Text: bodyText}
+	}
+	var p systemPayload
+	if err := json.Unmarshal([]byte(bodyText), &p); err != nil {
+		return &pb.MessageBody{Type: bodyType}
+	}
+	return &pb.MessageBody{
+		Type: bodyType,
+		System: &pb.SystemPayload{
+			Event:        p.Event,
+			OperatorId:   p.OperatorId,
+			UserIds:      p.UserIds,
+			Role:         p.Role,
+			Name:         p.Name,
+			Announcement: p.Announcement,
+		},
+	}
+}
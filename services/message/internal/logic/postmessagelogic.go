@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/HappyLadySauce/Beehive/services/message/internal/model"
@@ -13,6 +14,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// maxScheduleAhead 定时消息 send_at 距当前时间的最大跨度
+const maxScheduleAhead = 365 * 24 * time.Hour
+
 type PostMessageLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -42,14 +46,81 @@ func (l *PostMessageLogic) PostMessage(in *pb.PostMessageRequest) (*pb.PostMessa
 	if bodyType == "" {
 		bodyType = "text"
 	}
+	bodyText := body.GetText()
+	if bodyType == bodyTypeSystem {
+		// 系统消息由服务端生成，正文为结构化 payload，不支持定时发送
+		if body.GetSystem().GetEvent() == "" {
+			return nil, status.Error(codes.InvalidArgument, "system payload is required")
+		}
+		if in.GetSendAt() > 0 {
+			return nil, status.Error(codes.InvalidArgument, "system messages cannot be scheduled")
+		}
+		var err error
+		if bodyText, err = encodeSystemPayload(body.GetSystem()); err != nil {
+			return nil, status.Errorf(codes.Internal, "encode system payload failed: %v", err)
+		}
+	}
+	now := time.Now()
+	if bodyType != bodyTypeSystem {
+		// 非成员不能发言、已解散的群只读、禁言中不能发言；系统消息由服务端写入，不受限制
+		policy, err := checkSpeakPolicy(l.svcCtx, in.GetConversationId(), in.GetFromUserId(), now)
+		if err != nil {
+			return nil, err
+		}
+		// 单聊校验黑名单；drop 策略下向发送者返回成功但不落库、不推送
+		dropped, err := checkBlockPolicy(l.ctx, l.svcCtx, policy, in.GetFromUserId())
+		if err != nil {
+			return nil, err
+		}
+		if dropped {
+			return &pb.PostMessageResponse{
+				ServerMsgId:    uuid.Must(uuid.NewUUID()).String(),
+				ConversationId: in.GetConversationId(),
+				ServerTime:     now.Unix(),
+			}, nil
+		}
+	}
 	serverMsgID := uuid.Must(uuid.NewUUID()).String()
-	serverTime := time.Now().Unix()
 	// toUserId 仅在点对点消息时使用；群聊/广播时应为 NULL，而不是空串，避免 uuid 列解析错误
 	var toUserIDPtr *string
 	if in.GetToUserId() != "" {
 		v := in.GetToUserId()
 		toUserIDPtr = &v
 	}
+	if sendAt := in.GetSendAt(); sendAt > now.Unix() {
+		if sendAt > now.Add(maxScheduleAhead).Unix() {
+			return nil, status.Error(codes.InvalidArgument, "send_at is too far in the future")
+		}
+		// 定时消息：先落 scheduled_messages，到期由 scheduler 写入 messages 并发布 message.created
+		scheduled := &model.ScheduledMessage{
+			ID:             uuid.Must(uuid.NewUUID()).String(),
+			ServerMsgID:    serverMsgID,
+			ClientMsgID:    in.GetClientMsgId(),
+			ConversationID: in.GetConversationId(),
+			FromUserID:     in.GetFromUserId(),
+			ToUserID:       toUserIDPtr,
+			BodyType:       bodyType,
+			BodyText:       bodyText,
+			SendAt:         sendAt,
+			Status:         model.ScheduledStatusPending,
+		}
+		if err := l.svcCtx.Scheduled.Create(scheduled); err != nil {
+			l.Errorf("create scheduled message failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "create scheduled message failed: %v", err)
+		}
+		return &pb.PostMessageResponse{
+			ServerMsgId:    serverMsgID,
+			ConversationId: in.GetConversationId(),
+			ServerTime:     sendAt,
+			Scheduled:      true,
+		}, nil
+	}
+	serverTime := now.Unix()
+	expiresAt, err := l.svcCtx.Msg.ExpiresAtFor(in.GetConversationId(), now)
+	if err != nil {
+		l.Errorf("get conversation message ttl failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "get message ttl failed: %v", err)
+	}
 	msg := &model.Message{
 		ID:             uuid.Must(uuid.NewUUID()).String(),
 		ServerMsgID:    serverMsgID,
@@ -58,23 +129,15 @@ func (l *PostMessageLogic) PostMessage(in *pb.PostMessageRequest) (*pb.PostMessa
 		FromUserID:     in.GetFromUserId(),
 		ToUserID:       toUserIDPtr,
 		BodyType:       bodyType,
-		BodyText:       body.GetText(),
+		BodyText:       bodyText,
 		ServerTime:     serverTime,
+		ExpiresAt:      expiresAt,
 	}
 	if err := l.svcCtx.Msg.Create(msg); err != nil {
 		l.Errorf("create message failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "create message failed: %v", err)
 	}
-	event := map[string]interface{}{
-		"serverMsgId":    serverMsgID,
-		"clientMsgId":   in.GetClientMsgId(),
-		"conversationId": in.GetConversationId(),
-		"fromUserId":    in.GetFromUserId(),
-		"toUserId":      in.GetToUserId(),
-		"body":           map[string]string{"type": bodyType, "text": body.GetText()},
-		"serverTime":     serverTime,
-	}
-	if err := l.svcCtx.MQ.PublishJSON(event); err != nil {
+	if err := l.svcCtx.MQ.PublishJSON(messageCreatedEvent(msg)); err != nil {
 		l.Errorf("publish message.created failed: %v", err)
 	}
 	return &pb.PostMessageResponse{
@@ -82,4 +145,37 @@ func (l *PostMessageLogic) PostMessage(in *pb.PostMessageRequest) (*pb.PostMessa
 		ConversationId: in.GetConversationId(),
 		ServerTime:     serverTime,
 	}, nil
-}
\ No newline at end of file
+}
+
+// messageCreatedEvent 构造 message.created 事件，字段与 Gateway push.Consumer 解析的结构一致。
+// 即时消息与到期释放的定时消息共用该结构。
+func messageCreatedEvent(msg *model.Message) map[string]interface{} {
+	toUserID := ""
+	if msg.ToUserID != nil {
+		toUserID = *msg.ToUserID
+	}
+	event := map[string]interface{}{
+		"serverMsgId":    msg.ServerMsgID,
+		"clientMsgId":    msg.ClientMsgID,
+		"conversationId": msg.ConversationID,
+		"fromUserId":     msg.FromUserID,
+		"toUserId":       toUserID,
+		"body":           map[string]interface{}{"type": msg.BodyType, "text": msg.BodyText},
+		"serverTime":     msg.ServerTime,
+	}
+	if msg.BodyType == bodyTypeSystem {
+		event["body"] = map[string]interface{}{"type": msg.BodyType, "text": "", "system": json.RawMessage(msg.BodyText)}
+	}
+	if msg.ExpiresAt != nil {
+		event["expiresAt"] = msg.ExpiresAt.Unix()
+	}
+	return event
+}
+
+// expiresAtUnix 将可空的过期时间转为 Unix 秒，未设置时为 0
+func expiresAtUnix(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
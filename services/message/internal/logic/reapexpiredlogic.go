@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReapExpiredLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReapExpiredLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReapExpiredLogic {
+	return &ReapExpiredLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReapExpired 删除一批已过期的阅后即焚消息，并按会话发布 message.expired 通知客户端移除。返回本批删除条数。
+func (l *ReapExpiredLogic) ReapExpired(limit int) (int, error) {
+	list, err := l.svcCtx.Msg.DeleteExpired(limit)
+	if err != nil {
+		return 0, err
+	}
+	byConv := make(map[string][]string)
+	for _, m := range list {
+		byConv[m.ConversationID] = append(byConv[m.ConversationID], m.ServerMsgID)
+	}
+	for convID, ids := range byConv {
+		event := map[string]interface{}{
+			"conversationId": convID,
+			"serverMsgIds":   ids,
+		}
+		if err := l.svcCtx.MQ.PublishJSONWithKey("message.expired", event); err != nil {
+			l.Errorf("publish message.expired failed conversationId=%s: %v", convID, err)
+		}
+	}
+	return len(list), nil
+}
@@ -0,0 +1,155 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// scheduledLeaseSeconds 领取后的租约时长，期间其他副本不会重复领取；超时未处理完时自动重新领取
+	scheduledLeaseSeconds = 60
+	// scheduledMaxAttempts 临时错误的最大领取次数，用尽后标记为 failed 并通知发送者
+	scheduledMaxAttempts = 8
+	// 临时错误的重试间隔从 scheduledRetryBase 起每次翻倍，最长 scheduledRetryMax
+	scheduledRetryBase = 30 * time.Second
+	scheduledRetryMax  = 30 * time.Minute
+)
+
+type ReleaseScheduledLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReleaseScheduledLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseScheduledLogic {
+	return &ReleaseScheduledLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReleaseDue 领取一批到期的定时消息并逐条发送，返回本批领取条数。
+// 领取在短事务内完成，发言条件与黑名单的校验（含 UserService 调用）在事务外进行，不会长时间持有行锁。
+func (l *ReleaseScheduledLogic) ReleaseDue(limit int) (int, error) {
+	list, err := l.svcCtx.Scheduled.ClaimDue(time.Now().Unix(), scheduledLeaseSeconds, limit)
+	if err != nil {
+		return 0, err
+	}
+	for _, s := range list {
+		if l.ctx.Err() != nil {
+			// 未处理的消息租约到期后重新领取
+			break
+		}
+		l.release(s)
+	}
+	return len(list), nil
+}
+
+// release 按与 PostMessage 相同的规则重新校验后发送一条定时消息：
+// 发送者已不是成员、被禁言、群已解散或存在拉黑关系时标记为 failed 并通知发送者（drop 策略下静默取消）；
+// 数据库、RPC 等临时错误保持 pending 并退避重试，重试次数用尽后标记为 failed。
+func (l *ReleaseScheduledLogic) release(s *model.ScheduledMessage) {
+	now := time.Now()
+	policy, err := checkSpeakPolicy(l.svcCtx, s.ConversationID, s.FromUserID, now)
+	if err == nil {
+		var dropped bool
+		dropped, err = checkBlockPolicy(l.ctx, l.svcCtx, policy, s.FromUserID)
+		if err == nil && dropped {
+			if _, err := l.svcCtx.Scheduled.Finish(s.ID, model.ScheduledStatusCanceled, "blocked by recipient"); err != nil {
+				l.Errorf("cancel scheduled message failed serverMsgId=%s: %v", s.ServerMsgID, err)
+			}
+			return
+		}
+	}
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st.Code() != codes.Internal {
+			l.fail(s, st.Message())
+			return
+		}
+		l.retry(s, err)
+		return
+	}
+
+	var msg *model.Message
+	err = l.svcCtx.Scheduled.MarkSent(s.ID, func(tx *gorm.DB) error {
+		msgModel := model.NewMessageModel(tx)
+		expiresAt, err := msgModel.ExpiresAtFor(s.ConversationID, now)
+		if err != nil {
+			return err
+		}
+		msg = &model.Message{
+			ID:             uuid.Must(uuid.NewUUID()).String(),
+			ServerMsgID:    s.ServerMsgID,
+			ClientMsgID:    s.ClientMsgID,
+			ConversationID: s.ConversationID,
+			FromUserID:     s.FromUserID,
+			ToUserID:       s.ToUserID,
+			BodyType:       s.BodyType,
+			BodyText:       s.BodyText,
+			ServerTime:     now.Unix(),
+			ExpiresAt:      expiresAt,
+		}
+		return msgModel.Create(msg)
+	})
+	if err == gorm.ErrRecordNotFound {
+		// 领取后已被用户取消
+		return
+	}
+	if err != nil {
+		l.retry(s, err)
+		return
+	}
+	if err := l.svcCtx.MQ.PublishJSON(messageCreatedEvent(msg)); err != nil {
+		l.Errorf("publish message.created failed serverMsgId=%s: %v", msg.ServerMsgID, err)
+	}
+}
+
+// retry 临时错误：按已领取次数退避后重试，次数用尽时标记为 failed
+func (l *ReleaseScheduledLogic) retry(s *model.ScheduledMessage, cause error) {
+	if s.Attempts >= scheduledMaxAttempts {
+		l.Errorf("release scheduled message failed, giving up serverMsgId=%s attempts=%d: %v", s.ServerMsgID, s.Attempts, cause)
+		l.fail(s, "internal error")
+		return
+	}
+	delay := scheduledRetryMax
+	if shift := s.Attempts - 1; shift >= 0 && shift < 16 && scheduledRetryBase<<shift < scheduledRetryMax {
+		delay = scheduledRetryBase << shift
+	}
+	l.Errorf("release scheduled message failed, retry in %s serverMsgId=%s attempts=%d: %v", delay, s.ServerMsgID, s.Attempts, cause)
+	if err := l.svcCtx.Scheduled.Retry(s.ID, time.Now().Add(delay).Unix()); err != nil {
+		// 租约到期后仍会重新领取
+		l.Errorf("reschedule scheduled message failed serverMsgId=%s: %v", s.ServerMsgID, err)
+	}
+}
+
+// fail 标记为 failed 并通过 message.scheduledFailed 通知发送者的所有在线设备
+func (l *ReleaseScheduledLogic) fail(s *model.ScheduledMessage, reason string) {
+	ok, err := l.svcCtx.Scheduled.Finish(s.ID, model.ScheduledStatusFailed, reason)
+	if err != nil {
+		l.Errorf("mark scheduled message failed serverMsgId=%s: %v", s.ServerMsgID, err)
+		return
+	}
+	if !ok {
+		return
+	}
+	l.Infof("scheduled message failed serverMsgId=%s: %s", s.ServerMsgID, reason)
+	if err := l.svcCtx.MQ.PublishJSONWithKey("message.scheduledFailed", map[string]interface{}{
+		"userId":         s.FromUserID,
+		"conversationId": s.ConversationID,
+		"serverMsgId":    s.ServerMsgID,
+		"clientMsgId":    s.ClientMsgID,
+		"sendAt":         s.SendAt,
+		"reason":         reason,
+	}); err != nil {
+		l.Errorf("publish message.scheduledFailed failed: %v", err)
+	}
+}
@@ -19,6 +19,8 @@ type Message struct {
 	BodyText   string    `gorm:"column:body_text;type:text;not null"`
 	ServerTime int64     `gorm:"column:server_time;not null"`
 	CreatedAt  time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	// ExpiresAt 阅后即焚消息的过期时间，写入时按会话 message_ttl_seconds 计算；为空表示不过期
+	ExpiresAt *time.Time `gorm:"column:expires_at;type:timestamptz"`
 }
 
 func (Message) TableName() string {
@@ -33,8 +35,95 @@ func NewMessageModel(db *gorm.DB) *MessageModel {
 	return &MessageModel{db: db}
 }
 
+// Create 写入消息并在同一事务内刷新会话的 last_active_at，会话列表据此按最近活跃排序
 func (m *MessageModel) Create(msg *Message) error {
-	return m.db.Create(msg).Error
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(msg).Error; err != nil {
+			return err
+		}
+		return tx.Exec("UPDATE conversations SET last_active_at = GREATEST(last_active_at, NOW()) WHERE id = ?", msg.ConversationID).Error
+	})
+}
+
+// ExpiresAtFor 按会话的 message_ttl_seconds 计算从 from 开始的过期时间；会话未开启自动销毁时返回 nil
+func (m *MessageModel) ExpiresAtFor(conversationID string, from time.Time) (*time.Time, error) {
+	var ttl []int64
+	err := m.db.Table("conversations").Where("id = ?", conversationID).Pluck("message_ttl_seconds", &ttl).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(ttl) == 0 || ttl[0] <= 0 {
+		return nil, nil
+	}
+	t := from.Add(time.Duration(ttl[0]) * time.Second)
+	return &t, nil
+}
+
+// SpeakPolicy 发送者在会话中的发言限制，由 conversations 与 conversation_members 读出。
+// Role 为空表示发送者不是 active 成员；SpeakMutedUntil 为 nil 表示未被禁言；PeerID 仅在单聊且发送者为 active 成员时有效，为另一名成员。
+type SpeakPolicy struct {
+	Type            string     `gorm:"column:type"`
+	Status          string     `gorm:"column:status"`
+	MuteAll         bool       `gorm:"column:mute_all"`
+	Role            string     `gorm:"column:role"`
+	SpeakMutedUntil *time.Time `gorm:"column:speak_muted_until"`
+	PeerID          string     `gorm:"column:peer_id"`
+}
+
+// Dissolved 会话是否已解散
+func (p *SpeakPolicy) Dissolved() bool {
+	return p.Status == "dissolved"
+}
+
+// SpeakPolicyFor 查询 userID 在会话中的发言限制；会话不存在时返回零值
+func (m *MessageModel) SpeakPolicyFor(conversationID, userID string) (*SpeakPolicy, error) {
+	var p SpeakPolicy
+	err := m.db.Raw(`
+		SELECT c.type, c.status, c.mute_all, COALESCE(cm.role, '') AS role, cm.speak_muted_until,
+			COALESCE((
+				SELECT pm.user_id FROM conversation_members pm
+				WHERE c.type = 'single' AND cm.id IS NOT NULL AND pm.conversation_id = c.id AND pm.user_id <> ?
+				LIMIT 1
+			), '') AS peer_id
+		FROM conversations c
+		LEFT JOIN conversation_members cm
+			ON cm.conversation_id = c.id AND cm.user_id = ? AND cm.status = 'active'
+		WHERE c.id = ?`, userID, userID, conversationID).Scan(&p).Error
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// IsActiveMember 判断用户是否为会话的 active 成员
+func (m *MessageModel) IsActiveMember(conversationID, userID string) (bool, error) {
+	var n int64
+	err := m.db.Table("conversation_members").
+		Where("conversation_id = ? AND user_id = ? AND status = 'active'", conversationID, userID).
+		Count(&n).Error
+	return n > 0, err
+}
+
+// DeleteExpired 删除至多 limit 条已过期的消息并返回被删除的记录；SKIP LOCKED 保证多副本并发时不重复删除
+func (m *MessageModel) DeleteExpired(limit int) ([]*Message, error) {
+	var list []*Message
+	err := m.db.Raw(`
+		DELETE FROM messages
+		WHERE id IN (
+			SELECT id FROM messages
+			WHERE expires_at IS NOT NULL AND expires_at <= NOW()
+			ORDER BY expires_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, server_msg_id, client_msg_id, conversation_id, from_user_id, to_user_id, body_type, body_text, server_time, created_at, expires_at
+	`, limit).Scan(&list).Error
+	return list, err
+}
+
+// notExpired 为 messages 查询追加「未过期」条件，保证清理任务执行前过期消息也不会返回
+func notExpired(q *gorm.DB) *gorm.DB {
+	return q.Where("(messages.expires_at IS NULL OR messages.expires_at > NOW())")
 }
 
 // GetByServerMsgID 根据会话与 server_msg_id 查一条消息（用于已读回执解析 server_time）
@@ -47,38 +136,42 @@ func (m *MessageModel) GetByServerMsgID(conversationID, serverMsgID string) (*Me
 	return &msg, nil
 }
 
-// CountUnread 统计某会话中对某用户未读的消息数：server_time > lastReadTime 且 (to_user_id = userID 或 (to_user_id 为空且 from_user_id != userID))
+// CountUnread 统计某会话中对某用户未读的消息数：server_time > lastReadTime 且 (to_user_id = userID 或 (to_user_id 为空且 from_user_id != userID))，
+// 已被该用户删除或清空的消息不计入
 func (m *MessageModel) CountUnread(conversationID, userID string, lastReadTime int64) (int64, error) {
 	var n int64
-	err := m.db.Model(&Message{}).Where(
+	q := m.db.Model(&Message{}).Where(
 		"conversation_id = ? AND server_time > ? AND (to_user_id = ? OR (to_user_id IS NULL AND from_user_id != ?))",
 		conversationID, lastReadTime, userID, userID,
-	).Count(&n).Error
+	)
+	err := visibleTo(notExpired(q), userID).Count(&n).Error
 	return n, err
 }
 
-func (m *MessageModel) GetHistory(conversationID string, beforeTime int64, limit int) ([]*Message, error) {
+// GetHistory 按 server_time 倒序取会话消息；userID 非空时按该用户的可见性过滤
+func (m *MessageModel) GetHistory(conversationID, userID string, beforeTime int64, limit int) ([]*Message, error) {
 	q := m.db.Where("conversation_id = ?", conversationID).Order("server_time DESC")
 	if beforeTime > 0 {
 		q = q.Where("server_time < ?", beforeTime)
 	}
+	q = visibleTo(notExpired(q), userID)
 	var list []*Message
 	err := q.Limit(limit).Find(&list).Error
 	return list, err
 }
 
-func (m *MessageModel) GetLastByConversations(conversationIDs []string) (map[string]*Message, error) {
+// GetLastByConversations 取每个会话最后一条消息；userID 非空时取该用户可见的最后一条
+func (m *MessageModel) GetLastByConversations(conversationIDs []string, userID string) (map[string]*Message, error) {
 	if len(conversationIDs) == 0 {
 		return nil, nil
 	}
 	// 每个会话取 server_time 最大的一条：用 DISTINCT ON (conversation_id) 或子查询
 	var list []*Message
-	err := m.db.Raw(`
-		SELECT DISTINCT ON (conversation_id) id, server_msg_id, client_msg_id, conversation_id, from_user_id, to_user_id, body_type, body_text, server_time, created_at
-		FROM messages
-		WHERE conversation_id IN ?
-		ORDER BY conversation_id, server_time DESC
-	`, conversationIDs).Scan(&list).Error
+	q := m.db.Model(&Message{}).
+		Select("DISTINCT ON (conversation_id) id, server_msg_id, client_msg_id, conversation_id, from_user_id, to_user_id, body_type, body_text, server_time, created_at, expires_at").
+		Where("conversation_id IN ?", conversationIDs).
+		Order("conversation_id, server_time DESC")
+	err := visibleTo(notExpired(q), userID).Scan(&list).Error
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 定时消息状态
+const (
+	ScheduledStatusPending  = "pending"
+	ScheduledStatusSent     = "sent"
+	ScheduledStatusCanceled = "canceled"
+	ScheduledStatusFailed   = "failed"
+)
+
+// ScheduledMessage 定时消息，表名 scheduled_messages。到期后由调度器写入 messages 并沿用 server_msg_id。
+// Attempts 为已领取次数；RetryAt 为下次可领取时间（Unix 秒），领取后作为租约，临时失败后为退避重试时间；FailReason 为最终失败原因。
+type ScheduledMessage struct {
+	ID             string    `gorm:"column:id;type:uuid;primaryKey"`
+	ServerMsgID    string    `gorm:"column:server_msg_id;type:text;uniqueIndex;not null"`
+	ClientMsgID    string    `gorm:"column:client_msg_id;type:text;not null"`
+	ConversationID string    `gorm:"column:conversation_id;type:varchar(36);not null"`
+	FromUserID     string    `gorm:"column:from_user_id;type:varchar(10);not null;index:idx_scheduled_messages_from_user_status,priority:1"`
+	ToUserID       *string   `gorm:"column:to_user_id;type:varchar(10)"`
+	BodyType       string    `gorm:"column:body_type;type:text;not null"`
+	BodyText       string    `gorm:"column:body_text;type:text;not null"`
+	SendAt         int64     `gorm:"column:send_at;not null;index:idx_scheduled_messages_status_send_at,priority:2"`
+	Status         string    `gorm:"column:status;type:text;not null;default:pending;index:idx_scheduled_messages_status_send_at,priority:1;index:idx_scheduled_messages_from_user_status,priority:2"`
+	Attempts       int       `gorm:"column:attempts;not null;default:0"`
+	RetryAt        int64     `gorm:"column:retry_at;not null;default:0"`
+	FailReason     string    `gorm:"column:fail_reason;type:text;not null;default:''"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (ScheduledMessage) TableName() string {
+	return "scheduled_messages"
+}
+
+type ScheduledModel struct {
+	db *gorm.DB
+}
+
+func NewScheduledModel(db *gorm.DB) *ScheduledModel {
+	return &ScheduledModel{db: db}
+}
+
+func (m *ScheduledModel) Create(s *ScheduledMessage) error {
+	return m.db.Create(s).Error
+}
+
+// ListPending 列出用户尚未发出的定时消息，按 send_at 升序；conversationID 为空时不按会话过滤
+func (m *ScheduledModel) ListPending(fromUserID, conversationID string) ([]*ScheduledMessage, error) {
+	q := m.db.Where("from_user_id = ? AND status = ?", fromUserID, ScheduledStatusPending)
+	if conversationID != "" {
+		q = q.Where("conversation_id = ?", conversationID)
+	}
+	var list []*ScheduledMessage
+	err := q.Order("send_at ASC").Find(&list).Error
+	return list, err
+}
+
+// Cancel 取消用户的一条待发定时消息；已发出、已取消或不属于该用户时返回 gorm.ErrRecordNotFound
+func (m *ScheduledModel) Cancel(fromUserID, serverMsgID string) error {
+	res := m.db.Model(&ScheduledMessage{}).
+		Where("server_msg_id = ? AND from_user_id = ? AND status = ?", serverMsgID, fromUserID, ScheduledStatusPending).
+		Updates(map[string]interface{}{"status": ScheduledStatusCanceled, "updated_at": time.Now()})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ClaimDue 领取至多 limit 条到期（send_at、retry_at 均不晚于 now）的待发消息：attempts 加 1，retry_at 推后到 now + lease 作为租约。
+// 领取只在一个短事务内完成（FOR UPDATE SKIP LOCKED），多个副本并发时同一条消息只会被一个副本领取；
+// 发送前的校验在事务外进行，副本崩溃或未处理完时租约到期后自动重新领取。
+func (m *ScheduledModel) ClaimDue(now, lease int64, limit int) ([]*ScheduledMessage, error) {
+	var list []*ScheduledMessage
+	err := m.db.Raw(`
+		UPDATE scheduled_messages SET attempts = attempts + 1, retry_at = ?, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM scheduled_messages
+			WHERE status = ? AND send_at <= ? AND retry_at <= ?
+			ORDER BY send_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, now+lease, ScheduledStatusPending, now, now, limit).Scan(&list).Error
+	return list, err
+}
+
+// MarkSent 在一个事务内锁定仍为 pending 的定时消息，调用 write 写入 messages 后标记为 sent；
+// 领取后已被用户取消或已被其他副本处理时返回 gorm.ErrRecordNotFound，write 返回错误时整体回滚，消息保持 pending
+func (m *ScheduledModel) MarkSent(id string, write func(tx *gorm.DB) error) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		var s ScheduledMessage
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status = ?", id, ScheduledStatusPending).
+			First(&s).Error; err != nil {
+			return err
+		}
+		if err := write(tx); err != nil {
+			return err
+		}
+		return tx.Model(&ScheduledMessage{}).Where("id = ?", id).
+			Updates(map[string]interface{}{"status": ScheduledStatusSent, "updated_at": time.Now()}).Error
+	})
+}
+
+// Finish 将仍为 pending 的定时消息结束为 status（canceled / failed），reason 记入 fail_reason；
+// 已不是 pending（用户已取消或已发出）时返回 false
+func (m *ScheduledModel) Finish(id, status, reason string) (bool, error) {
+	res := m.db.Model(&ScheduledMessage{}).
+		Where("id = ? AND status = ?", id, ScheduledStatusPending).
+		Updates(map[string]interface{}{"status": status, "fail_reason": reason, "updated_at": time.Now()})
+	return res.RowsAffected > 0, res.Error
+}
+
+// Retry 临时失败后保持 pending，retry_at 之后再次领取
+func (m *ScheduledModel) Retry(id string, retryAt int64) error {
+	return m.db.Model(&ScheduledMessage{}).
+		Where("id = ? AND status = ?", id, ScheduledStatusPending).
+		Updates(map[string]interface{}{"retry_at": retryAt, "updated_at": time.Now()}).Error
+}
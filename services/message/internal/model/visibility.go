@@ -0,0 +1,108 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// HiddenMessage 用户「仅对自己删除」的消息，表名 message_hidden，主键 (user_id, server_msg_id)
+type HiddenMessage struct {
+	UserID         string    `gorm:"column:user_id;type:varchar(10);primaryKey;index:idx_message_hidden_user_conv,priority:1"`
+	ServerMsgID    string    `gorm:"column:server_msg_id;type:text;primaryKey"`
+	ConversationID string    `gorm:"column:conversation_id;type:varchar(36);not null;index:idx_message_hidden_user_conv,priority:2"`
+	CreatedAt      time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+}
+
+func (HiddenMessage) TableName() string {
+	return "message_hidden"
+}
+
+// ConversationClear 用户在某会话的清空水位：server_time 早于 ClearedServerTime 的消息对该用户不可见，
+// 清空时与水位同一秒的已有消息写入 message_hidden；ClearedServerMsgID 为清空时会话的最后一条消息，ClearedAt 为清空操作时间
+type ConversationClear struct {
+	UserID             string    `gorm:"column:user_id;type:varchar(10);primaryKey"`
+	ConversationID     string    `gorm:"column:conversation_id;type:varchar(36);primaryKey"`
+	ClearedAt          time.Time `gorm:"column:cleared_at;type:timestamptz;not null"`
+	ClearedServerTime  int64     `gorm:"column:cleared_server_time;not null;default:0"`
+	ClearedServerMsgID string    `gorm:"column:cleared_server_msg_id;type:text;not null;default:''"`
+	UpdatedAt          time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (ConversationClear) TableName() string {
+	return "conversation_clear"
+}
+
+type VisibilityModel struct {
+	db *gorm.DB
+}
+
+func NewVisibilityModel(db *gorm.DB) *VisibilityModel {
+	return &VisibilityModel{db: db}
+}
+
+// HideMessages 为用户隐藏会话内的若干消息；只写入确实属于该会话的 server_msg_id，已隐藏的忽略。返回新隐藏条数。
+func (m *VisibilityModel) HideMessages(userID, conversationID string, serverMsgIDs []string) (int64, error) {
+	if len(serverMsgIDs) == 0 {
+		return 0, nil
+	}
+	res := m.db.Exec(`
+		INSERT INTO message_hidden (user_id, server_msg_id, conversation_id, created_at)
+		SELECT ?, server_msg_id, conversation_id, NOW()
+		FROM messages
+		WHERE conversation_id = ? AND server_msg_id IN ?
+		ON CONFLICT (user_id, server_msg_id) DO NOTHING
+	`, userID, conversationID, serverMsgIDs)
+	return res.RowsAffected, res.Error
+}
+
+// ClearConversation 以会话当前最后一条消息为水位为用户清空历史（水位只前进不后退），返回该消息；会话没有消息时返回 nil。
+// 水位按服务端消息序（server_time）而不是时钟比较：早于水位那一秒的消息由水位覆盖，同一秒内已有的消息逐条隐藏，
+// 清空之后同一秒内写入的消息仍然可见
+func (m *VisibilityModel) ClearConversation(userID, conversationID string, now time.Time) (*Message, error) {
+	var last *Message
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		var list []*Message
+		if err := tx.Where("conversation_id = ?", conversationID).
+			Order("server_time DESC, server_msg_id DESC").Limit(1).Find(&list).Error; err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		last = list[0]
+		if err := tx.Exec(`
+			INSERT INTO conversation_clear (user_id, conversation_id, cleared_at, cleared_server_time, cleared_server_msg_id, updated_at)
+			VALUES (?, ?, ?, ?, ?, NOW())
+			ON CONFLICT (user_id, conversation_id) DO UPDATE SET
+				cleared_at = EXCLUDED.cleared_at,
+				cleared_server_time = GREATEST(conversation_clear.cleared_server_time, EXCLUDED.cleared_server_time),
+				cleared_server_msg_id = EXCLUDED.cleared_server_msg_id,
+				updated_at = NOW()
+		`, userID, conversationID, now, last.ServerTime, last.ServerMsgID).Error; err != nil {
+			return err
+		}
+		return tx.Exec(`
+			INSERT INTO message_hidden (user_id, server_msg_id, conversation_id, created_at)
+			SELECT ?, server_msg_id, conversation_id, NOW()
+			FROM messages
+			WHERE conversation_id = ? AND server_time = ?
+			ON CONFLICT (user_id, server_msg_id) DO NOTHING
+		`, userID, conversationID, last.ServerTime).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return last, nil
+}
+
+// visibleTo 为 messages 查询追加「对 userID 可见」条件：未被该用户隐藏，且不早于该用户的清空水位。userID 为空时不过滤。
+func visibleTo(q *gorm.DB, userID string) *gorm.DB {
+	if userID == "" {
+		return q
+	}
+	return q.Where(visibleToSQL, userID, userID)
+}
+
+const visibleToSQL = `NOT EXISTS (SELECT 1 FROM message_hidden h WHERE h.user_id = ? AND h.server_msg_id = messages.server_msg_id)
+	AND NOT EXISTS (SELECT 1 FROM conversation_clear cc WHERE cc.user_id = ? AND cc.conversation_id = messages.conversation_id AND messages.server_time < cc.cleared_server_time)`
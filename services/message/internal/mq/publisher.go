@@ -56,14 +56,30 @@ func (p *Publisher) PublishJSON(v interface{}) error {
 	return p.Publish(body)
 }
 
+// PublishJSONWithKey 与 PublishJSON 相同，但使用指定的 routeKey（如 message.deletedForMe）。
+func (p *Publisher) PublishJSONWithKey(routeKey string, v interface{}) error {
+	if p == nil {
+		return nil
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return p.publish(routeKey, body)
+}
+
 // Publish 发布原始 body。
 func (p *Publisher) Publish(body []byte) error {
 	if p == nil {
 		return nil
 	}
+	return p.publish(p.routeKey, body)
+}
+
+func (p *Publisher) publish(routeKey string, body []byte) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	return p.ch.Publish(p.exchange, p.routeKey, false, false, amqp.Publishing{
+	return p.ch.Publish(p.exchange, routeKey, false, false, amqp.Publishing{
 		ContentType: "application/json",
 		Body:        body,
 	})
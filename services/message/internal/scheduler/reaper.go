@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/logic"
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Reaper 周期性删除已过期的阅后即焚消息。查询侧已过滤过期消息，Reaper 只负责物理清理与通知客户端。
+type Reaper struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+}
+
+// NewReaper 按配置的间隔创建清理任务，未配置时默认 5 秒。
+func NewReaper(svcCtx *svc.ServiceContext) *Reaper {
+	interval := time.Duration(svcCtx.Config.ExpiredReapIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	return &Reaper{svcCtx: svcCtx, interval: interval}
+}
+
+// Run 阻塞运行直到 ctx 取消。
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.reapAll(ctx)
+		}
+	}
+}
+
+func (r *Reaper) reapAll(ctx context.Context) {
+	l := logic.NewReapExpiredLogic(ctx, r.svcCtx)
+	for ctx.Err() == nil {
+		n, err := l.ReapExpired(batchSize)
+		if err != nil {
+			logx.Errorf("reaper: delete expired messages failed: %v", err)
+			return
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Package scheduler 提供 Message 服务的后台任务：释放到期的定时消息、清理过期的阅后即焚消息。
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/message/internal/logic"
+	"github.com/HappyLadySauce/Beehive/services/message/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// batchSize 每次领取的最大条数；一批领满时立即继续下一批，避免积压。
+const batchSize = 100
+
+// Scheduler 轮询 scheduled_messages 并释放到期消息。待发消息持久化在数据库中，服务重启后继续释放；
+// 多副本同时运行时由领取租约（FOR UPDATE SKIP LOCKED 领取并推后 retry_at）与发送时的行锁保证每条消息只发出一次。
+type Scheduler struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+}
+
+// NewScheduler 按配置的轮询间隔创建调度器，未配置时默认 1 秒。
+func NewScheduler(svcCtx *svc.ServiceContext) *Scheduler {
+	interval := time.Duration(svcCtx.Config.ScheduledPollIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Second
+	}
+	return &Scheduler{svcCtx: svcCtx, interval: interval}
+}
+
+// Run 阻塞运行直到 ctx 取消。
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.releaseAll(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) releaseAll(ctx context.Context) {
+	l := logic.NewReleaseScheduledLogic(ctx, s.svcCtx)
+	for ctx.Err() == nil {
+		n, err := l.ReleaseDue(batchSize)
+		if err != nil {
+			logx.Errorf("scheduler: release scheduled messages failed: %v", err)
+			return
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
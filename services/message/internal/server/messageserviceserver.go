@@ -47,3 +47,23 @@ func (s *MessageServiceServer) GetUnreadCounts(ctx context.Context, in *pb.GetUn
 	l := logic.NewGetUnreadCountsLogic(ctx, s.svcCtx)
 	return l.GetUnreadCounts(in)
 }
+
+func (s *MessageServiceServer) DeleteForMe(ctx context.Context, in *pb.DeleteForMeRequest) (*pb.DeleteForMeResponse, error) {
+	l := logic.NewDeleteForMeLogic(ctx, s.svcCtx)
+	return l.DeleteForMe(in)
+}
+
+func (s *MessageServiceServer) ClearHistory(ctx context.Context, in *pb.ClearHistoryRequest) (*pb.ClearHistoryResponse, error) {
+	l := logic.NewClearHistoryLogic(ctx, s.svcCtx)
+	return l.ClearHistory(in)
+}
+
+func (s *MessageServiceServer) ListScheduledMessages(ctx context.Context, in *pb.ListScheduledMessagesRequest) (*pb.ListScheduledMessagesResponse, error) {
+	l := logic.NewListScheduledMessagesLogic(ctx, s.svcCtx)
+	return l.ListScheduledMessages(in)
+}
+
+func (s *MessageServiceServer) CancelScheduledMessage(ctx context.Context, in *pb.CancelScheduledMessageRequest) (*pb.CancelScheduledMessageResponse, error) {
+	l := logic.NewCancelScheduledMessageLogic(ctx, s.svcCtx)
+	return l.CancelScheduledMessage(in)
+}
@@ -6,17 +6,26 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/message/internal/config"
 	"github.com/HappyLadySauce/Beehive/services/message/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/message/internal/mq"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/zrpc"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
 type ServiceContext struct {
-	Config  config.Config
-	DB      *gorm.DB
-	Msg     *model.MessageModel
-	Read    *model.ReadModel
-	MQ      *mq.Publisher
+	Config     config.Config
+	DB         *gorm.DB
+	Msg        *model.MessageModel
+	Read       *model.ReadModel
+	Visibility *model.VisibilityModel
+	Scheduled  *model.ScheduledModel
+	MQ         *mq.Publisher
+	// SpeakPolicies 缓存 会话+用户 的发言限制，避免每条消息都查询数据库
+	SpeakPolicies *collection.Cache
+	// UserSvc 用于单聊黑名单校验；未配置时为 nil，不校验
+	UserSvc userservice.UserService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -36,6 +45,12 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	if err := db.AutoMigrate(&model.ConversationRead{}); err != nil {
 		panic("auto migrate conversation_read: " + err.Error())
 	}
+	if err := db.AutoMigrate(&model.HiddenMessage{}, &model.ConversationClear{}); err != nil {
+		panic("auto migrate message visibility: " + err.Error())
+	}
+	if err := db.AutoMigrate(&model.ScheduledMessage{}); err != nil {
+		panic("auto migrate scheduled_messages: " + err.Error())
+	}
 	var pub *mq.Publisher
 	if c.RabbitMQURL != "" {
 		pub, err = mq.NewPublisher(c.RabbitMQURL, c.RabbitMQExchange, c.RabbitMQRouteKey)
@@ -43,11 +58,27 @@ func NewServiceContext(c config.Config) *ServiceContext {
 			panic("rabbitmq: " + err.Error())
 		}
 	}
+	cacheSeconds := c.SpeakPolicyCacheSeconds
+	if cacheSeconds <= 0 {
+		cacheSeconds = 5
+	}
+	speakPolicies, err := collection.NewCache(time.Duration(cacheSeconds)*time.Second, collection.WithName("speak-policy"))
+	if err != nil {
+		panic("speak policy cache: " + err.Error())
+	}
+	var userSvc userservice.UserService
+	if c.UserRpcConfigured() {
+		userSvc = userservice.NewUserService(zrpc.MustNewClient(c.UserRpc))
+	}
 	return &ServiceContext{
-		Config: c,
-		DB:     db,
-		Msg:    model.NewMessageModel(db),
-		Read:   model.NewReadModel(db),
-		MQ:     pub,
+		Config:        c,
+		DB:            db,
+		Msg:           model.NewMessageModel(db),
+		Read:          model.NewReadModel(db),
+		Visibility:    model.NewVisibilityModel(db),
+		Scheduled:     model.NewScheduledModel(db),
+		MQ:            pub,
+		SpeakPolicies: speakPolicies,
+		UserSvc:       userSvc,
 	}
 }
@@ -14,18 +14,28 @@ import (
 )
 
 type (
-	GetHistoryRequest         = pb.GetHistoryRequest
-	GetHistoryResponse        = pb.GetHistoryResponse
-	GetLastMessagesRequest    = pb.GetLastMessagesRequest
-	GetLastMessagesResponse   = pb.GetLastMessagesResponse
-	GetUnreadCountsRequest    = pb.GetUnreadCountsRequest
-	GetUnreadCountsResponse   = pb.GetUnreadCountsResponse
-	MarkReadRequest           = pb.MarkReadRequest
-	MarkReadResponse          = pb.MarkReadResponse
-	MessageBody               = pb.MessageBody
-	MessageRecord             = pb.MessageRecord
-	PostMessageRequest        = pb.PostMessageRequest
-	PostMessageResponse       = pb.PostMessageResponse
+	CancelScheduledMessageRequest  = pb.CancelScheduledMessageRequest
+	CancelScheduledMessageResponse = pb.CancelScheduledMessageResponse
+	ClearHistoryRequest            = pb.ClearHistoryRequest
+	ClearHistoryResponse           = pb.ClearHistoryResponse
+	DeleteForMeRequest             = pb.DeleteForMeRequest
+	DeleteForMeResponse            = pb.DeleteForMeResponse
+	GetHistoryRequest              = pb.GetHistoryRequest
+	GetHistoryResponse             = pb.GetHistoryResponse
+	GetLastMessagesRequest         = pb.GetLastMessagesRequest
+	GetLastMessagesResponse        = pb.GetLastMessagesResponse
+	GetUnreadCountsRequest         = pb.GetUnreadCountsRequest
+	GetUnreadCountsResponse        = pb.GetUnreadCountsResponse
+	ListScheduledMessagesRequest   = pb.ListScheduledMessagesRequest
+	ListScheduledMessagesResponse  = pb.ListScheduledMessagesResponse
+	MarkReadRequest                = pb.MarkReadRequest
+	MarkReadResponse               = pb.MarkReadResponse
+	MessageBody                    = pb.MessageBody
+	MessageRecord                  = pb.MessageRecord
+	PostMessageRequest             = pb.PostMessageRequest
+	PostMessageResponse            = pb.PostMessageResponse
+	ScheduledMessage               = pb.ScheduledMessage
+	SystemPayload                  = pb.SystemPayload
 
 	MessageService interface {
 		PostMessage(ctx context.Context, in *PostMessageRequest, opts ...grpc.CallOption) (*PostMessageResponse, error)
@@ -33,6 +43,10 @@ type (
 		GetLastMessages(ctx context.Context, in *GetLastMessagesRequest, opts ...grpc.CallOption) (*GetLastMessagesResponse, error)
 		MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
 		GetUnreadCounts(ctx context.Context, in *GetUnreadCountsRequest, opts ...grpc.CallOption) (*GetUnreadCountsResponse, error)
+		DeleteForMe(ctx context.Context, in *DeleteForMeRequest, opts ...grpc.CallOption) (*DeleteForMeResponse, error)
+		ClearHistory(ctx context.Context, in *ClearHistoryRequest, opts ...grpc.CallOption) (*ClearHistoryResponse, error)
+		ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error)
+		CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error)
 	}
 
 	defaultMessageService struct {
@@ -70,3 +84,23 @@ func (m *defaultMessageService) GetUnreadCounts(ctx context.Context, in *GetUnre
 	client := pb.NewMessageServiceClient(m.cli.Conn())
 	return client.GetUnreadCounts(ctx, in, opts...)
 }
+
+func (m *defaultMessageService) DeleteForMe(ctx context.Context, in *DeleteForMeRequest, opts ...grpc.CallOption) (*DeleteForMeResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.DeleteForMe(ctx, in, opts...)
+}
+
+func (m *defaultMessageService) ClearHistory(ctx context.Context, in *ClearHistoryRequest, opts ...grpc.CallOption) (*ClearHistoryResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.ClearHistory(ctx, in, opts...)
+}
+
+func (m *defaultMessageService) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.ListScheduledMessages(ctx, in, opts...)
+}
+
+func (m *defaultMessageService) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error) {
+	client := pb.NewMessageServiceClient(m.cli.Conn())
+	return client.CancelScheduledMessage(ctx, in, opts...)
+}
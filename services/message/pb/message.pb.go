@@ -23,8 +23,9 @@ const (
 
 type MessageBody struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // text / image / system / ...
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"` // when type = text
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`     // text / image / system / ...
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`     // when type = text
+	System        *SystemPayload         `protobuf:"bytes,3,opt,name=system,proto3" json:"system,omitempty"` // when type = system，由服务端生成，客户端按 event 本地化展示
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,6 +74,98 @@ func (x *MessageBody) GetText() string {
 	return ""
 }
 
+func (x *MessageBody) GetSystem() *SystemPayload {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+// SystemPayload 系统消息的结构化内容（成员变动、群资料变更等）
+type SystemPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`                             // group.created / group.renamed / member.joined / member.left / member.removed / member.roleChanged / announcement.changed
+	OperatorId    string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 触发事件的用户
+	UserIds       []string               `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`          // 被操作的成员
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`                               // member.roleChanged 时的新角色
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                               // group.created / group.renamed 时的群名
+	Announcement  string                 `protobuf:"bytes,6,opt,name=announcement,proto3" json:"announcement,omitempty"`               // announcement.changed 时的新公告
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemPayload) Reset() {
+	*x = SystemPayload{}
+	mi := &file_proto_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemPayload) ProtoMessage() {}
+
+func (x *SystemPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemPayload.ProtoReflect.Descriptor instead.
+func (*SystemPayload) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SystemPayload) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *SystemPayload) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *SystemPayload) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *SystemPayload) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SystemPayload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemPayload) GetAnnouncement() string {
+	if x != nil {
+		return x.Announcement
+	}
+	return ""
+}
+
 type PostMessageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ClientMsgId    string                 `protobuf:"bytes,1,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`
@@ -80,13 +173,14 @@ type PostMessageRequest struct {
 	FromUserId     string                 `protobuf:"bytes,3,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
 	ToUserId       string                 `protobuf:"bytes,4,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"` // for single chat, optional for group
 	Body           *MessageBody           `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	SendAt         int64                  `protobuf:"varint,6,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"` // optional: 定时发送时间（Unix 秒），晚于当前时间时进入定时队列
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PostMessageRequest) Reset() {
 	*x = PostMessageRequest{}
-	mi := &file_proto_message_proto_msgTypes[1]
+	mi := &file_proto_message_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -98,7 +192,7 @@ func (x *PostMessageRequest) String() string {
 func (*PostMessageRequest) ProtoMessage() {}
 
 func (x *PostMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[1]
+	mi := &file_proto_message_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +205,7 @@ func (x *PostMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostMessageRequest.ProtoReflect.Descriptor instead.
 func (*PostMessageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{1}
+	return file_proto_message_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PostMessageRequest) GetClientMsgId() string {
@@ -149,18 +243,26 @@ func (x *PostMessageRequest) GetBody() *MessageBody {
 	return nil
 }
 
+func (x *PostMessageRequest) GetSendAt() int64 {
+	if x != nil {
+		return x.SendAt
+	}
+	return 0
+}
+
 type PostMessageResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ServerMsgId    string                 `protobuf:"bytes,1,opt,name=server_msg_id,json=serverMsgId,proto3" json:"server_msg_id,omitempty"`
 	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	ServerTime     int64                  `protobuf:"varint,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	ServerTime     int64                  `protobuf:"varint,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"` // 定时消息为预计发送时间 send_at
+	Scheduled      bool                   `protobuf:"varint,4,opt,name=scheduled,proto3" json:"scheduled,omitempty"`                     // true 表示已进入定时队列，尚未写入消息表
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PostMessageResponse) Reset() {
 	*x = PostMessageResponse{}
-	mi := &file_proto_message_proto_msgTypes[2]
+	mi := &file_proto_message_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -172,7 +274,7 @@ func (x *PostMessageResponse) String() string {
 func (*PostMessageResponse) ProtoMessage() {}
 
 func (x *PostMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[2]
+	mi := &file_proto_message_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +287,7 @@ func (x *PostMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostMessageResponse.ProtoReflect.Descriptor instead.
 func (*PostMessageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{2}
+	return file_proto_message_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PostMessageResponse) GetServerMsgId() string {
@@ -209,18 +311,26 @@ func (x *PostMessageResponse) GetServerTime() int64 {
 	return 0
 }
 
+func (x *PostMessageResponse) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
 type GetHistoryRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId string                 `protobuf:"bytes,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	BeforeTime     int64                  `protobuf:"varint,2,opt,name=before_time,json=beforeTime,proto3" json:"before_time,omitempty"` // optional: messages before this time
 	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional: 按该用户的可见性过滤（已删除/已清空的消息不返回）
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetHistoryRequest) Reset() {
 	*x = GetHistoryRequest{}
-	mi := &file_proto_message_proto_msgTypes[3]
+	mi := &file_proto_message_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -232,7 +342,7 @@ func (x *GetHistoryRequest) String() string {
 func (*GetHistoryRequest) ProtoMessage() {}
 
 func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[3]
+	mi := &file_proto_message_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +355,7 @@ func (x *GetHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{3}
+	return file_proto_message_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetHistoryRequest) GetConversationId() string {
@@ -269,6 +379,13 @@ func (x *GetHistoryRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type MessageRecord struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ServerMsgId    string                 `protobuf:"bytes,1,opt,name=server_msg_id,json=serverMsgId,proto3" json:"server_msg_id,omitempty"`
@@ -277,13 +394,14 @@ type MessageRecord struct {
 	ToUserId       string                 `protobuf:"bytes,4,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
 	Body           *MessageBody           `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
 	ServerTime     int64                  `protobuf:"varint,6,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	ExpiresAt      int64                  `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 阅后即焚消息的过期时间（Unix 秒），0 表示不过期
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *MessageRecord) Reset() {
 	*x = MessageRecord{}
-	mi := &file_proto_message_proto_msgTypes[4]
+	mi := &file_proto_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -295,7 +413,7 @@ func (x *MessageRecord) String() string {
 func (*MessageRecord) ProtoMessage() {}
 
 func (x *MessageRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[4]
+	mi := &file_proto_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,7 +426,7 @@ func (x *MessageRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageRecord.ProtoReflect.Descriptor instead.
 func (*MessageRecord) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{4}
+	return file_proto_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MessageRecord) GetServerMsgId() string {
@@ -353,6 +471,13 @@ func (x *MessageRecord) GetServerTime() int64 {
 	return 0
 }
 
+func (x *MessageRecord) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
 type GetHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*MessageRecord       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -363,7 +488,7 @@ type GetHistoryResponse struct {
 
 func (x *GetHistoryResponse) Reset() {
 	*x = GetHistoryResponse{}
-	mi := &file_proto_message_proto_msgTypes[5]
+	mi := &file_proto_message_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -375,7 +500,7 @@ func (x *GetHistoryResponse) String() string {
 func (*GetHistoryResponse) ProtoMessage() {}
 
 func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[5]
+	mi := &file_proto_message_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,7 +513,7 @@ func (x *GetHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{5}
+	return file_proto_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetHistoryResponse) GetItems() []*MessageRecord {
@@ -408,13 +533,14 @@ func (x *GetHistoryResponse) GetHasMore() bool {
 type GetLastMessagesRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ConversationIds []string               `protobuf:"bytes,1,rep,name=conversation_ids,json=conversationIds,proto3" json:"conversation_ids,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional: 按该用户的可见性过滤
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetLastMessagesRequest) Reset() {
 	*x = GetLastMessagesRequest{}
-	mi := &file_proto_message_proto_msgTypes[6]
+	mi := &file_proto_message_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -426,7 +552,7 @@ func (x *GetLastMessagesRequest) String() string {
 func (*GetLastMessagesRequest) ProtoMessage() {}
 
 func (x *GetLastMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[6]
+	mi := &file_proto_message_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,7 +565,7 @@ func (x *GetLastMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastMessagesRequest.ProtoReflect.Descriptor instead.
 func (*GetLastMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{6}
+	return file_proto_message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetLastMessagesRequest) GetConversationIds() []string {
@@ -449,6 +575,13 @@ func (x *GetLastMessagesRequest) GetConversationIds() []string {
 	return nil
 }
 
+func (x *GetLastMessagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type GetLastMessagesResponse struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	LastMessages  map[string]*MessageRecord `protobuf:"bytes,1,rep,name=last_messages,json=lastMessages,proto3" json:"last_messages,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -458,7 +591,7 @@ type GetLastMessagesResponse struct {
 
 func (x *GetLastMessagesResponse) Reset() {
 	*x = GetLastMessagesResponse{}
-	mi := &file_proto_message_proto_msgTypes[7]
+	mi := &file_proto_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -470,7 +603,7 @@ func (x *GetLastMessagesResponse) String() string {
 func (*GetLastMessagesResponse) ProtoMessage() {}
 
 func (x *GetLastMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[7]
+	mi := &file_proto_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +616,7 @@ func (x *GetLastMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastMessagesResponse.ProtoReflect.Descriptor instead.
 func (*GetLastMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{7}
+	return file_proto_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetLastMessagesResponse) GetLastMessages() map[string]*MessageRecord {
@@ -504,7 +637,7 @@ type MarkReadRequest struct {
 
 func (x *MarkReadRequest) Reset() {
 	*x = MarkReadRequest{}
-	mi := &file_proto_message_proto_msgTypes[8]
+	mi := &file_proto_message_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +649,7 @@ func (x *MarkReadRequest) String() string {
 func (*MarkReadRequest) ProtoMessage() {}
 
 func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[8]
+	mi := &file_proto_message_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +662,7 @@ func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
 func (*MarkReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{8}
+	return file_proto_message_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MarkReadRequest) GetUserId() string {
@@ -561,7 +694,7 @@ type MarkReadResponse struct {
 
 func (x *MarkReadResponse) Reset() {
 	*x = MarkReadResponse{}
-	mi := &file_proto_message_proto_msgTypes[9]
+	mi := &file_proto_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +706,7 @@ func (x *MarkReadResponse) String() string {
 func (*MarkReadResponse) ProtoMessage() {}
 
 func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[9]
+	mi := &file_proto_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +719,7 @@ func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
 func (*MarkReadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{9}
+	return file_proto_message_proto_rawDescGZIP(), []int{10}
 }
 
 type GetUnreadCountsRequest struct {
@@ -599,7 +732,7 @@ type GetUnreadCountsRequest struct {
 
 func (x *GetUnreadCountsRequest) Reset() {
 	*x = GetUnreadCountsRequest{}
-	mi := &file_proto_message_proto_msgTypes[10]
+	mi := &file_proto_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +744,7 @@ func (x *GetUnreadCountsRequest) String() string {
 func (*GetUnreadCountsRequest) ProtoMessage() {}
 
 func (x *GetUnreadCountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[10]
+	mi := &file_proto_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +757,7 @@ func (x *GetUnreadCountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadCountsRequest.ProtoReflect.Descriptor instead.
 func (*GetUnreadCountsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{10}
+	return file_proto_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUnreadCountsRequest) GetUserId() string {
@@ -650,7 +783,7 @@ type GetUnreadCountsResponse struct {
 
 func (x *GetUnreadCountsResponse) Reset() {
 	*x = GetUnreadCountsResponse{}
-	mi := &file_proto_message_proto_msgTypes[11]
+	mi := &file_proto_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +795,7 @@ func (x *GetUnreadCountsResponse) String() string {
 func (*GetUnreadCountsResponse) ProtoMessage() {}
 
 func (x *GetUnreadCountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_message_proto_msgTypes[11]
+	mi := &file_proto_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +808,7 @@ func (x *GetUnreadCountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUnreadCountsResponse.ProtoReflect.Descriptor instead.
 func (*GetUnreadCountsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_message_proto_rawDescGZIP(), []int{11}
+	return file_proto_message_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUnreadCountsResponse) GetCounts() map[string]int32 {
@@ -685,14 +818,515 @@ func (x *GetUnreadCountsResponse) GetCounts() map[string]int32 {
 	return nil
 }
 
+type DeleteForMeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	ServerMsgIds   []string               `protobuf:"bytes,3,rep,name=server_msg_ids,json=serverMsgIds,proto3" json:"server_msg_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteForMeRequest) Reset() {
+	*x = DeleteForMeRequest{}
+	mi := &file_proto_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteForMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteForMeRequest) ProtoMessage() {}
+
+func (x *DeleteForMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteForMeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteForMeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteForMeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteForMeRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *DeleteForMeRequest) GetServerMsgIds() []string {
+	if x != nil {
+		return x.ServerMsgIds
+	}
+	return nil
+}
+
+type DeleteForMeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       int32                  `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"` // 实际新隐藏的条数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteForMeResponse) Reset() {
+	*x = DeleteForMeResponse{}
+	mi := &file_proto_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteForMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteForMeResponse) ProtoMessage() {}
+
+func (x *DeleteForMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteForMeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteForMeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteForMeResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type ClearHistoryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClearHistoryRequest) Reset() {
+	*x = ClearHistoryRequest{}
+	mi := &file_proto_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryRequest) ProtoMessage() {}
+
+func (x *ClearHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ClearHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ClearHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClearHistoryRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type ClearHistoryResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ClearedAt          int64                  `protobuf:"varint,1,opt,name=cleared_at,json=clearedAt,proto3" json:"cleared_at,omitempty"`                               // 清空水位：清空时最后一条消息的 server_time（Unix 秒），会话没有消息时为 0
+	ClearedServerMsgId string                 `protobuf:"bytes,2,opt,name=cleared_server_msg_id,json=clearedServerMsgId,proto3" json:"cleared_server_msg_id,omitempty"` // 清空时最后一条消息，该消息及之前已有的消息对该用户不可见
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ClearHistoryResponse) Reset() {
+	*x = ClearHistoryResponse{}
+	mi := &file_proto_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHistoryResponse) ProtoMessage() {}
+
+func (x *ClearHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ClearHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClearHistoryResponse) GetClearedAt() int64 {
+	if x != nil {
+		return x.ClearedAt
+	}
+	return 0
+}
+
+func (x *ClearHistoryResponse) GetClearedServerMsgId() string {
+	if x != nil {
+		return x.ClearedServerMsgId
+	}
+	return ""
+}
+
+type ScheduledMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ServerMsgId    string                 `protobuf:"bytes,1,opt,name=server_msg_id,json=serverMsgId,proto3" json:"server_msg_id,omitempty"` // 发出后沿用该 ID
+	ClientMsgId    string                 `protobuf:"bytes,2,opt,name=client_msg_id,json=clientMsgId,proto3" json:"client_msg_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,3,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	FromUserId     string                 `protobuf:"bytes,4,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId       string                 `protobuf:"bytes,5,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Body           *MessageBody           `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	SendAt         int64                  `protobuf:"varint,7,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	CreatedAt      int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduledMessage) Reset() {
+	*x = ScheduledMessage{}
+	mi := &file_proto_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMessage) ProtoMessage() {}
+
+func (x *ScheduledMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMessage.ProtoReflect.Descriptor instead.
+func (*ScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ScheduledMessage) GetServerMsgId() string {
+	if x != nil {
+		return x.ServerMsgId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetClientMsgId() string {
+	if x != nil {
+		return x.ClientMsgId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetBody() *MessageBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *ScheduledMessage) GetSendAt() int64 {
+	if x != nil {
+		return x.SendAt
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListScheduledMessagesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConversationId string                 `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"` // optional: 只列出该会话
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListScheduledMessagesRequest) Reset() {
+	*x = ListScheduledMessagesRequest{}
+	mi := &file_proto_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesRequest) ProtoMessage() {}
+
+func (x *ListScheduledMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListScheduledMessagesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListScheduledMessagesRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+type ListScheduledMessagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ScheduledMessage    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledMessagesResponse) Reset() {
+	*x = ListScheduledMessagesResponse{}
+	mi := &file_proto_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesResponse) ProtoMessage() {}
+
+func (x *ListScheduledMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListScheduledMessagesResponse) GetItems() []*ScheduledMessage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CancelScheduledMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ServerMsgId   string                 `protobuf:"bytes,2,opt,name=server_msg_id,json=serverMsgId,proto3" json:"server_msg_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageRequest) Reset() {
+	*x = CancelScheduledMessageRequest{}
+	mi := &file_proto_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageRequest) ProtoMessage() {}
+
+func (x *CancelScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelScheduledMessageRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CancelScheduledMessageRequest) GetServerMsgId() string {
+	if x != nil {
+		return x.ServerMsgId
+	}
+	return ""
+}
+
+type CancelScheduledMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageResponse) Reset() {
+	*x = CancelScheduledMessageResponse{}
+	mi := &file_proto_message_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageResponse) ProtoMessage() {}
+
+func (x *CancelScheduledMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_message_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_message_proto_rawDescGZIP(), []int{21}
+}
+
 var File_proto_message_proto protoreflect.FileDescriptor
 
 const file_proto_message_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/message.proto\x12\x0fbeehive.message\"5\n" +
+	"\x13proto/message.proto\x12\x0fbeehive.message\"m\n" +
 	"\vMessageBody\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\"\xd3\x01\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x126\n" +
+	"\x06system\x18\x03 \x01(\v2\x1e.beehive.message.SystemPayloadR\x06system\"\xad\x01\n" +
+	"\rSystemPayload\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\tR\auserIds\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\"\n" +
+	"\fannouncement\x18\x06 \x01(\tR\fannouncement\"\xec\x01\n" +
 	"\x12PostMessageRequest\x12\"\n" +
 	"\rclient_msg_id\x18\x01 \x01(\tR\vclientMsgId\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12 \n" +
@@ -700,17 +1334,20 @@ const file_proto_message_proto_rawDesc = "" +
 	"fromUserId\x12\x1c\n" +
 	"\n" +
 	"to_user_id\x18\x04 \x01(\tR\btoUserId\x120\n" +
-	"\x04body\x18\x05 \x01(\v2\x1c.beehive.message.MessageBodyR\x04body\"\x83\x01\n" +
+	"\x04body\x18\x05 \x01(\v2\x1c.beehive.message.MessageBodyR\x04body\x12\x17\n" +
+	"\asend_at\x18\x06 \x01(\x03R\x06sendAt\"\xa1\x01\n" +
 	"\x13PostMessageResponse\x12\"\n" +
 	"\rserver_msg_id\x18\x01 \x01(\tR\vserverMsgId\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12\x1f\n" +
 	"\vserver_time\x18\x03 \x01(\x03R\n" +
-	"serverTime\"s\n" +
+	"serverTime\x12\x1c\n" +
+	"\tscheduled\x18\x04 \x01(\bR\tscheduled\"\x8c\x01\n" +
 	"\x11GetHistoryRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\tR\x0econversationId\x12\x1f\n" +
 	"\vbefore_time\x18\x02 \x01(\x03R\n" +
 	"beforeTime\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xef\x01\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"\x8e\x02\n" +
 	"\rMessageRecord\x12\"\n" +
 	"\rserver_msg_id\x18\x01 \x01(\tR\vserverMsgId\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12 \n" +
@@ -720,12 +1357,15 @@ const file_proto_message_proto_rawDesc = "" +
 	"to_user_id\x18\x04 \x01(\tR\btoUserId\x120\n" +
 	"\x04body\x18\x05 \x01(\v2\x1c.beehive.message.MessageBodyR\x04body\x12\x1f\n" +
 	"\vserver_time\x18\x06 \x01(\x03R\n" +
-	"serverTime\"e\n" +
+	"serverTime\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\x03R\texpiresAt\"e\n" +
 	"\x12GetHistoryResponse\x124\n" +
 	"\x05items\x18\x01 \x03(\v2\x1e.beehive.message.MessageRecordR\x05items\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"C\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\\\n" +
 	"\x16GetLastMessagesRequest\x12)\n" +
-	"\x10conversation_ids\x18\x01 \x03(\tR\x0fconversationIds\"\xdb\x01\n" +
+	"\x10conversation_ids\x18\x01 \x03(\tR\x0fconversationIds\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xdb\x01\n" +
 	"\x17GetLastMessagesResponse\x12_\n" +
 	"\rlast_messages\x18\x01 \x03(\v2:.beehive.message.GetLastMessagesResponse.LastMessagesEntryR\flastMessages\x1a_\n" +
 	"\x11LastMessagesEntry\x12\x10\n" +
@@ -743,14 +1383,52 @@ const file_proto_message_proto_rawDesc = "" +
 	"\x06counts\x18\x01 \x03(\v24.beehive.message.GetUnreadCountsResponse.CountsEntryR\x06counts\x1a9\n" +
 	"\vCountsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x012\xde\x03\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"|\n" +
+	"\x12DeleteForMeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\x12$\n" +
+	"\x0eserver_msg_ids\x18\x03 \x03(\tR\fserverMsgIds\"/\n" +
+	"\x13DeleteForMeResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x05R\adeleted\"W\n" +
+	"\x13ClearHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\"h\n" +
+	"\x14ClearHistoryResponse\x12\x1d\n" +
+	"\n" +
+	"cleared_at\x18\x01 \x01(\x03R\tclearedAt\x121\n" +
+	"\x15cleared_server_msg_id\x18\x02 \x01(\tR\x12clearedServerMsgId\"\xad\x02\n" +
+	"\x10ScheduledMessage\x12\"\n" +
+	"\rserver_msg_id\x18\x01 \x01(\tR\vserverMsgId\x12\"\n" +
+	"\rclient_msg_id\x18\x02 \x01(\tR\vclientMsgId\x12'\n" +
+	"\x0fconversation_id\x18\x03 \x01(\tR\x0econversationId\x12 \n" +
+	"\ffrom_user_id\x18\x04 \x01(\tR\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x05 \x01(\tR\btoUserId\x120\n" +
+	"\x04body\x18\x06 \x01(\v2\x1c.beehive.message.MessageBodyR\x04body\x12\x17\n" +
+	"\asend_at\x18\a \x01(\x03R\x06sendAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"`\n" +
+	"\x1cListScheduledMessagesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fconversation_id\x18\x02 \x01(\tR\x0econversationId\"X\n" +
+	"\x1dListScheduledMessagesResponse\x127\n" +
+	"\x05items\x18\x01 \x03(\v2!.beehive.message.ScheduledMessageR\x05items\"\\\n" +
+	"\x1dCancelScheduledMessageRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\rserver_msg_id\x18\x02 \x01(\tR\vserverMsgId\" \n" +
+	"\x1eCancelScheduledMessageResponse2\x88\a\n" +
 	"\x0eMessageService\x12X\n" +
 	"\vPostMessage\x12#.beehive.message.PostMessageRequest\x1a$.beehive.message.PostMessageResponse\x12U\n" +
 	"\n" +
 	"GetHistory\x12\".beehive.message.GetHistoryRequest\x1a#.beehive.message.GetHistoryResponse\x12d\n" +
 	"\x0fGetLastMessages\x12'.beehive.message.GetLastMessagesRequest\x1a(.beehive.message.GetLastMessagesResponse\x12O\n" +
 	"\bMarkRead\x12 .beehive.message.MarkReadRequest\x1a!.beehive.message.MarkReadResponse\x12d\n" +
-	"\x0fGetUnreadCounts\x12'.beehive.message.GetUnreadCountsRequest\x1a(.beehive.message.GetUnreadCountsResponseB7Z5github.com/HappyLadySauce/Beehive/services/message/pbb\x06proto3"
+	"\x0fGetUnreadCounts\x12'.beehive.message.GetUnreadCountsRequest\x1a(.beehive.message.GetUnreadCountsResponse\x12X\n" +
+	"\vDeleteForMe\x12#.beehive.message.DeleteForMeRequest\x1a$.beehive.message.DeleteForMeResponse\x12[\n" +
+	"\fClearHistory\x12$.beehive.message.ClearHistoryRequest\x1a%.beehive.message.ClearHistoryResponse\x12v\n" +
+	"\x15ListScheduledMessages\x12-.beehive.message.ListScheduledMessagesRequest\x1a..beehive.message.ListScheduledMessagesResponse\x12y\n" +
+	"\x16CancelScheduledMessage\x12..beehive.message.CancelScheduledMessageRequest\x1a/.beehive.message.CancelScheduledMessageResponseB\x17Z\x15./services/message/pbb\x06proto3"
 
 var (
 	file_proto_message_proto_rawDescOnce sync.Once
@@ -764,45 +1442,66 @@ func file_proto_message_proto_rawDescGZIP() []byte {
 	return file_proto_message_proto_rawDescData
 }
 
-var file_proto_message_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_message_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_message_proto_goTypes = []any{
-	(*MessageBody)(nil),             // 0: beehive.message.MessageBody
-	(*PostMessageRequest)(nil),      // 1: beehive.message.PostMessageRequest
-	(*PostMessageResponse)(nil),     // 2: beehive.message.PostMessageResponse
-	(*GetHistoryRequest)(nil),       // 3: beehive.message.GetHistoryRequest
-	(*MessageRecord)(nil),           // 4: beehive.message.MessageRecord
-	(*GetHistoryResponse)(nil),      // 5: beehive.message.GetHistoryResponse
-	(*GetLastMessagesRequest)(nil),  // 6: beehive.message.GetLastMessagesRequest
-	(*GetLastMessagesResponse)(nil), // 7: beehive.message.GetLastMessagesResponse
-	(*MarkReadRequest)(nil),         // 8: beehive.message.MarkReadRequest
-	(*MarkReadResponse)(nil),        // 9: beehive.message.MarkReadResponse
-	(*GetUnreadCountsRequest)(nil),  // 10: beehive.message.GetUnreadCountsRequest
-	(*GetUnreadCountsResponse)(nil), // 11: beehive.message.GetUnreadCountsResponse
-	nil,                             // 12: beehive.message.GetLastMessagesResponse.LastMessagesEntry
-	nil,                             // 13: beehive.message.GetUnreadCountsResponse.CountsEntry
+	(*MessageBody)(nil),                    // 0: beehive.message.MessageBody
+	(*SystemPayload)(nil),                  // 1: beehive.message.SystemPayload
+	(*PostMessageRequest)(nil),             // 2: beehive.message.PostMessageRequest
+	(*PostMessageResponse)(nil),            // 3: beehive.message.PostMessageResponse
+	(*GetHistoryRequest)(nil),              // 4: beehive.message.GetHistoryRequest
+	(*MessageRecord)(nil),                  // 5: beehive.message.MessageRecord
+	(*GetHistoryResponse)(nil),             // 6: beehive.message.GetHistoryResponse
+	(*GetLastMessagesRequest)(nil),         // 7: beehive.message.GetLastMessagesRequest
+	(*GetLastMessagesResponse)(nil),        // 8: beehive.message.GetLastMessagesResponse
+	(*MarkReadRequest)(nil),                // 9: beehive.message.MarkReadRequest
+	(*MarkReadResponse)(nil),               // 10: beehive.message.MarkReadResponse
+	(*GetUnreadCountsRequest)(nil),         // 11: beehive.message.GetUnreadCountsRequest
+	(*GetUnreadCountsResponse)(nil),        // 12: beehive.message.GetUnreadCountsResponse
+	(*DeleteForMeRequest)(nil),             // 13: beehive.message.DeleteForMeRequest
+	(*DeleteForMeResponse)(nil),            // 14: beehive.message.DeleteForMeResponse
+	(*ClearHistoryRequest)(nil),            // 15: beehive.message.ClearHistoryRequest
+	(*ClearHistoryResponse)(nil),           // 16: beehive.message.ClearHistoryResponse
+	(*ScheduledMessage)(nil),               // 17: beehive.message.ScheduledMessage
+	(*ListScheduledMessagesRequest)(nil),   // 18: beehive.message.ListScheduledMessagesRequest
+	(*ListScheduledMessagesResponse)(nil),  // 19: beehive.message.ListScheduledMessagesResponse
+	(*CancelScheduledMessageRequest)(nil),  // 20: beehive.message.CancelScheduledMessageRequest
+	(*CancelScheduledMessageResponse)(nil), // 21: beehive.message.CancelScheduledMessageResponse
+	nil,                                    // 22: beehive.message.GetLastMessagesResponse.LastMessagesEntry
+	nil,                                    // 23: beehive.message.GetUnreadCountsResponse.CountsEntry
 }
 var file_proto_message_proto_depIdxs = []int32{
-	0,  // 0: beehive.message.PostMessageRequest.body:type_name -> beehive.message.MessageBody
-	0,  // 1: beehive.message.MessageRecord.body:type_name -> beehive.message.MessageBody
-	4,  // 2: beehive.message.GetHistoryResponse.items:type_name -> beehive.message.MessageRecord
-	12, // 3: beehive.message.GetLastMessagesResponse.last_messages:type_name -> beehive.message.GetLastMessagesResponse.LastMessagesEntry
-	13, // 4: beehive.message.GetUnreadCountsResponse.counts:type_name -> beehive.message.GetUnreadCountsResponse.CountsEntry
-	4,  // 5: beehive.message.GetLastMessagesResponse.LastMessagesEntry.value:type_name -> beehive.message.MessageRecord
-	1,  // 6: beehive.message.MessageService.PostMessage:input_type -> beehive.message.PostMessageRequest
-	3,  // 7: beehive.message.MessageService.GetHistory:input_type -> beehive.message.GetHistoryRequest
-	6,  // 8: beehive.message.MessageService.GetLastMessages:input_type -> beehive.message.GetLastMessagesRequest
-	8,  // 9: beehive.message.MessageService.MarkRead:input_type -> beehive.message.MarkReadRequest
-	10, // 10: beehive.message.MessageService.GetUnreadCounts:input_type -> beehive.message.GetUnreadCountsRequest
-	2,  // 11: beehive.message.MessageService.PostMessage:output_type -> beehive.message.PostMessageResponse
-	5,  // 12: beehive.message.MessageService.GetHistory:output_type -> beehive.message.GetHistoryResponse
-	7,  // 13: beehive.message.MessageService.GetLastMessages:output_type -> beehive.message.GetLastMessagesResponse
-	9,  // 14: beehive.message.MessageService.MarkRead:output_type -> beehive.message.MarkReadResponse
-	11, // 15: beehive.message.MessageService.GetUnreadCounts:output_type -> beehive.message.GetUnreadCountsResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	1,  // 0: beehive.message.MessageBody.system:type_name -> beehive.message.SystemPayload
+	0,  // 1: beehive.message.PostMessageRequest.body:type_name -> beehive.message.MessageBody
+	0,  // 2: beehive.message.MessageRecord.body:type_name -> beehive.message.MessageBody
+	5,  // 3: beehive.message.GetHistoryResponse.items:type_name -> beehive.message.MessageRecord
+	22, // 4: beehive.message.GetLastMessagesResponse.last_messages:type_name -> beehive.message.GetLastMessagesResponse.LastMessagesEntry
+	23, // 5: beehive.message.GetUnreadCountsResponse.counts:type_name -> beehive.message.GetUnreadCountsResponse.CountsEntry
+	0,  // 6: beehive.message.ScheduledMessage.body:type_name -> beehive.message.MessageBody
+	17, // 7: beehive.message.ListScheduledMessagesResponse.items:type_name -> beehive.message.ScheduledMessage
+	5,  // 8: beehive.message.GetLastMessagesResponse.LastMessagesEntry.value:type_name -> beehive.message.MessageRecord
+	2,  // 9: beehive.message.MessageService.PostMessage:input_type -> beehive.message.PostMessageRequest
+	4,  // 10: beehive.message.MessageService.GetHistory:input_type -> beehive.message.GetHistoryRequest
+	7,  // 11: beehive.message.MessageService.GetLastMessages:input_type -> beehive.message.GetLastMessagesRequest
+	9,  // 12: beehive.message.MessageService.MarkRead:input_type -> beehive.message.MarkReadRequest
+	11, // 13: beehive.message.MessageService.GetUnreadCounts:input_type -> beehive.message.GetUnreadCountsRequest
+	13, // 14: beehive.message.MessageService.DeleteForMe:input_type -> beehive.message.DeleteForMeRequest
+	15, // 15: beehive.message.MessageService.ClearHistory:input_type -> beehive.message.ClearHistoryRequest
+	18, // 16: beehive.message.MessageService.ListScheduledMessages:input_type -> beehive.message.ListScheduledMessagesRequest
+	20, // 17: beehive.message.MessageService.CancelScheduledMessage:input_type -> beehive.message.CancelScheduledMessageRequest
+	3,  // 18: beehive.message.MessageService.PostMessage:output_type -> beehive.message.PostMessageResponse
+	6,  // 19: beehive.message.MessageService.GetHistory:output_type -> beehive.message.GetHistoryResponse
+	8,  // 20: beehive.message.MessageService.GetLastMessages:output_type -> beehive.message.GetLastMessagesResponse
+	10, // 21: beehive.message.MessageService.MarkRead:output_type -> beehive.message.MarkReadResponse
+	12, // 22: beehive.message.MessageService.GetUnreadCounts:output_type -> beehive.message.GetUnreadCountsResponse
+	14, // 23: beehive.message.MessageService.DeleteForMe:output_type -> beehive.message.DeleteForMeResponse
+	16, // 24: beehive.message.MessageService.ClearHistory:output_type -> beehive.message.ClearHistoryResponse
+	19, // 25: beehive.message.MessageService.ListScheduledMessages:output_type -> beehive.message.ListScheduledMessagesResponse
+	21, // 26: beehive.message.MessageService.CancelScheduledMessage:output_type -> beehive.message.CancelScheduledMessageResponse
+	18, // [18:27] is the sub-list for method output_type
+	9,  // [9:18] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_message_proto_init() }
@@ -816,7 +1515,7 @@ func file_proto_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_message_proto_rawDesc), len(file_proto_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MessageService_PostMessage_FullMethodName     = "/beehive.message.MessageService/PostMessage"
-	MessageService_GetHistory_FullMethodName      = "/beehive.message.MessageService/GetHistory"
-	MessageService_GetLastMessages_FullMethodName = "/beehive.message.MessageService/GetLastMessages"
-	MessageService_MarkRead_FullMethodName        = "/beehive.message.MessageService/MarkRead"
-	MessageService_GetUnreadCounts_FullMethodName = "/beehive.message.MessageService/GetUnreadCounts"
+	MessageService_PostMessage_FullMethodName            = "/beehive.message.MessageService/PostMessage"
+	MessageService_GetHistory_FullMethodName             = "/beehive.message.MessageService/GetHistory"
+	MessageService_GetLastMessages_FullMethodName        = "/beehive.message.MessageService/GetLastMessages"
+	MessageService_MarkRead_FullMethodName               = "/beehive.message.MessageService/MarkRead"
+	MessageService_GetUnreadCounts_FullMethodName        = "/beehive.message.MessageService/GetUnreadCounts"
+	MessageService_DeleteForMe_FullMethodName            = "/beehive.message.MessageService/DeleteForMe"
+	MessageService_ClearHistory_FullMethodName           = "/beehive.message.MessageService/ClearHistory"
+	MessageService_ListScheduledMessages_FullMethodName  = "/beehive.message.MessageService/ListScheduledMessages"
+	MessageService_CancelScheduledMessage_FullMethodName = "/beehive.message.MessageService/CancelScheduledMessage"
 )
 
 // MessageServiceClient is the client API for MessageService service.
@@ -35,6 +39,14 @@ type MessageServiceClient interface {
 	GetLastMessages(ctx context.Context, in *GetLastMessagesRequest, opts ...grpc.CallOption) (*GetLastMessagesResponse, error)
 	MarkRead(ctx context.Context, in *MarkReadRequest, opts ...grpc.CallOption) (*MarkReadResponse, error)
 	GetUnreadCounts(ctx context.Context, in *GetUnreadCountsRequest, opts ...grpc.CallOption) (*GetUnreadCountsResponse, error)
+	// 仅对自己删除：隐藏若干消息，不影响其他成员
+	DeleteForMe(ctx context.Context, in *DeleteForMeRequest, opts ...grpc.CallOption) (*DeleteForMeResponse, error)
+	// 清空会话历史（仅对自己）：记录清空水位，水位之前的消息对该用户不可见
+	ClearHistory(ctx context.Context, in *ClearHistoryRequest, opts ...grpc.CallOption) (*ClearHistoryResponse, error)
+	// 列出当前用户尚未发出的定时消息
+	ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error)
+	// 取消一条尚未发出的定时消息
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error)
 }
 
 type messageServiceClient struct {
@@ -95,6 +107,46 @@ func (c *messageServiceClient) GetUnreadCounts(ctx context.Context, in *GetUnrea
 	return out, nil
 }
 
+func (c *messageServiceClient) DeleteForMe(ctx context.Context, in *DeleteForMeRequest, opts ...grpc.CallOption) (*DeleteForMeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteForMeResponse)
+	err := c.cc.Invoke(ctx, MessageService_DeleteForMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ClearHistory(ctx context.Context, in *ClearHistoryRequest, opts ...grpc.CallOption) (*ClearHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearHistoryResponse)
+	err := c.cc.Invoke(ctx, MessageService_ClearHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) ListScheduledMessages(ctx context.Context, in *ListScheduledMessagesRequest, opts ...grpc.CallOption) (*ListScheduledMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledMessagesResponse)
+	err := c.cc.Invoke(ctx, MessageService_ListScheduledMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*CancelScheduledMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledMessageResponse)
+	err := c.cc.Invoke(ctx, MessageService_CancelScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility.
@@ -104,6 +156,14 @@ type MessageServiceServer interface {
 	GetLastMessages(context.Context, *GetLastMessagesRequest) (*GetLastMessagesResponse, error)
 	MarkRead(context.Context, *MarkReadRequest) (*MarkReadResponse, error)
 	GetUnreadCounts(context.Context, *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error)
+	// 仅对自己删除：隐藏若干消息，不影响其他成员
+	DeleteForMe(context.Context, *DeleteForMeRequest) (*DeleteForMeResponse, error)
+	// 清空会话历史（仅对自己）：记录清空水位，水位之前的消息对该用户不可见
+	ClearHistory(context.Context, *ClearHistoryRequest) (*ClearHistoryResponse, error)
+	// 列出当前用户尚未发出的定时消息
+	ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error)
+	// 取消一条尚未发出的定时消息
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -129,6 +189,18 @@ func (UnimplementedMessageServiceServer) MarkRead(context.Context, *MarkReadRequ
 func (UnimplementedMessageServiceServer) GetUnreadCounts(context.Context, *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetUnreadCounts not implemented")
 }
+func (UnimplementedMessageServiceServer) DeleteForMe(context.Context, *DeleteForMeRequest) (*DeleteForMeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteForMe not implemented")
+}
+func (UnimplementedMessageServiceServer) ClearHistory(context.Context, *ClearHistoryRequest) (*ClearHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearHistory not implemented")
+}
+func (UnimplementedMessageServiceServer) ListScheduledMessages(context.Context, *ListScheduledMessagesRequest) (*ListScheduledMessagesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*CancelScheduledMessageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 func (UnimplementedMessageServiceServer) testEmbeddedByValue()                        {}
 
@@ -240,6 +312,78 @@ func _MessageService_GetUnreadCounts_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MessageService_DeleteForMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteForMeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).DeleteForMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_DeleteForMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).DeleteForMe(ctx, req.(*DeleteForMeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ClearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ClearHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ClearHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ClearHistory(ctx, req.(*ClearHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_ListScheduledMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).ListScheduledMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_ListScheduledMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).ListScheduledMessages(ctx, req.(*ListScheduledMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MessageService_CancelScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +411,22 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUnreadCounts",
 			Handler:    _MessageService_GetUnreadCounts_Handler,
 		},
+		{
+			MethodName: "DeleteForMe",
+			Handler:    _MessageService_DeleteForMe_Handler,
+		},
+		{
+			MethodName: "ClearHistory",
+			Handler:    _MessageService_ClearHistory_Handler,
+		},
+		{
+			MethodName: "ListScheduledMessages",
+			Handler:    _MessageService_ListScheduledMessages_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _MessageService_CancelScheduledMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/message.proto",
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/config"
+	"github.com/HappyLadySauce/Beehive/services/presence/internal/scheduler"
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/server"
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/presence/pb"
@@ -25,6 +27,11 @@ func main() {
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
 
+	// 后台任务：回收心跳超时的用户并记录最后在线时间，随进程退出停止
+	schedCtx, cancelSched := context.WithCancel(context.Background())
+	defer cancelSched()
+	go scheduler.NewReaper(ctx).Run(schedCtx)
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterPresenceServiceServer(grpcServer, server.NewPresenceServiceServer(ctx))
 
@@ -12,4 +12,16 @@ type Config struct {
 
 	// 会话 TTL（秒），心跳刷新时续期；<=0 时使用默认 90。
 	SessionTTLSeconds int `json:",optional"`
+
+	// 回收心跳超时用户并记录最后在线时间的扫描间隔（秒），<=0 时使用默认 30。
+	LastSeenReapIntervalSeconds int `json:",optional"`
+
+	// UserRpc 为 UserService 的 zrpc 客户端配置；可选，配置后 GetUserPresence 按隐私设置与黑名单对查询者隐藏在线状态，
+	// 用户最后一个会话结束时写入最后在线时间
+	UserRpc zrpc.RpcClientConf `json:",optional"`
+}
+
+// UserRpcConfigured 判断是否已配置 UserService。
+func (c *Config) UserRpcConfigured() bool {
+	return len(c.UserRpc.Endpoints) > 0 || c.UserRpc.Etcd.Key != ""
 }
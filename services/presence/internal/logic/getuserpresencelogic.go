@@ -5,6 +5,7 @@ import (
 
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/presence/pb"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
@@ -29,6 +30,9 @@ func (l *GetUserPresenceLogic) GetUserPresence(in *pb.GetUserPresenceRequest) (*
 	if in.GetUserId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
+	if l.hiddenFromViewer(in.GetUserId(), in.GetViewerId()) {
+		return &pb.GetUserPresenceResponse{Online: false}, nil
+	}
 	sessions, err := getSessionsForUser(l.ctx, l.svcCtx.Redis, in.GetUserId())
 	if err != nil {
 		l.Errorf("get user presence error: %v", err)
@@ -39,3 +43,21 @@ func (l *GetUserPresenceLogic) GetUserPresence(in *pb.GetUserPresenceRequest) (*
 		Sessions: sessions,
 	}, nil
 }
+
+// hiddenFromViewer 目标用户隐私设置 presence_visibility 不允许查询者查看，或任一方拉黑对方时隐藏在线状态；
+// 未指定查询者、查询自己或 UserService 不可用时不隐藏
+func (l *GetUserPresenceLogic) hiddenFromViewer(userID, viewerID string) bool {
+	if viewerID == "" || viewerID == userID || l.svcCtx.UserSvc == nil {
+		return false
+	}
+	resp, err := l.svcCtx.UserSvc.CheckPrivacy(l.ctx, &userservice.CheckPrivacyRequest{
+		ViewerId: viewerID,
+		Action:   "presence",
+		UserIds:  []string{userID},
+	})
+	if err != nil {
+		l.Errorf("check presence privacy failed: %v", err)
+		return false
+	}
+	return len(resp.GetDeniedUserIds()) > 0
+}
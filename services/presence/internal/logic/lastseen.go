@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/presence/internal/session"
+	"github.com/HappyLadySauce/Beehive/services/presence/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// markOffline 用户最后一个会话结束：移出在线用户集合，并通过 UserService 记录最后在线时间（失败只记日志）
+func markOffline(ctx context.Context, svcCtx *svc.ServiceContext, userID string, lastSeenAt int64) {
+	if err := svcCtx.Redis.ZRem(ctx, session.ActiveUsersKey, userID).Err(); err != nil {
+		logx.WithContext(ctx).Errorf("presence: ZREM active user %s failed: %v", userID, err)
+	}
+	if svcCtx.UserSvc == nil {
+		return
+	}
+	if _, err := svcCtx.UserSvc.SetLastSeen(ctx, &userservice.SetLastSeenRequest{UserId: userID, LastSeenAt: lastSeenAt}); err != nil {
+		logx.WithContext(ctx).Errorf("presence: SetLastSeen userId=%s failed: %v", userID, err)
+	}
+}
+
+type ReapOfflineLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReapOfflineLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReapOfflineLogic {
+	return &ReapOfflineLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReapOffline 处理一批超过会话 TTL 未心跳的用户：会话已全部过期的记为离线，最后在线时间取最后一次心跳；
+// 仍有会话的（心跳与扫描交错）把分数刷新为当前时间，移出本轮扫描范围，避免堆在集合头部挡住后面的过期用户。
+// 返回本批扫描的用户数；读取会话失败时中止本批，下一轮再试。
+func (l *ReapOfflineLogic) ReapOffline(limit int) (int, error) {
+	ttl := session.SessionTTL(l.svcCtx.Config.SessionTTLSeconds)
+	now := time.Now().Unix()
+	deadline := now - int64(ttl)
+	list, err := l.svcCtx.Redis.ZRangeByScoreWithScores(l.ctx, session.ActiveUsersKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(deadline, 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+	for _, z := range list {
+		userID, ok := z.Member.(string)
+		if !ok || userID == "" {
+			continue
+		}
+		sessions, err := getSessionsForUser(l.ctx, l.svcCtx.Redis, userID)
+		if err != nil {
+			return 0, err
+		}
+		if len(sessions) > 0 {
+			// XX：期间已被移出集合（最后一个会话结束）时不再加回
+			if err := l.svcCtx.Redis.ZAddXX(l.ctx, session.ActiveUsersKey, redis.Z{Score: float64(now), Member: userID}).Err(); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		markOffline(l.ctx, l.svcCtx, userID, int64(z.Score))
+	}
+	return len(list), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/presence/pb"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -53,6 +54,7 @@ func (l *RefreshSessionLogic) RefreshSession(in *pb.RefreshSessionRequest) (*pb.
 	pipe.HSet(l.ctx, sessionKey, session.HashLastPingAt, strconv.FormatInt(now, 10))
 	pipe.Expire(l.ctx, sessionKey, ttlDur)
 	pipe.Expire(l.ctx, userConnsKey, ttlDur)
+	pipe.ZAdd(l.ctx, session.ActiveUsersKey, redis.Z{Score: float64(now), Member: in.GetUserId()})
 	if _, err := pipe.Exec(l.ctx); err != nil {
 		l.Errorf("refresh session redis error: %v", err)
 		return nil, status.Errorf(codes.Internal, "refresh session failed: %v", err)
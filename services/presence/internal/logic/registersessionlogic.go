@@ -9,6 +9,7 @@ import (
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/presence/pb"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -51,6 +52,7 @@ func (l *RegisterSessionLogic) RegisterSession(in *pb.RegisterSessionRequest) (*
 	)
 	pipe.Expire(l.ctx, sessionKey, ttlDur)
 	pipe.Expire(l.ctx, userConnsKey, ttlDur)
+	pipe.ZAdd(l.ctx, session.ActiveUsersKey, redis.Z{Score: float64(now), Member: in.GetUserId()})
 	_, err := pipe.Exec(l.ctx)
 	if err != nil {
 		l.Errorf("register session redis error: %v", err)
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"time"
 
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/session"
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/svc"
@@ -43,9 +44,10 @@ func (l *UnregisterSessionLogic) UnregisterSession(in *pb.UnregisterSessionReque
 		return nil, status.Errorf(codes.Internal, "unregister session failed: %v", err)
 	}
 
-	// 若该用户已无任何会话，删除空 set，避免残留 key
+	// 若该用户已无任何会话，删除空 set，避免残留 key，并记录最后在线时间
 	if card, e := l.svcCtx.Redis.SCard(l.ctx, userConnsKey).Result(); e == nil && card == 0 {
 		_ = l.svcCtx.Redis.Del(l.ctx, userConnsKey).Err()
+		markOffline(l.ctx, l.svcCtx, in.GetUserId(), time.Now().Unix())
 	}
 
 	return &pb.UnregisterSessionResponse{}, nil
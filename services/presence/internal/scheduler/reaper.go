@@ -0,0 +1,58 @@
+// Package scheduler 提供 Presence 服务的后台任务：回收心跳超时的用户并记录最后在线时间。
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/presence/internal/logic"
+	"github.com/HappyLadySauce/Beehive/services/presence/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// batchSize 每批扫描的用户数；一批扫满时立即继续下一批，避免积压。
+const batchSize = 100
+
+// Reaper 周期性找出会话已全部过期（未主动断开、心跳超时）的用户，记录其最后在线时间。
+// 多副本同时运行时可能重复写入同一用户，最后在线时间只会前移，不影响正确性。
+type Reaper struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+}
+
+// NewReaper 按配置的间隔创建回收任务，未配置时默认 30 秒。
+func NewReaper(svcCtx *svc.ServiceContext) *Reaper {
+	interval := time.Duration(svcCtx.Config.LastSeenReapIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &Reaper{svcCtx: svcCtx, interval: interval}
+}
+
+// Run 阻塞运行直到 ctx 取消。
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.reapAll(ctx)
+		}
+	}
+}
+
+func (r *Reaper) reapAll(ctx context.Context) {
+	l := logic.NewReapOfflineLogic(ctx, r.svcCtx)
+	for ctx.Err() == nil {
+		n, err := l.ReapOffline(batchSize)
+		if err != nil {
+			logx.Errorf("reaper: reap offline users failed: %v", err)
+			return
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
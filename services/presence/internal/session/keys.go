@@ -6,6 +6,8 @@ const (
 	userConnsKeyPrefix = "presence:user:"
 	userConnsKeySuffix = ":conns"
 	sessionKeyPrefix   = "presence:session:"
+	// ActiveUsersKey 有在线会话的用户 ZSET，score 为最近一次注册或心跳的 Unix 秒；Reaper 据此找出会话已全部过期的用户
+	ActiveUsersKey = "presence:active"
 )
 
 // UserConnsKey 返回用户会话 connId 集合的 key：presence:user:{userId}:conns
@@ -4,12 +4,16 @@ import (
 	"context"
 
 	"github.com/HappyLadySauce/Beehive/services/presence/internal/config"
+	"github.com/HappyLadySauce/Beehive/services/user/userservice"
 	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
 	Config config.Config
 	Redis  *redis.Client
+	// UserSvc 用于在线状态的隐私设置与黑名单过滤及写入最后在线时间；未配置时为 nil，不过滤也不记录
+	UserSvc userservice.UserService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -21,8 +25,13 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	if err := rdb.Ping(context.Background()).Err(); err != nil {
 		panic(err)
 	}
+	var userSvc userservice.UserService
+	if c.UserRpcConfigured() {
+		userSvc = userservice.NewUserService(zrpc.MustNewClient(c.UserRpc))
+	}
 	return &ServiceContext{
-		Config: c,
-		Redis:  rdb,
+		Config:  c,
+		Redis:   rdb,
+		UserSvc: userSvc,
 	}
 }
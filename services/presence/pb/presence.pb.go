@@ -482,8 +482,10 @@ func (x *GetOnlineSessionsResponse) GetSessions() []*SessionInfo {
 }
 
 type GetUserPresenceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 查询者；非空时按目标用户隐私设置 presence_visibility 与黑名单校验，不允许查看时返回离线
+	ViewerId      string `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -525,6 +527,13 @@ func (x *GetUserPresenceRequest) GetUserId() string {
 	return ""
 }
 
+func (x *GetUserPresenceRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
 type GetUserPresenceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Online        bool                   `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
@@ -612,9 +621,10 @@ const file_proto_presence_proto_rawDesc = "" +
 	"\flast_ping_at\x18\x05 \x01(\x03R\n" +
 	"lastPingAt\"V\n" +
 	"\x19GetOnlineSessionsResponse\x129\n" +
-	"\bsessions\x18\x01 \x03(\v2\x1d.beehive.presence.SessionInfoR\bsessions\"1\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1d.beehive.presence.SessionInfoR\bsessions\"N\n" +
 	"\x16GetUserPresenceRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"l\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"l\n" +
 	"\x17GetUserPresenceResponse\x12\x16\n" +
 	"\x06online\x18\x01 \x01(\bR\x06online\x129\n" +
 	"\bsessions\x18\x02 \x03(\v2\x1d.beehive.presence.SessionInfoR\bsessions2\xa2\x04\n" +
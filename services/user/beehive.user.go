@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 
 	"github.com/HappyLadySauce/Beehive/services/user/internal/config"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/scheduler"
 	"github.com/HappyLadySauce/Beehive/services/user/internal/server"
 	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/user/pb"
@@ -25,6 +27,13 @@ func main() {
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
 
+	// 后台任务：定期重算好友推荐、清除到期注销的账号、生成个人数据导出，随进程退出停止
+	schedCtx, cancelSched := context.WithCancel(context.Background())
+	defer cancelSched()
+	go scheduler.NewSuggestionRefresher(ctx).Run(schedCtx)
+	go scheduler.NewAccountPurger(ctx).Run(schedCtx)
+	go scheduler.NewDataExportWorker(ctx).Run(schedCtx)
+
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		pb.RegisterUserServiceServer(grpcServer, server.NewUserServiceServer(ctx))
 
@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"time"
 
 	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/user/pb"
@@ -25,7 +26,7 @@ func (l *AcceptContactRequestLogic) AcceptContactRequest(in *pb.AcceptContactReq
 	if in.GetUserId() == "" || in.GetRequestId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id and request_id are required")
 	}
-	err := l.svcCtx.ContactRequestMod.Accept(in.GetRequestId(), in.GetUserId())
+	req, err := l.svcCtx.ContactRequestMod.Accept(in.GetRequestId(), in.GetUserId())
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, status.Error(codes.NotFound, "request not found or not pending")
@@ -33,5 +34,15 @@ func (l *AcceptContactRequestLogic) AcceptContactRequest(in *pb.AcceptContactReq
 		l.Errorf("accept contact request failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "accept contact request failed: %v", err)
 	}
+	// 通知发起方申请已通过
+	if err := l.svcCtx.MQ.PublishJSON("contact.request.accepted", map[string]interface{}{
+		"userId":     req.FromUserID,
+		"requestId":  req.ID,
+		"fromUserId": req.FromUserID,
+		"toUserId":   req.ToUserID,
+		"acceptedAt": time.Now().Unix(),
+	}); err != nil {
+		l.Errorf("publish contact.request.accepted failed: %v", err)
+	}
 	return &pb.AcceptContactRequestResponse{}, nil
 }
@@ -36,7 +36,9 @@ func NewBatchGetUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Bat
 // BatchGetUsers 批量获取用户基础资料。
 // - 如果请求中没有任何 ID，则直接返回空列表；
 // - 有 ID 时先做去重与顺序整理，再批量从 Redis 读取；
-// - 缓存未命中的 ID 再从数据库中补齐，并把结果写回 Redis 做缓存。
+// - 缓存未命中的 ID 再从数据库中补齐，并把结果写回 Redis 做缓存；
+// - 携带 viewer_id 时按各用户 presence_visibility 隐藏最后在线时间与自定义状态；
+// - known_versions 中版本与当前一致的用户不返回资料，只列入 unchanged_ids。
 func (l *BatchGetUsersLogic) BatchGetUsers(in *pb.BatchGetUsersRequest) (*pb.BatchGetUsersResponse, error) {
 	if len(in.GetIds()) == 0 {
 		return &pb.BatchGetUsersResponse{Users: []*pb.User{}}, nil
@@ -134,11 +136,20 @@ func (l *BatchGetUsersLogic) BatchGetUsers(in *pb.BatchGetUsersRequest) (*pb.Bat
 	//    - 使用 ids（已经去重且保持顺序）驱动遍历；
 	//    - 只有在 usersByID 中存在的 ID 才会被追加到结果列表中。
 	result := make([]*pb.User, 0, len(ids))
+	var unchanged []string
 	for _, id := range ids {
 		if u, ok := usersByID[id]; ok {
+			if v := in.GetKnownVersions()[id]; v != 0 && v == u.GetVersion() {
+				unchanged = append(unchanged, id)
+				continue
+			}
+			if err := applyPresenceVisibility(ctx, l.svcCtx, u, in.GetViewerId()); err != nil {
+				l.Errorf("check presence privacy failed: %v", err)
+				return nil, status.Errorf(codes.Internal, "check privacy failed: %v", err)
+			}
 			result = append(result, u)
 		}
 	}
 
-	return &pb.BatchGetUsersResponse{Users: result}, nil
+	return &pb.BatchGetUsersResponse{Users: result, UnchangedIds: unchanged}, nil
 }
@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// blockCacheLoaded 黑名单集合中的占位成员，表示该用户的黑名单已整份写入缓存（空名单也会缓存，避免反复回源）
+const blockCacheLoaded = "~"
+
+// blockKey 与 "user:profile:" 同级，为 Redis 集合，成员为被拉黑的用户 ID
+func blockKey(userID string) string {
+	return fmt.Sprintf("user:blocks:%s", userID)
+}
+
+// blockVersionKey 黑名单版本号，每次拉黑/解除拉黑后递增；回写缓存时 WATCH 该 key，期间有写入则放弃回写
+func blockVersionKey(userID string) string {
+	return fmt.Sprintf("user:blocks_ver:%s", userID)
+}
+
+// hasBlocked 判断 blockerID 是否拉黑了 targetID：先查 Redis 集合，未缓存时从 PostgreSQL 读出整份黑名单回写后再判断。
+// 回写在 WATCH 版本号的事务中进行，读库期间黑名单被修改时放弃回写，避免旧名单覆盖删除缓存后的结果。
+// Redis 不可用时直接查库，不影响正确性。
+func hasBlocked(ctx context.Context, svcCtx *svc.ServiceContext, blockerID, targetID string) (bool, error) {
+	key := blockKey(blockerID)
+	var exists *redis.IntCmd
+	var member *redis.BoolCmd
+	_, err := svcCtx.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		exists = p.Exists(ctx, key)
+		member = p.SIsMember(ctx, key, targetID)
+		return nil
+	})
+	if err == nil && exists.Val() > 0 {
+		return member.Val(), nil
+	}
+	ttl := svcCtx.Config.UserProfileTTLSeconds
+	if ttl <= 0 {
+		ttl = 600
+	}
+	var (
+		ids     []string
+		loaded  bool
+		loadErr error
+	)
+	load := func(tx *redis.Tx) error {
+		ids, loadErr = svcCtx.UserBlockMod.ListBlockedIDs(blockerID)
+		if loadErr != nil {
+			return loadErr
+		}
+		loaded = true
+		members := make([]interface{}, 0, len(ids)+1)
+		members = append(members, blockCacheLoaded)
+		for _, id := range ids {
+			members = append(members, id)
+		}
+		_, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Del(ctx, key)
+			p.SAdd(ctx, key, members...)
+			p.Expire(ctx, key, time.Duration(ttl)*time.Second)
+			return nil
+		})
+		return err
+	}
+	if err := svcCtx.Redis.Watch(ctx, load, blockVersionKey(blockerID)); err != nil && loadErr == nil && err != redis.TxFailedErr {
+		logx.WithContext(ctx).Errorf("redis cache %s error: %v", key, err)
+	}
+	if loadErr != nil {
+		return false, loadErr
+	}
+	if !loaded {
+		// Redis 不可用，WATCH 未执行 load，直接查库
+		if ids, err = svcCtx.UserBlockMod.ListBlockedIDs(blockerID); err != nil {
+			return false, err
+		}
+	}
+	for _, id := range ids {
+		if id == targetID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// invalidateBlocks 拉黑/解除拉黑提交后递增版本号并删除缓存，下次读取时重新加载；
+// 递增版本号使并发中、已读到旧名单的回写失败
+func invalidateBlocks(ctx context.Context, svcCtx *svc.ServiceContext, blockerID string) {
+	key := blockKey(blockerID)
+	if _, err := svcCtx.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.Incr(ctx, blockVersionKey(blockerID))
+		p.Del(ctx, key)
+		return nil
+	}); err != nil {
+		logx.WithContext(ctx).Errorf("redis DEL %s error: %v", key, err)
+	}
+}
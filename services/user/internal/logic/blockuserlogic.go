@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type BlockUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockUserLogic {
+	return &BlockUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BlockUser 拉黑用户，重复拉黑视为成功；不删除联系人关系，解除拉黑后恢复正常
+func (l *BlockUserLogic) BlockUser(in *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	if in.GetUserId() == "" || in.GetTargetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and target_user_id are required")
+	}
+	if in.GetUserId() == in.GetTargetUserId() {
+		return nil, status.Error(codes.InvalidArgument, "cannot block self")
+	}
+	if _, err := l.svcCtx.UserMod.FindByID(in.GetTargetUserId()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "target user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	if err := l.svcCtx.UserBlockMod.Block(in.GetUserId(), in.GetTargetUserId()); err != nil {
+		l.Errorf("block user failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "block user failed: %v", err)
+	}
+	invalidateBlocks(l.ctx, l.svcCtx, in.GetUserId())
+	return &pb.BlockUserResponse{}, nil
+}
@@ -0,0 +1,253 @@
+package logic
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	// dataExportStaleAfter running 超过该时间仍未完成的任务视为执行副本已退出，可被重新领取
+	dataExportStaleAfter = 30 * time.Minute
+	// dataExportMessageBatch 导出消息时每次读取的条数
+	dataExportMessageBatch = 1000
+	// dataExportStoreChunk 归档写入 user_data_export_chunks 时每个分块的字节数
+	dataExportStoreChunk = 1 << 20
+)
+
+type BuildDataExportsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBuildDataExportsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BuildDataExportsLogic {
+	return &BuildDataExportsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BuildPending 依次领取并生成至多 limit 个导出归档，返回本批成功生成的个数；
+// 单个任务失败时标记为 failed 并记录错误信息，不影响其他任务
+func (l *BuildDataExportsLogic) BuildPending(limit int) (int, error) {
+	n := 0
+	for i := 0; i < limit && l.ctx.Err() == nil; i++ {
+		e, err := l.svcCtx.ExportMod.Claim(dataExportStaleAfter)
+		if err == gorm.ErrRecordNotFound {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+		f, err := l.build(e)
+		if err != nil {
+			l.Errorf("build data export failed id=%s userId=%s: %v", e.ID, e.UserID, err)
+			if err := l.svcCtx.ExportMod.MarkFailed(e.ID, err.Error()); err != nil {
+				l.Errorf("mark data export failed id=%s: %v", e.ID, err)
+			}
+			continue
+		}
+		ttl := time.Duration(l.svcCtx.Config.DataExportTTLSeconds) * time.Second
+		_, err = l.svcCtx.ExportMod.MarkReady(e.ID, f, dataExportStoreChunk, time.Now().Add(ttl))
+		l.removeTemp(f)
+		if err != nil {
+			// 保持 running，超过 dataExportStaleAfter 后会被重新领取
+			l.Errorf("store data export id=%s: %v", e.ID, err)
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+// CleanupExpired 删除一批已过期的导出任务及其归档，返回删除的任务数
+func (l *BuildDataExportsLogic) CleanupExpired(limit int) (int, error) {
+	n, err := l.svcCtx.ExportMod.DeleteExpired(time.Now(), limit)
+	return int(n), err
+}
+
+// build 将 zip 归档写入本地临时文件并返回读位置在开头的文件，由调用方写入数据库后删除。归档内容：
+// profile.json（账号、资料、隐私设置）、contacts.json、blocked.json、conversations.json、
+// messages.jsonl（本人发送的消息，每行一条，按发送时间正序）
+func (l *BuildDataExportsLogic) build(e *model.DataExport) (*os.File, error) {
+	f, err := os.CreateTemp("", "beehive-export-*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("create archive: %w", err)
+	}
+	if err := l.writeArchive(zip.NewWriter(f), e.UserID); err != nil {
+		l.removeTemp(f)
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		l.removeTemp(f)
+		return nil, fmt.Errorf("rewind archive: %w", err)
+	}
+	return f, nil
+}
+
+// removeTemp 关闭并删除临时归档文件
+func (l *BuildDataExportsLogic) removeTemp(f *os.File) {
+	_ = f.Close()
+	if err := os.Remove(f.Name()); err != nil && !os.IsNotExist(err) {
+		l.Errorf("remove temp archive %s failed: %v", f.Name(), err)
+	}
+}
+
+func (l *BuildDataExportsLogic) writeArchive(zw *zip.Writer, userID string) error {
+	if err := l.writeProfile(zw, userID); err != nil {
+		return err
+	}
+	if err := l.writeContacts(zw, userID); err != nil {
+		return err
+	}
+	conversations, err := l.svcCtx.ExportMod.ListConversations(userID)
+	if err != nil {
+		return fmt.Errorf("list conversations: %w", err)
+	}
+	if err := writeZipJSON(zw, "conversations.json", conversations); err != nil {
+		return err
+	}
+	if err := l.writeMessages(zw, userID); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func (l *BuildDataExportsLogic) writeProfile(zw *zip.Writer, userID string) error {
+	usr, err := l.svcCtx.UserMod.FindByID(userID)
+	if err != nil {
+		return fmt.Errorf("find user: %w", err)
+	}
+	account := map[string]interface{}{
+		"id":        usr.ID,
+		"username":  usr.Username,
+		"status":    usr.Status,
+		"createdAt": usr.CreatedAt.Unix(),
+	}
+	if usr.Email != nil {
+		account["email"] = *usr.Email
+	}
+	if usr.Phone != nil {
+		account["phone"] = *usr.Phone
+	}
+	out := map[string]interface{}{
+		"exportedAt": time.Now().Unix(),
+		"user":       account,
+	}
+	p, err := l.svcCtx.UserProfileMod.FindByID(userID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return fmt.Errorf("find profile: %w", err)
+	}
+	if p != nil {
+		profile := map[string]interface{}{
+			"nickname":     p.Nickname,
+			"avatarUrl":    p.AvatarURL,
+			"bio":          p.Bio,
+			"status":       p.Status,
+			"discoverable": p.Discoverable,
+			"updatedAt":    p.UpdatedAt.Unix(),
+		}
+		if p.LastSeenAt != nil {
+			profile["lastSeenAt"] = p.LastSeenAt.Unix()
+		}
+		if p.CustomStatusEmoji != "" || p.CustomStatusText != "" {
+			profile["customStatus"] = map[string]interface{}{"emoji": p.CustomStatusEmoji, "text": p.CustomStatusText}
+		}
+		out["profile"] = profile
+	}
+	ps, err := l.svcCtx.UserPrivacyMod.FindByUserID(userID)
+	if err != nil {
+		return fmt.Errorf("find privacy settings: %w", err)
+	}
+	out["privacy"] = map[string]interface{}{
+		"findBy":             ps.FindBy,
+		"contactRequest":     ps.ContactRequest,
+		"groupAdd":           ps.GroupAdd,
+		"presenceVisibility": ps.PresenceVisibility,
+	}
+	return writeZipJSON(zw, "profile.json", out)
+}
+
+func (l *BuildDataExportsLogic) writeContacts(zw *zip.Writer, userID string) error {
+	contacts, err := l.svcCtx.ExportMod.ListContacts(userID)
+	if err != nil {
+		return fmt.Errorf("list contacts: %w", err)
+	}
+	items := make([]map[string]interface{}, 0, len(contacts))
+	for _, c := range contacts {
+		items = append(items, map[string]interface{}{
+			"userId":    c.ContactUserID,
+			"remark":    c.Remark,
+			"tags":      []string(c.Tags),
+			"starred":   c.Starred,
+			"note":      c.Note,
+			"createdAt": c.CreatedAt.Unix(),
+		})
+	}
+	if err := writeZipJSON(zw, "contacts.json", items); err != nil {
+		return err
+	}
+	blocked, err := l.svcCtx.ExportMod.ListBlocked(userID)
+	if err != nil {
+		return fmt.Errorf("list blocked: %w", err)
+	}
+	blockedItems := make([]map[string]interface{}, 0, len(blocked))
+	for _, b := range blocked {
+		blockedItems = append(blockedItems, map[string]interface{}{"userId": b.BlockedID, "createdAt": b.CreatedAt.Unix()})
+	}
+	return writeZipJSON(zw, "blocked.json", blockedItems)
+}
+
+// writeMessages 按游标分批读取，消息量大时不需要一次性加载到内存
+func (l *BuildDataExportsLogic) writeMessages(zw *zip.Writer, userID string) error {
+	w, err := zw.Create("messages.jsonl")
+	if err != nil {
+		return fmt.Errorf("create messages.jsonl: %w", err)
+	}
+	enc := json.NewEncoder(w)
+	var afterTime int64
+	var afterID string
+	for {
+		if err := l.ctx.Err(); err != nil {
+			return err
+		}
+		list, err := l.svcCtx.ExportMod.ListMessages(userID, afterTime, afterID, dataExportMessageBatch)
+		if err != nil {
+			return fmt.Errorf("list messages: %w", err)
+		}
+		for _, m := range list {
+			if err := enc.Encode(m); err != nil {
+				return fmt.Errorf("write messages.jsonl: %w", err)
+			}
+		}
+		if len(list) < dataExportMessageBatch {
+			return nil
+		}
+		last := list[len(list)-1]
+		afterTime, afterID = last.ServerTime, last.ID
+	}
+}
+
+func writeZipJSON(zw *zip.Writer, name string, v interface{}) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type CancelAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAccountDeletionLogic {
+	return &CancelAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CancelAccountDeletion 在宽限期内撤销注销申请；没有待处理的申请（含已被清除）时返回 NotFound
+func (l *CancelAccountDeletionLogic) CancelAccountDeletion(in *pb.CancelAccountDeletionRequest) (*pb.CancelAccountDeletionResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := l.svcCtx.DeletionMod.Cancel(in.GetUserId()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "no pending account deletion")
+		}
+		l.Errorf("cancel account deletion failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "cancel account deletion failed: %v", err)
+	}
+	return &pb.CancelAccountDeletionResponse{}, nil
+}
@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CheckBlockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckBlockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckBlockLogic {
+	return &CheckBlockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CheckBlock 返回两个方向的拉黑关系，命中缓存时不访问数据库
+func (l *CheckBlockLogic) CheckBlock(in *pb.CheckBlockRequest) (*pb.CheckBlockResponse, error) {
+	if in.GetUserId() == "" || in.GetPeerId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and peer_id are required")
+	}
+	byUser, byPeer, err := blockRelation(l.ctx, l.svcCtx, in.GetUserId(), in.GetPeerId())
+	if err != nil {
+		l.Errorf("check block failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "check block failed: %v", err)
+	}
+	return &pb.CheckBlockResponse{BlockedByUser: byUser, BlockedByPeer: byPeer}, nil
+}
+
+// blockRelation 返回 userID 是否拉黑了 peerID、peerID 是否拉黑了 userID
+func blockRelation(ctx context.Context, svcCtx *svc.ServiceContext, userID, peerID string) (byUser, byPeer bool, err error) {
+	if byUser, err = hasBlocked(ctx, svcCtx, userID, peerID); err != nil {
+		return false, false, err
+	}
+	if byPeer, err = hasBlocked(ctx, svcCtx, peerID, userID); err != nil {
+		return false, false, err
+	}
+	return byUser, byPeer, nil
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxCheckPrivacyUsers 单次 CheckPrivacy 最多校验的用户数，与群聊最大档位上限一致
+const maxCheckPrivacyUsers = 10000
+
+type CheckPrivacyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckPrivacyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckPrivacyLogic {
+	return &CheckPrivacyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CheckPrivacy 返回 user_ids 中不允许 viewer 执行 action 的用户
+func (l *CheckPrivacyLogic) CheckPrivacy(in *pb.CheckPrivacyRequest) (*pb.CheckPrivacyResponse, error) {
+	if in.GetViewerId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "viewer_id is required")
+	}
+	switch in.GetAction() {
+	case privacyActionFind, privacyActionContactRequest, privacyActionGroupAdd, privacyActionPresence:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid action: %s", in.GetAction())
+	}
+	if len(in.GetUserIds()) > maxCheckPrivacyUsers {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d user_ids", maxCheckPrivacyUsers)
+	}
+	denied := make([]string, 0)
+	for _, id := range in.GetUserIds() {
+		ok, err := privacyAllows(l.ctx, l.svcCtx, id, in.GetViewerId(), in.GetAction())
+		if err != nil {
+			l.Errorf("check privacy failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "check privacy failed: %v", err)
+		}
+		if !ok {
+			denied = append(denied, id)
+		}
+	}
+	return &pb.CheckPrivacyResponse{DeniedUserIds: denied}, nil
+}
@@ -2,7 +2,9 @@ package logic
 
 import (
 	"context"
+	"time"
 
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/user/pb"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -36,6 +38,25 @@ func (l *CreateContactRequestLogic) CreateContactRequest(in *pb.CreateContactReq
 		}
 		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
 	}
+	// 任一方拉黑对方时不能发申请；被对方拉黑时不提示具体原因
+	byUser, byPeer, err := blockRelation(l.ctx, l.svcCtx, in.GetFromUserId(), in.GetToUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "check block failed: %v", err)
+	}
+	if byUser {
+		return nil, status.Error(codes.FailedPrecondition, "you have blocked this user")
+	}
+	if byPeer {
+		return nil, status.Error(codes.PermissionDenied, "cannot send request to this user")
+	}
+	// 对方隐私设置 contact_request 不允许时拒绝
+	allowed, err := privacyAllows(l.ctx, l.svcCtx, in.GetToUserId(), in.GetFromUserId(), privacyActionContactRequest)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "check privacy failed: %v", err)
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "this user does not accept contact requests")
+	}
 	// 已是好友则不再发申请
 	ok, err := l.svcCtx.ContactMod.Exists(in.GetFromUserId(), in.GetToUserId())
 	if err != nil {
@@ -44,10 +65,32 @@ func (l *CreateContactRequestLogic) CreateContactRequest(in *pb.CreateContactReq
 	if ok {
 		return nil, status.Error(codes.AlreadyExists, "already contacts")
 	}
-	req, err := l.svcCtx.ContactRequestMod.CreateOrReapply(in.GetFromUserId(), in.GetToUserId(), in.GetMessage())
+	ttl := time.Duration(l.svcCtx.Config.ContactRequestTTLSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = 7 * 24 * time.Hour
+	}
+	cooldown := time.Duration(l.svcCtx.Config.ContactRequestCooldownSeconds) * time.Second
+	req, created, err := l.svcCtx.ContactRequestMod.Create(in.GetFromUserId(), in.GetToUserId(), in.GetMessage(), ttl, cooldown)
 	if err != nil {
+		if err == model.ErrContactRequestCooldown {
+			return nil, status.Error(codes.ResourceExhausted, "please wait before sending another request to this user")
+		}
 		l.Errorf("create contact request failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "create contact request failed: %v", err)
 	}
-	return &pb.CreateContactRequestResponse{RequestId: req.ID}, nil
+	// 重复申请返回原申请，不重复通知对方
+	if created {
+		if err := l.svcCtx.MQ.PublishJSON("contact.request.received", map[string]interface{}{
+			"userId":     req.ToUserID,
+			"requestId":  req.ID,
+			"fromUserId": req.FromUserID,
+			"toUserId":   req.ToUserID,
+			"message":    req.Message,
+			"createdAt":  req.CreatedAt.Unix(),
+			"expiresAt":  req.ExpiresAt.Unix(),
+		}); err != nil {
+			l.Errorf("publish contact.request.received failed: %v", err)
+		}
+	}
+	return &pb.CreateContactRequestResponse{RequestId: req.ID, ExpiresAt: req.ExpiresAt.Unix()}, nil
 }
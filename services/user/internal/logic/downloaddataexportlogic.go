@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// dataExportChunkSize 下载时每个分块的字节数，远小于 gRPC 默认 4MB 消息上限
+const dataExportChunkSize = 64 * 1024
+
+type DownloadDataExportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDownloadDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DownloadDataExportLogic {
+	return &DownloadDataExportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DownloadDataExport 从数据库逐块读取已就绪的导出归档并分块发送，任意副本都能处理；任务未就绪返回 FailedPrecondition，已过期返回 NotFound
+func (l *DownloadDataExportLogic) DownloadDataExport(in *pb.DownloadDataExportRequest, stream pb.UserService_DownloadDataExportServer) error {
+	e, err := findDataExport(l.svcCtx, in.GetUserId(), in.GetExportId())
+	if err != nil {
+		if status.Code(err) == codes.Internal {
+			l.Errorf("%v", err)
+		}
+		return err
+	}
+	if e.Status != model.DataExportReady {
+		return status.Error(codes.FailedPrecondition, "export is not ready")
+	}
+	if e.ExpiresAt != nil && !e.ExpiresAt.After(time.Now()) {
+		return status.Error(codes.NotFound, "export not found")
+	}
+	for seq := 0; seq < e.ChunkCount; seq++ {
+		if err := l.ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		data, err := l.svcCtx.ExportMod.Chunk(e.ID, seq)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				// 读取期间任务过期被清理
+				return status.Error(codes.NotFound, "export not found")
+			}
+			l.Errorf("read data export failed: %v", err)
+			return status.Errorf(codes.Internal, "read data export failed: %v", err)
+		}
+		for len(data) > 0 {
+			n := min(len(data), dataExportChunkSize)
+			if err := stream.Send(&pb.DataExportChunk{Data: data[:n]}); err != nil {
+				return err
+			}
+			data = data[n:]
+		}
+	}
+	return nil
+}
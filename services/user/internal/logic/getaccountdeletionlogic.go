@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GetAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAccountDeletionLogic {
+	return &GetAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetAccountDeletion 查询待处理的注销申请，没有时 deletion 为空
+func (l *GetAccountDeletionLogic) GetAccountDeletion(in *pb.GetAccountDeletionRequest) (*pb.GetAccountDeletionResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	d, err := l.svcCtx.DeletionMod.Find(in.GetUserId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &pb.GetAccountDeletionResponse{}, nil
+		}
+		l.Errorf("find account deletion failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "find account deletion failed: %v", err)
+	}
+	return &pb.GetAccountDeletionResponse{Deletion: toProtoAccountDeletion(d)}, nil
+}
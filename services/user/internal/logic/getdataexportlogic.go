@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GetDataExportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDataExportLogic {
+	return &GetDataExportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetDataExport 查询导出任务状态；任务不属于该用户或已过期清理时返回 NotFound
+func (l *GetDataExportLogic) GetDataExport(in *pb.GetDataExportRequest) (*pb.GetDataExportResponse, error) {
+	e, err := findDataExport(l.svcCtx, in.GetUserId(), in.GetExportId())
+	if err != nil {
+		if status.Code(err) == codes.Internal {
+			l.Errorf("%v", err)
+		}
+		return nil, err
+	}
+	return &pb.GetDataExportResponse{Export: toProtoDataExport(e)}, nil
+}
+
+// findDataExport 按用户与任务 ID 查询导出任务，错误已转换为 gRPC status
+func findDataExport(svcCtx *svc.ServiceContext, userID, exportID string) (*model.DataExport, error) {
+	if userID == "" || exportID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and export_id are required")
+	}
+	if _, err := uuid.Parse(exportID); err != nil {
+		return nil, status.Error(codes.NotFound, "export not found")
+	}
+	e, err := svcCtx.ExportMod.Find(userID, exportID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "export not found")
+		}
+		return nil, status.Errorf(codes.Internal, "find data export failed: %v", err)
+	}
+	return e, nil
+}
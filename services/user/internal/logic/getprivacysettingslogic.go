@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GetPrivacySettingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPrivacySettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPrivacySettingsLogic {
+	return &GetPrivacySettingsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetPrivacySettings 返回用户的隐私设置，未设置过时返回默认值
+func (l *GetPrivacySettingsLogic) GetPrivacySettings(in *pb.GetPrivacySettingsRequest) (*pb.GetPrivacySettingsResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	settings, err := privacySettings(l.ctx, l.svcCtx, in.GetUserId())
+	if err != nil {
+		l.Errorf("get privacy settings failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "get privacy settings failed: %v", err)
+	}
+	return &pb.GetPrivacySettingsResponse{Settings: settings}, nil
+}
+
+// privacySettings 组合 user_privacy_settings 与 user_profiles.discoverable；没有 profile 的用户视为允许被搜索
+func privacySettings(ctx context.Context, svcCtx *svc.ServiceContext, userID string) (*pb.PrivacySettings, error) {
+	p, err := loadPrivacy(ctx, svcCtx, userID)
+	if err != nil {
+		return nil, err
+	}
+	discoverable := true
+	profile, err := svcCtx.UserProfileMod.FindByID(userID)
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	if err == nil {
+		discoverable = profile.Discoverable
+	}
+	return toProtoPrivacy(p, discoverable), nil
+}
+
+func toProtoPrivacy(p *model.UserPrivacy, discoverable bool) *pb.PrivacySettings {
+	return &pb.PrivacySettings{
+		FindBy:             p.FindBy,
+		ContactRequest:     p.ContactRequest,
+		GroupAdd:           p.GroupAdd,
+		PresenceVisibility: p.PresenceVisibility,
+		Discoverable:       discoverable,
+	}
+}
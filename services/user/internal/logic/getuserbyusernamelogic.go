@@ -38,5 +38,5 @@ func (l *GetUserByUsernameLogic) GetUserByUsername(in *pb.GetUserByUsernameReque
 		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
 	}
 	// 复用 GetUser 逻辑：按 id 查 profile 并返回
-	return NewGetUserLogic(l.ctx, l.svcCtx).GetUser(&pb.GetUserRequest{Id: u.ID})
+	return NewGetUserLogic(l.ctx, l.svcCtx).GetUser(&pb.GetUserRequest{Id: u.ID, ViewerId: in.GetViewerId()})
 }
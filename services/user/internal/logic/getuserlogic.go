@@ -38,11 +38,24 @@ func NewGetUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserLo
 // GetUser 查询单个用户的公开资料。
 // - 入参要求提供用户 ID，ID 为空时直接返回 InvalidArgument 错误；
 // - 先尝试从 Redis 读取并反序列化，如果成功则直接返回；
-// - 如果缓存不存在或内容异常，再从 PostgreSQL 查询，并把查询结果写入缓存。
+// - 如果缓存不存在或内容异常，再从 PostgreSQL 查询，并把查询结果写入缓存；
+// - 携带 viewer_id 时按对方隐私设置 find_by 校验，不允许查找时与用户不存在一样返回 NotFound；
+// - 返回前按 presence_visibility 对查询者隐藏最后在线时间与自定义状态，缓存中保存的是未过滤的资料；
+// - 携带 if_version 且与当前资料版本一致时只返回 not_modified。
 func (l *GetUserLogic) GetUser(in *pb.GetUserRequest) (*pb.GetUserResponse, error) {
 	if in.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
+	if in.GetViewerId() != "" {
+		ok, err := privacyAllows(l.ctx, l.svcCtx, in.GetId(), in.GetViewerId(), privacyActionFind)
+		if err != nil {
+			l.Errorf("check privacy failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "check privacy failed: %v", err)
+		}
+		if !ok {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+	}
 
 	ctx := l.ctx
 	id := in.GetId()
@@ -55,7 +68,7 @@ func (l *GetUserLogic) GetUser(in *pb.GetUserRequest) (*pb.GetUserResponse, erro
 	if err == nil && len(val) > 0 {
 		var u pb.User
 		if e := json.Unmarshal(val, &u); e == nil {
-			return &pb.GetUserResponse{User: &u}, nil
+			return l.response(&u, in)
 		}
 	}
 	if err != nil && err != redis.Nil {
@@ -103,15 +116,39 @@ func (l *GetUserLogic) GetUser(in *pb.GetUserRequest) (*pb.GetUserResponse, erro
 		l.Errorf("marshal user profile for cache failed: %v", e)
 	}
 
+	return l.response(u, in)
+}
+
+// response 资料版本未变化时返回 not_modified，否则按查询者处理最后在线时间与自定义状态后返回
+func (l *GetUserLogic) response(u *pb.User, in *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if in.GetIfVersion() != 0 && u.GetVersion() == in.GetIfVersion() {
+		return &pb.GetUserResponse{NotModified: true}, nil
+	}
+	if err := applyPresenceVisibility(l.ctx, l.svcCtx, u, in.GetViewerId()); err != nil {
+		l.Errorf("check presence privacy failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "check privacy failed: %v", err)
+	}
 	return &pb.GetUserResponse{User: u}, nil
 }
 
 func toProtoUser(p *model.UserProfile) *pb.User {
-	return &pb.User{
+	u := &pb.User{
 		Id:        p.UserID,
 		Nickname:  p.Nickname,
 		AvatarUrl: p.AvatarURL,
 		Bio:       p.Bio,
 		Status:    p.Status,
+		Version:   p.Version,
+		UpdatedAt: p.UpdatedAt.Unix(),
+	}
+	if p.LastSeenAt != nil {
+		u.LastSeenAt = p.LastSeenAt.Unix()
+	}
+	if p.CustomStatusEmoji != "" || p.CustomStatusText != "" {
+		u.CustomStatus = &pb.CustomStatus{Emoji: p.CustomStatusEmoji, Text: p.CustomStatusText}
+		if p.CustomStatusExpiresAt != nil {
+			u.CustomStatus.ExpiresAt = p.CustomStatusExpiresAt.Unix()
+		}
 	}
+	return u
 }
@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultBlockedPageSize = 50
+	maxBlockedPageSize     = 200
+)
+
+type ListBlockedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListBlockedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListBlockedLogic {
+	return &ListBlockedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListBlocked 按拉黑时间倒序分页返回黑名单
+func (l *ListBlockedLogic) ListBlocked(in *pb.ListBlockedRequest) (*pb.ListBlockedResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	var after *model.BlockListCursor
+	if in.GetCursor() != "" {
+		c, ok := decodeBlockCursor(in.GetCursor())
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		after = c
+	}
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultBlockedPageSize
+	}
+	if limit > maxBlockedPageSize {
+		limit = maxBlockedPageSize
+	}
+	list, err := l.svcCtx.UserBlockMod.List(in.GetUserId(), after, limit+1)
+	if err != nil {
+		l.Errorf("list blocked failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list blocked failed: %v", err)
+	}
+	var nextCursor string
+	if len(list) > limit {
+		list = list[:limit]
+		last := list[len(list)-1]
+		nextCursor = strconv.FormatInt(last.CreatedAt.UnixMicro(), 10) + ":" + last.BlockedID
+	}
+	items := make([]*pb.BlockedUser, 0, len(list))
+	for _, b := range list {
+		items = append(items, &pb.BlockedUser{UserId: b.BlockedID, BlockedAt: b.CreatedAt.Unix()})
+	}
+	return &pb.ListBlockedResponse{Items: items, NextCursor: nextCursor}, nil
+}
+
+// decodeBlockCursor 游标格式为 "<created_at 微秒>:<blocked_id>"
+func decodeBlockCursor(s string) (*model.BlockListCursor, bool) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, false
+	}
+	micros, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &model.BlockListCursor{CreatedAt: time.UnixMicro(micros), BlockedID: parts[1]}, true
+}
@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/user/pb"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -24,7 +25,15 @@ func (l *ListContactRequestsLogic) ListContactRequests(in *pb.ListContactRequest
 	if in.GetUserId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id is required")
 	}
-	list, err := l.svcCtx.ContactRequestMod.ListPendingByToUser(in.GetUserId())
+	var (
+		list []*model.ContactRequest
+		err  error
+	)
+	if in.GetSent() {
+		list, err = l.svcCtx.ContactRequestMod.ListPendingByFromUser(in.GetUserId())
+	} else {
+		list, err = l.svcCtx.ContactRequestMod.ListPendingByToUser(in.GetUserId())
+	}
 	if err != nil {
 		l.Errorf("list contact requests failed: %v", err)
 		return nil, status.Errorf(codes.Internal, "list contact requests failed: %v", err)
@@ -37,6 +46,7 @@ func (l *ListContactRequestsLogic) ListContactRequests(in *pb.ListContactRequest
 			ToUserId:   r.ToUserID,
 			Message:    r.Message,
 			CreatedAt:  r.CreatedAt.Unix(),
+			ExpiresAt:  r.ExpiresAt.Unix(),
 		})
 	}
 	return &pb.ListContactRequestsResponse{Items: items}, nil
@@ -2,7 +2,11 @@ package logic
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/user/pb"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -10,6 +14,13 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultContactPageSize = 100
+	maxContactPageSize     = 500
+	// contactSyncTokenSkew 全量拉取及增量最后一页返回的同步点至少向前回退的时长，覆盖查询期间尚未提交的事务；增量结果可能因此少量重复
+	contactSyncTokenSkew = 2 * time.Second
+)
+
 type ListContactsLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -20,13 +31,128 @@ func NewListContactsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *List
 	return &ListContactsLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
 }
 
+// ListContacts 全量模式按 contact_user_id 分页列出联系人并返回 sync_token；携带 sync_token 时为增量模式，
+// 只返回该同步点之后变化的联系人（含已删除）。联系人资料经 BatchGetUsers 附带。
 func (l *ListContactsLogic) ListContacts(in *pb.ListContactsRequest) (*pb.ListContactsResponse, error) {
 	if in.GetOwnerId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "owner_id is required")
 	}
-	ids, err := l.svcCtx.ContactMod.ListContactUserIDs(in.GetOwnerId())
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultContactPageSize
+	}
+	if limit > maxContactPageSize {
+		limit = maxContactPageSize
+	}
+	if in.GetSyncToken() != "" {
+		return l.listChanged(in.GetOwnerId(), in.GetSyncToken(), limit)
+	}
+	syncToken := encodeContactSyncToken(time.Now().Add(-contactSyncTokenSkew), "")
+	filter := model.ContactFilter{StarredOnly: in.GetStarredOnly(), Tag: strings.TrimSpace(in.GetTag())}
+	list, err := l.svcCtx.ContactMod.List(in.GetOwnerId(), filter, in.GetCursor(), limit+1)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list contacts failed: %v", err)
 	}
-	return &pb.ListContactsResponse{ContactUserIds: ids}, nil
+	var nextCursor string
+	if len(list) > limit {
+		list = list[:limit]
+		nextCursor = list[len(list)-1].ContactUserID
+	}
+	resp := l.buildResponse(in.GetOwnerId(), list)
+	resp.NextCursor = nextCursor
+	resp.SyncToken = syncToken
+	return resp, nil
+}
+
+// listChanged 增量模式：按变化时间正序返回，sync_token 推进到本页最后一条（最后一页回退 contactSyncTokenSkew）
+func (l *ListContactsLogic) listChanged(ownerID, token string, limit int) (*pb.ListContactsResponse, error) {
+	since, afterID, ok := decodeContactSyncToken(token)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid sync_token")
+	}
+	list, err := l.svcCtx.ContactMod.ListChangedSince(ownerID, since, afterID, limit+1)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list contacts failed: %v", err)
+	}
+	hasMore := len(list) > limit
+	if hasMore {
+		list = list[:limit]
+	}
+	resp := l.buildResponse(ownerID, list)
+	resp.SyncToken = token
+	if len(list) > 0 {
+		last := list[len(list)-1]
+		resp.SyncToken = encodeContactSyncToken(last.UpdatedAt, last.ContactUserID)
+		// 最后一页的同步点不晚于 now - contactSyncTokenSkew，否则变化时间更早但尚未提交的事务会被跳过；中间页保持精确游标
+		if floor := time.Now().Add(-contactSyncTokenSkew); !hasMore && last.UpdatedAt.After(floor) {
+			resp.SyncToken = encodeContactSyncToken(floor, "")
+		}
+	}
+	resp.HasMore = hasMore
+	return resp, nil
+}
+
+// buildResponse 转换联系人并附带资料（按 ownerID 的可见性过滤最后在线时间与自定义状态）；资料读取失败时只返回联系人本身
+func (l *ListContactsLogic) buildResponse(ownerID string, list []*model.Contact) *pb.ListContactsResponse {
+	ids := make([]string, 0, len(list))
+	items := make([]*pb.ContactInfo, 0, len(list))
+	for _, c := range list {
+		items = append(items, toContactInfo(c))
+		if c.Status == model.ContactStatusAccepted {
+			ids = append(ids, c.ContactUserID)
+		}
+	}
+	if len(ids) > 0 {
+		users, err := NewBatchGetUsersLogic(l.ctx, l.svcCtx).BatchGetUsers(&pb.BatchGetUsersRequest{Ids: ids, ViewerId: ownerID})
+		if err != nil {
+			l.Errorf("batch get contact profiles failed: %v", err)
+		} else {
+			byID := make(map[string]*pb.User, len(users.GetUsers()))
+			for _, u := range users.GetUsers() {
+				if u != nil {
+					byID[u.Id] = u
+				}
+			}
+			for _, it := range items {
+				if it.Status == model.ContactStatusAccepted {
+					it.User = byID[it.ContactUserId]
+				}
+			}
+		}
+	}
+	return &pb.ListContactsResponse{ContactUserIds: ids, Items: items}
+}
+
+func toContactInfo(c *model.Contact) *pb.ContactInfo {
+	tags := []string(c.Tags)
+	if tags == nil {
+		tags = []string{}
+	}
+	return &pb.ContactInfo{
+		ContactUserId: c.ContactUserID,
+		Status:        c.Status,
+		Remark:        c.Remark,
+		Tags:          tags,
+		Starred:       c.Starred,
+		Note:          c.Note,
+		CreatedAt:     c.CreatedAt.Unix(),
+		UpdatedAt:     c.UpdatedAt.Unix(),
+	}
+}
+
+// encodeContactSyncToken 同步点格式：<微秒时间戳>:<contact_user_id>，ID 为空表示该时间点之后的全部变化
+func encodeContactSyncToken(t time.Time, contactUserID string) string {
+	return strconv.FormatInt(t.UnixMicro(), 10) + ":" + contactUserID
+}
+
+func decodeContactSyncToken(s string) (time.Time, string, bool) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return time.Time{}, "", false
+	}
+	us, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", false
+	}
+	return time.UnixMicro(us), parts[1], true
 }
@@ -0,0 +1,143 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 隐私校验的动作，对应 CheckPrivacyRequest.action
+const (
+	privacyActionFind           = "find"
+	privacyActionContactRequest = "contact_request"
+	privacyActionGroupAdd       = "group_add"
+	privacyActionPresence       = "presence"
+)
+
+// privacyKey 与 "user:profile:" 同级，值为 UserPrivacy 的 JSON
+func privacyKey(userID string) string {
+	return fmt.Sprintf("user:privacy:%s", userID)
+}
+
+// loadPrivacy 读取隐私设置：Redis 优先，未命中时查库（无记录即默认值）并回写缓存
+func loadPrivacy(ctx context.Context, svcCtx *svc.ServiceContext, userID string) (*model.UserPrivacy, error) {
+	key := privacyKey(userID)
+	val, err := svcCtx.Redis.Get(ctx, key).Bytes()
+	if err == nil && len(val) > 0 {
+		var p model.UserPrivacy
+		if e := json.Unmarshal(val, &p); e == nil {
+			return &p, nil
+		}
+	}
+	if err != nil && err != redis.Nil {
+		logx.WithContext(ctx).Errorf("redis GET %s error: %v", key, err)
+	}
+	p, err := svcCtx.UserPrivacyMod.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	cachePrivacy(ctx, svcCtx, p)
+	return p, nil
+}
+
+// cachePrivacy 写入隐私设置缓存，失败只记日志
+func cachePrivacy(ctx context.Context, svcCtx *svc.ServiceContext, p *model.UserPrivacy) {
+	key := privacyKey(p.UserID)
+	buf, err := json.Marshal(p)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("marshal user privacy failed: %v", err)
+		return
+	}
+	ttl := svcCtx.Config.UserProfileTTLSeconds
+	if ttl <= 0 {
+		ttl = 600
+	}
+	if err := svcCtx.Redis.Set(ctx, key, buf, time.Duration(ttl)*time.Second).Err(); err != nil {
+		logx.WithContext(ctx).Errorf("redis SET %s error: %v", key, err)
+	}
+}
+
+// applyPresenceVisibility 去掉已过期的自定义状态；viewerID 非空且不是本人、对方 presence_visibility 不允许时，
+// 同时隐藏最后在线时间与自定义状态
+func applyPresenceVisibility(ctx context.Context, svcCtx *svc.ServiceContext, u *pb.User, viewerID string) error {
+	if cs := u.GetCustomStatus(); cs != nil && cs.GetExpiresAt() > 0 && cs.GetExpiresAt() <= time.Now().Unix() {
+		u.CustomStatus = nil
+	}
+	if u.GetLastSeenAt() == 0 && u.GetCustomStatus() == nil {
+		return nil
+	}
+	ok, err := privacyAllows(ctx, svcCtx, u.GetId(), viewerID, privacyActionPresence)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		u.LastSeenAt = 0
+		u.CustomStatus = nil
+	}
+	return nil
+}
+
+// privacyAllows 判断 viewerID 能否对 userID 执行 action：viewerID 为空或为本人时允许；任一方拉黑对方时不允许；
+// 其余按 userID 的隐私设置判断，contacts 要求 viewerID 在 userID 的联系人中，contacts_of_contacts 另外允许有共同联系人的用户
+func privacyAllows(ctx context.Context, svcCtx *svc.ServiceContext, userID, viewerID, action string) (bool, error) {
+	if viewerID == "" || viewerID == userID {
+		return true, nil
+	}
+	byUser, byPeer, err := blockRelation(ctx, svcCtx, userID, viewerID)
+	if err != nil {
+		return false, err
+	}
+	if byUser || byPeer {
+		return false, nil
+	}
+	p, err := loadPrivacy(ctx, svcCtx, userID)
+	if err != nil {
+		return false, err
+	}
+	var setting string
+	switch action {
+	case privacyActionFind:
+		setting = p.FindBy
+	case privacyActionContactRequest:
+		setting = p.ContactRequest
+	case privacyActionGroupAdd:
+		setting = p.GroupAdd
+	case privacyActionPresence:
+		setting = p.PresenceVisibility
+	default:
+		return false, fmt.Errorf("unknown privacy action: %s", action)
+	}
+	switch setting {
+	case model.PrivacyNobody:
+		return false, nil
+	case model.PrivacyContacts:
+		return svcCtx.ContactMod.Exists(userID, viewerID)
+	case model.PrivacyContactsOfContacts:
+		ok, err := svcCtx.ContactMod.Exists(userID, viewerID)
+		if err != nil || ok {
+			return ok, err
+		}
+		return svcCtx.ContactMod.HasMutualContact(userID, viewerID)
+	}
+	return true, nil
+}
+
+// validPrivacyValue 校验 action 对应设置项的取值，contact_request 支持 contacts_of_contacts，其余支持 contacts
+func validPrivacyValue(action, v string) bool {
+	switch v {
+	case model.PrivacyEveryone, model.PrivacyNobody:
+		return true
+	case model.PrivacyContacts:
+		return action != privacyActionContactRequest
+	case model.PrivacyContactsOfContacts:
+		return action == privacyActionContactRequest
+	}
+	return false
+}
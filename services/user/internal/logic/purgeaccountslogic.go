@@ -0,0 +1,103 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/auth/authservice"
+	"github.com/HappyLadySauce/Beehive/services/conversation/conversationservice"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type PurgeAccountsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPurgeAccountsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PurgeAccountsLogic {
+	return &PurgeAccountsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// PurgeDue 清除一批宽限期已到的账号，返回本批成功清除的账号数；单个账号失败只记录日志，下一轮再试。
+// 未配置 ConversationService 时无法退出会话，不执行清除
+func (l *PurgeAccountsLogic) PurgeDue(limit int) (int, error) {
+	if l.svcCtx.ConversationSvc == nil {
+		return 0, errors.New("conversation service not configured, account purge skipped")
+	}
+	ids, err := l.svcCtx.DeletionMod.ListDue(time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, id := range ids {
+		if l.ctx.Err() != nil {
+			break
+		}
+		if err := l.purge(id); err != nil {
+			l.Errorf("purge account failed userId=%s: %v", id, err)
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
+
+// purge 清除单个账号：先由 ConversationService 退出所有会话（群主转让、系统消息与事件由其负责），
+// 再在一个事务内完成本服务的清除（含导出归档），之后失效缓存、吊销 token 并通知在线会话。
+// 退出会话失败时不清除，下一轮重试；事务后的步骤失败只记录日志：缓存会随 TTL 过期，token 会自然过期
+func (l *PurgeAccountsLogic) purge(userID string) error {
+	if _, err := l.svcCtx.DeletionMod.Find(userID); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// 申请已被撤销或已被其他副本清除
+			return nil
+		}
+		return err
+	}
+	blockers, err := l.svcCtx.UserBlockMod.ListBlockerIDs(userID)
+	if err != nil {
+		return err
+	}
+	if _, err := l.svcCtx.ConversationSvc.RemoveUserEverywhere(l.ctx, &conversationservice.RemoveUserEverywhereRequest{UserId: userID}); err != nil {
+		return fmt.Errorf("remove from conversations: %w", err)
+	}
+	err = l.svcCtx.DeletionMod.Purge(userID)
+	if err == gorm.ErrRecordNotFound {
+		// 申请已被撤销或已被其他副本清除
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	keys := []string{fmt.Sprintf("user:profile:%s", userID), privacyKey(userID)}
+	if err := l.svcCtx.Redis.Del(l.ctx, keys...).Err(); err != nil {
+		l.Errorf("redis DEL caches of purged user %s error: %v", userID, err)
+	}
+	invalidateBlocks(l.ctx, l.svcCtx, userID)
+	for _, b := range blockers {
+		invalidateBlocks(l.ctx, l.svcCtx, b)
+	}
+	if l.svcCtx.AuthSvc != nil {
+		if _, err := l.svcCtx.AuthSvc.RevokeUserTokens(l.ctx, &authservice.RevokeUserTokensRequest{UserId: userID}); err != nil {
+			l.Errorf("revoke tokens of purged user %s failed: %v", userID, err)
+		}
+	}
+	// 通知该用户仍在线的会话账号已注销，客户端据此退出登录
+	if err := l.svcCtx.MQ.PublishJSON("account.deleted", map[string]interface{}{
+		"userId":    userID,
+		"deletedAt": time.Now().Unix(),
+	}); err != nil {
+		l.Errorf("publish account.deleted failed: %v", err)
+	}
+	l.Infof("account purged userId=%s", userID)
+	return nil
+}
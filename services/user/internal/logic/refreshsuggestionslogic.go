@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// suggestionActiveWindow 只为该时间内请求过推荐的用户重算，长期不用的用户不占后台资源
+const suggestionActiveWindow = 7 * 24 * time.Hour
+
+type RefreshSuggestionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRefreshSuggestionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshSuggestionsLogic {
+	return &RefreshSuggestionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RefreshStale 重算一批推荐已过期的活跃用户，返回本批成功重算的用户数；单个用户失败只记录日志，下一轮再试
+func (l *RefreshSuggestionsLogic) RefreshStale(limit int) (int, error) {
+	interval := time.Duration(l.svcCtx.Config.SuggestionRefreshSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	now := time.Now()
+	ids, err := l.svcCtx.SuggestionMod.ListStale(now.Add(-interval), now.Add(-suggestionActiveWindow), limit)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, id := range ids {
+		if l.ctx.Err() != nil {
+			break
+		}
+		if err := recomputeSuggestions(l.svcCtx, id); err != nil {
+			l.Errorf("refresh contact suggestions failed userId=%s: %v", id, err)
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
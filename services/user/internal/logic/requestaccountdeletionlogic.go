@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type RequestAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRequestAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestAccountDeletionLogic {
+	return &RequestAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RequestAccountDeletion 登记注销申请，宽限期到期后由后台清除；重复申请幂等，不会推迟清除时间。
+// 用户本人申请使用配置的宽限期，管理员代为申请时可通过 grace_seconds 指定更短的宽限期
+func (l *RequestAccountDeletionLogic) RequestAccountDeletion(in *pb.RequestAccountDeletionRequest) (*pb.RequestAccountDeletionResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if in.GetUserId() == model.PlaceholderUserID {
+		return nil, status.Error(codes.InvalidArgument, "placeholder user cannot be deleted")
+	}
+	usr, err := l.svcCtx.UserMod.FindByID(in.GetUserId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		l.Errorf("find user failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "find user failed: %v", err)
+	}
+	if usr.Status == model.UserStatusDeleted {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+
+	requestedBy := in.GetUserId()
+	grace := time.Duration(l.svcCtx.Config.AccountDeletionGraceSeconds) * time.Second
+	if in.GetOperatorId() != "" && in.GetOperatorId() != in.GetUserId() {
+		requestedBy = in.GetOperatorId()
+		if in.GetGraceSeconds() > 0 {
+			grace = time.Duration(in.GetGraceSeconds()) * time.Second
+		}
+	}
+	if grace < 0 {
+		grace = 0
+	}
+	d, err := l.svcCtx.DeletionMod.Schedule(in.GetUserId(), requestedBy, time.Now().Add(grace))
+	if err != nil {
+		l.Errorf("schedule account deletion failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "schedule account deletion failed: %v", err)
+	}
+	return &pb.RequestAccountDeletionResponse{Deletion: toProtoAccountDeletion(d)}, nil
+}
+
+func toProtoAccountDeletion(d *model.AccountDeletion) *pb.AccountDeletion {
+	return &pb.AccountDeletion{
+		UserId:      d.UserID,
+		RequestedBy: d.RequestedBy,
+		RequestedAt: d.RequestedAt.Unix(),
+		PurgeAt:     d.PurgeAt.Unix(),
+	}
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type RequestDataExportLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRequestDataExportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestDataExportLogic {
+	return &RequestDataExportLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RequestDataExport 排队一个个人数据导出任务，由后台异步生成归档；已有排队或执行中的任务时直接返回该任务
+func (l *RequestDataExportLogic) RequestDataExport(in *pb.RequestDataExportRequest) (*pb.RequestDataExportResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	usr, err := l.svcCtx.UserMod.FindByID(in.GetUserId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		l.Errorf("find user failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "find user failed: %v", err)
+	}
+	if usr.Status == model.UserStatusDeleted {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	requestedBy := in.GetUserId()
+	if in.GetOperatorId() != "" {
+		requestedBy = in.GetOperatorId()
+	}
+	exp, _, err := l.svcCtx.ExportMod.Create(in.GetUserId(), requestedBy)
+	if err != nil {
+		l.Errorf("create data export failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "create data export failed: %v", err)
+	}
+	return &pb.RequestDataExportResponse{Export: toProtoDataExport(exp)}, nil
+}
+
+func toProtoDataExport(e *model.DataExport) *pb.DataExport {
+	out := &pb.DataExport{
+		Id:        e.ID,
+		UserId:    e.UserID,
+		Status:    e.Status,
+		SizeBytes: e.SizeBytes,
+		Error:     e.Error,
+		CreatedAt: e.CreatedAt.Unix(),
+	}
+	if e.CompletedAt != nil {
+		out.CompletedAt = e.CompletedAt.Unix()
+	}
+	if e.ExpiresAt != nil {
+		out.ExpiresAt = e.ExpiresAt.Unix()
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 50
+	maxSearchQueryLen     = 32
+)
+
+type SearchUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSearchUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SearchUsersLogic {
+	return &SearchUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SearchUsers 按相关度分页搜索用户；结果按相关度排序，游标为下一页的偏移量
+func (l *SearchUsersLogic) SearchUsers(in *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	q := strings.TrimSpace(in.GetQuery())
+	if q == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	if utf8.RuneCountInString(q) > maxSearchQueryLen {
+		return nil, status.Errorf(codes.InvalidArgument, "query must be at most %d characters", maxSearchQueryLen)
+	}
+	offset := 0
+	if in.GetCursor() != "" {
+		n, err := strconv.Atoi(in.GetCursor())
+		if err != nil || n < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		offset = n
+	}
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultSearchPageSize
+	}
+	if limit > maxSearchPageSize {
+		limit = maxSearchPageSize
+	}
+	// 多取一条判断是否还有下一页
+	list, err := l.svcCtx.UserProfileMod.SearchUsers(in.GetUserId(), q, offset, limit+1)
+	if err != nil {
+		l.Errorf("search users failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "search users failed: %v", err)
+	}
+	var nextCursor string
+	if len(list) > limit {
+		list = list[:limit]
+		nextCursor = strconv.Itoa(offset + limit)
+	}
+	users := make([]*pb.User, 0, len(list))
+	for _, p := range list {
+		u := toProtoUser(p)
+		// 最后在线时间与自定义状态按对方 presence_visibility 对搜索者过滤
+		if err := applyPresenceVisibility(l.ctx, l.svcCtx, u, in.GetUserId()); err != nil {
+			l.Errorf("check presence privacy failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "check privacy failed: %v", err)
+		}
+		users = append(users, u)
+	}
+	return &pb.SearchUsersResponse{Users: users, NextCursor: nextCursor}, nil
+}
@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxContactTags   = 20
+	maxContactTagLen = 16
+)
+
+type SetContactTagsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetContactTagsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetContactTagsLogic {
+	return &SetContactTagsLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// SetContactTags 整体替换联系人标签，去重并去掉空标签
+func (l *SetContactTagsLogic) SetContactTags(in *pb.SetContactTagsRequest) (*pb.SetContactTagsResponse, error) {
+	if in.GetOwnerId() == "" || in.GetContactUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner_id and contact_user_id are required")
+	}
+	seen := make(map[string]struct{}, len(in.GetTags()))
+	tags := make(pq.StringArray, 0, len(in.GetTags()))
+	for _, t := range in.GetTags() {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		if utf8.RuneCountInString(t) > maxContactTagLen {
+			return nil, status.Errorf(codes.InvalidArgument, "tag must be at most %d characters", maxContactTagLen)
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		tags = append(tags, t)
+	}
+	if len(tags) > maxContactTags {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d tags", maxContactTags)
+	}
+	resp, err := updateContactMeta(l.ctx, l.svcCtx, in.GetOwnerId(), in.GetContactUserId(), map[string]interface{}{"tags": tags})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetContactTagsResponse{Contact: resp.GetContact()}, nil
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxCustomStatusEmojiLen = 8
+	maxCustomStatusTextLen  = 64
+)
+
+type SetCustomStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetCustomStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetCustomStatusLogic {
+	return &SetCustomStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetCustomStatus 设置或清除（emoji 与 text 均为空）自定义状态并使资料缓存失效
+func (l *SetCustomStatusLogic) SetCustomStatus(in *pb.SetCustomStatusRequest) (*pb.SetCustomStatusResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	emoji := strings.TrimSpace(in.GetEmoji())
+	text := strings.TrimSpace(in.GetText())
+	if utf8.RuneCountInString(emoji) > maxCustomStatusEmojiLen {
+		return nil, status.Errorf(codes.InvalidArgument, "emoji must be at most %d characters", maxCustomStatusEmojiLen)
+	}
+	if utf8.RuneCountInString(text) > maxCustomStatusTextLen {
+		return nil, status.Errorf(codes.InvalidArgument, "text must be at most %d characters", maxCustomStatusTextLen)
+	}
+	var expiresAt *time.Time
+	var cs *pb.CustomStatus
+	if emoji != "" || text != "" {
+		if in.GetExpiresAt() != 0 {
+			if in.GetExpiresAt() <= time.Now().Unix() {
+				return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+			}
+			t := time.Unix(in.GetExpiresAt(), 0)
+			expiresAt = &t
+		}
+		cs = &pb.CustomStatus{Emoji: emoji, Text: text, ExpiresAt: in.GetExpiresAt()}
+	}
+	if err := l.svcCtx.UserProfileMod.UpdateCustomStatus(in.GetUserId(), emoji, text, expiresAt); err != nil {
+		l.Errorf("update custom status failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "update custom status failed: %v", err)
+	}
+	key := fmt.Sprintf("user:profile:%s", in.GetUserId())
+	if err := l.svcCtx.Redis.Del(l.ctx, key).Err(); err != nil {
+		l.Errorf("redis DEL %s error: %v", key, err)
+	}
+	return &pb.SetCustomStatusResponse{CustomStatus: cs}, nil
+}
@@ -0,0 +1,43 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SetLastSeenLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetLastSeenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetLastSeenLogic {
+	return &SetLastSeenLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetLastSeen 记录最后在线时间并使资料缓存失效；由 Presence 服务在用户最后一个会话结束时调用
+func (l *SetLastSeenLogic) SetLastSeen(in *pb.SetLastSeenRequest) (*pb.SetLastSeenResponse, error) {
+	if in.GetUserId() == "" || in.GetLastSeenAt() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and last_seen_at are required")
+	}
+	if err := l.svcCtx.UserProfileMod.UpdateLastSeen(in.GetUserId(), time.Unix(in.GetLastSeenAt(), 0)); err != nil {
+		l.Errorf("update last seen failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "update last seen failed: %v", err)
+	}
+	key := fmt.Sprintf("user:profile:%s", in.GetUserId())
+	if err := l.svcCtx.Redis.Del(l.ctx, key).Err(); err != nil {
+		l.Errorf("redis DEL %s error: %v", key, err)
+	}
+	return &pb.SetLastSeenResponse{}, nil
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultProfileAudienceBatch = 1000
+	maxProfileAudienceBatch     = 5000
+)
+
+type StreamProfileAudienceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStreamProfileAudienceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StreamProfileAudienceLogic {
+	return &StreamProfileAudienceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// StreamProfileAudience 按用户 ID 游标分批读取资料变更的推送对象并逐批发送，联系人多、所在群大时无需一次性加载。
+func (l *StreamProfileAudienceLogic) StreamProfileAudience(in *pb.StreamProfileAudienceRequest, stream pb.UserService_StreamProfileAudienceServer) error {
+	if in.GetUserId() == "" {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	batch := int(in.GetBatchSize())
+	if batch <= 0 {
+		batch = defaultProfileAudienceBatch
+	}
+	if batch > maxProfileAudienceBatch {
+		batch = maxProfileAudienceBatch
+	}
+	var after string
+	for {
+		if err := l.ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		ids, err := l.svcCtx.UserProfileMod.ListProfileAudience(in.GetUserId(), after, batch)
+		if err != nil {
+			l.Errorf("list profile audience failed: %v", err)
+			return status.Errorf(codes.Internal, "list profile audience failed: %v", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := stream.Send(&pb.ProfileAudienceChunk{UserIds: ids}); err != nil {
+			return err
+		}
+		if len(ids) < batch {
+			return nil
+		}
+		after = ids[len(ids)-1]
+	}
+}
@@ -0,0 +1,139 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultSuggestPageSize = 20
+	maxSuggestPageSize     = 50
+	// suggestionKeep 每个用户预计算保留的推荐条数
+	suggestionKeep = 200
+)
+
+type SuggestContactsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSuggestContactsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SuggestContactsLogic {
+	return &SuggestContactsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SuggestContacts 读取预计算的好友推荐，游标为下一页的偏移量。首次请求时同步计算一次，
+// 之后由后台按 SuggestionRefreshSeconds 重算；对方隐私设置不允许查找或发送好友申请时不返回。
+func (l *SuggestContactsLogic) SuggestContacts(in *pb.SuggestContactsRequest) (*pb.SuggestContactsResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	offset := 0
+	if in.GetCursor() != "" {
+		n, err := strconv.Atoi(in.GetCursor())
+		if err != nil || n < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		offset = n
+	}
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = defaultSuggestPageSize
+	}
+	if limit > maxSuggestPageSize {
+		limit = maxSuggestPageSize
+	}
+
+	_, err := l.svcCtx.SuggestionMod.FindState(in.GetUserId())
+	switch {
+	case err == gorm.ErrRecordNotFound:
+		if err := recomputeSuggestions(l.svcCtx, in.GetUserId()); err != nil {
+			l.Errorf("compute contact suggestions failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "compute contact suggestions failed: %v", err)
+		}
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "query suggestion state failed: %v", err)
+	default:
+		if err := l.svcCtx.SuggestionMod.Touch(in.GetUserId()); err != nil {
+			l.Errorf("touch suggestion state failed: %v", err)
+		}
+	}
+
+	// 多取一条判断是否还有下一页
+	list, err := l.svcCtx.SuggestionMod.List(in.GetUserId(), offset, limit+1)
+	if err != nil {
+		l.Errorf("list contact suggestions failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "list contact suggestions failed: %v", err)
+	}
+	var nextCursor string
+	if len(list) > limit {
+		list = list[:limit]
+		nextCursor = strconv.Itoa(offset + limit)
+	}
+	ids := make([]string, 0, len(list))
+	for _, s := range list {
+		allowed, err := suggestionAllowed(l.ctx, l.svcCtx, s.SuggestedUserID, in.GetUserId())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "check privacy failed: %v", err)
+		}
+		if allowed {
+			ids = append(ids, s.SuggestedUserID)
+		}
+	}
+	byID := make(map[string]*pb.User, len(ids))
+	if len(ids) > 0 {
+		users, err := NewBatchGetUsersLogic(l.ctx, l.svcCtx).BatchGetUsers(&pb.BatchGetUsersRequest{Ids: ids, ViewerId: in.GetUserId()})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users.GetUsers() {
+			if u != nil {
+				byID[u.Id] = u
+			}
+		}
+	}
+	items := make([]*pb.ContactSuggestion, 0, len(ids))
+	for _, s := range list {
+		u, ok := byID[s.SuggestedUserID]
+		if !ok {
+			continue
+		}
+		items = append(items, &pb.ContactSuggestion{
+			User:           u,
+			MutualContacts: int32(s.MutualContacts),
+			SharedGroups:   int32(s.SharedGroups),
+		})
+	}
+	return &pb.SuggestContactsResponse{Items: items, NextCursor: nextCursor}, nil
+}
+
+// suggestionAllowed 推荐会暴露对方存在，需同时满足对方的 find_by 与 contact_request 隐私设置
+func suggestionAllowed(ctx context.Context, svcCtx *svc.ServiceContext, userID, viewerID string) (bool, error) {
+	for _, action := range []string{privacyActionFind, privacyActionContactRequest} {
+		ok, err := privacyAllows(ctx, svcCtx, userID, viewerID, action)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// recomputeSuggestions 按配置重新计算 userID 的好友推荐
+func recomputeSuggestions(svcCtx *svc.ServiceContext, userID string) error {
+	maxGroup := svcCtx.Config.SuggestionMaxGroupSize
+	if maxGroup <= 0 {
+		maxGroup = 500
+	}
+	return svcCtx.SuggestionMod.Recompute(userID, maxGroup, suggestionKeep)
+}
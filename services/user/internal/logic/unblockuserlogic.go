@@ -0,0 +1,38 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UnblockUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnblockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnblockUserLogic {
+	return &UnblockUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnblockUser 解除拉黑，未拉黑时视为成功
+func (l *UnblockUserLogic) UnblockUser(in *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	if in.GetUserId() == "" || in.GetTargetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and target_user_id are required")
+	}
+	if err := l.svcCtx.UserBlockMod.Unblock(in.GetUserId(), in.GetTargetUserId()); err != nil {
+		l.Errorf("unblock user failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "unblock user failed: %v", err)
+	}
+	invalidateBlocks(l.ctx, l.svcCtx, in.GetUserId())
+	return &pb.UnblockUserResponse{}, nil
+}
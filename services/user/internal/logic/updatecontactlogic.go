@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	maxContactRemarkLen = 32
+	maxContactNoteLen   = 500
+)
+
+type UpdateContactLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateContactLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateContactLogic {
+	return &UpdateContactLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+// UpdateContact 修改联系人备注、星标、备忘，只更新传入的字段
+func (l *UpdateContactLogic) UpdateContact(in *pb.UpdateContactRequest) (*pb.UpdateContactResponse, error) {
+	if in.GetOwnerId() == "" || in.GetContactUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner_id and contact_user_id are required")
+	}
+	updates := make(map[string]interface{})
+	if in.Remark != nil {
+		remark := strings.TrimSpace(in.GetRemark())
+		if utf8.RuneCountInString(remark) > maxContactRemarkLen {
+			return nil, status.Errorf(codes.InvalidArgument, "remark must be at most %d characters", maxContactRemarkLen)
+		}
+		updates["remark"] = remark
+	}
+	if in.Note != nil {
+		if utf8.RuneCountInString(in.GetNote()) > maxContactNoteLen {
+			return nil, status.Errorf(codes.InvalidArgument, "note must be at most %d characters", maxContactNoteLen)
+		}
+		updates["note"] = in.GetNote()
+	}
+	if in.Starred != nil {
+		updates["starred"] = in.GetStarred()
+	}
+	return updateContactMeta(l.ctx, l.svcCtx, in.GetOwnerId(), in.GetContactUserId(), updates)
+}
+
+// updateContactMeta 写入联系人信息并返回更新后的联系人；updates 为空时只校验联系人存在
+func updateContactMeta(ctx context.Context, svcCtx *svc.ServiceContext, ownerID, contactUserID string, updates map[string]interface{}) (*pb.UpdateContactResponse, error) {
+	if len(updates) > 0 {
+		if err := svcCtx.ContactMod.UpdateMeta(ownerID, contactUserID, updates); err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, status.Error(codes.NotFound, "contact not found")
+			}
+			logx.WithContext(ctx).Errorf("update contact failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "update contact failed: %v", err)
+		}
+	}
+	c, err := svcCtx.ContactMod.Find(ownerID, contactUserID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "contact not found")
+		}
+		return nil, status.Errorf(codes.Internal, "query contact failed: %v", err)
+	}
+	return &pb.UpdateContactResponse{Contact: toContactInfo(c)}, nil
+}
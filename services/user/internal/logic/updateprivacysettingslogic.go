@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UpdatePrivacySettingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdatePrivacySettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePrivacySettingsLogic {
+	return &UpdatePrivacySettingsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdatePrivacySettings 修改传入的隐私设置项，返回修改后的完整设置
+func (l *UpdatePrivacySettingsLogic) UpdatePrivacySettings(in *pb.UpdatePrivacySettingsRequest) (*pb.UpdatePrivacySettingsResponse, error) {
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	fields := []struct {
+		action string
+		name   string
+		value  *string
+	}{
+		{privacyActionFind, "find_by", in.FindBy},
+		{privacyActionContactRequest, "contact_request", in.ContactRequest},
+		{privacyActionGroupAdd, "group_add", in.GroupAdd},
+		{privacyActionPresence, "presence_visibility", in.PresenceVisibility},
+	}
+	for _, f := range fields {
+		if f.value != nil && !validPrivacyValue(f.action, *f.value) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %s", f.name, *f.value)
+		}
+	}
+	if _, err := l.svcCtx.UserMod.FindByID(in.GetUserId()); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "query user failed: %v", err)
+	}
+	p, err := l.svcCtx.UserPrivacyMod.FindByUserID(in.GetUserId())
+	if err != nil {
+		l.Errorf("find privacy settings failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "find privacy settings failed: %v", err)
+	}
+	if in.FindBy != nil {
+		p.FindBy = in.GetFindBy()
+	}
+	if in.ContactRequest != nil {
+		p.ContactRequest = in.GetContactRequest()
+	}
+	if in.GroupAdd != nil {
+		p.GroupAdd = in.GetGroupAdd()
+	}
+	if in.PresenceVisibility != nil {
+		p.PresenceVisibility = in.GetPresenceVisibility()
+	}
+	if err := l.svcCtx.UserPrivacyMod.Save(p); err != nil {
+		l.Errorf("save privacy settings failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "save privacy settings failed: %v", err)
+	}
+	cachePrivacy(l.ctx, l.svcCtx, p)
+	if in.Discoverable != nil {
+		if err := l.svcCtx.UserProfileMod.UpdateDiscoverable(in.GetUserId(), in.GetDiscoverable()); err != nil {
+			l.Errorf("update discoverable failed: %v", err)
+			return nil, status.Errorf(codes.Internal, "update discoverable failed: %v", err)
+		}
+	}
+	settings, err := privacySettings(l.ctx, l.svcCtx, in.GetUserId())
+	if err != nil {
+		l.Errorf("get privacy settings failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "get privacy settings failed: %v", err)
+	}
+	return &pb.UpdatePrivacySettingsResponse{Settings: settings}, nil
+}
@@ -2,14 +2,11 @@ package logic
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"time"
+	"unicode/utf8"
 
-	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
 	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
 	"github.com/HappyLadySauce/Beehive/services/user/pb"
-	"github.com/redis/go-redis/v9"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,10 +15,16 @@ import (
 
 // UpdateUserLogic 负责实现用户资料更新相关的业务逻辑。
 // 更新流程大致为：
-// 1. 先从数据库中读取当前用户 profile；
-// 2. 根据请求参数有选择地覆盖可变字段（昵称、头像、个性签名等）；
-// 3. 更新数据库记录；
-// 4. 将最新数据写回 Redis 缓存，保证后续读操作可以直接命中缓存。
+// 1. 先从数据库中读取当前用户 profile，确认用户存在；
+// 2. 在一条 UPDATE 语句内按请求有选择地覆盖可变字段（昵称、头像、个性签名等），有变化时资料版本号加一；
+// 3. 删除 Redis 缓存，下次读取时回源数据库，避免与并发读回写的旧数据相互覆盖；
+// 4. 昵称、头像、签名有变化时发布 user.updated，由 Gateway 推送给本人其他设备、联系人与共同会话成员。
+const (
+	maxNicknameLen  = 32
+	maxAvatarURLLen = 512
+	maxBioLen       = 256
+)
+
 type UpdateUserLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -41,11 +44,20 @@ func NewUpdateUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Update
 // UpdateUser 更新用户的基础资料。
 // - 要求请求中必须携带用户 ID；
 // - 仅对非空字段做覆盖更新，保持“部分更新”语义；
-// - 更新数据库成功后会同步刷新 Redis 缓存中的用户资料。
+// - 更新数据库成功后使 Redis 缓存中的用户资料失效。
 func (l *UpdateUserLogic) UpdateUser(in *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
 	if in.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
+	if utf8.RuneCountInString(in.GetNickname()) > maxNicknameLen {
+		return nil, status.Errorf(codes.InvalidArgument, "nickname must be at most %d characters", maxNicknameLen)
+	}
+	if len(in.GetAvatarUrl()) > maxAvatarURLLen {
+		return nil, status.Errorf(codes.InvalidArgument, "avatar_url must be at most %d bytes", maxAvatarURLLen)
+	}
+	if utf8.RuneCountInString(in.GetBio()) > maxBioLen {
+		return nil, status.Errorf(codes.InvalidArgument, "bio must be at most %d characters", maxBioLen)
+	}
 
 	ctx := l.ctx
 	id := in.GetId()
@@ -61,52 +73,45 @@ func (l *UpdateUserLogic) UpdateUser(in *pb.UpdateUserRequest) (*pb.UpdateUserRe
 		return nil, status.Errorf(codes.Internal, "query user profile failed: %v", err)
 	}
 
-	// 2. 根据请求选择性更新字段：
-	//    - 使用 chooseNonEmpty 对字符串字段进行“非空覆盖”，
-	//      即只有当请求字段非空时才会覆盖原有值，避免把空字符串写回数据库。
-	p := &model.UserProfile{
-		UserID:    existing.UserID,
-		Nickname:  chooseNonEmpty(in.GetNickname(), existing.Nickname),
-		AvatarURL: chooseNonEmpty(in.GetAvatarUrl(), existing.AvatarURL),
-		Bio:       chooseNonEmpty(in.GetBio(), existing.Bio),
-		Status:    existing.Status,
-		UpdatedAt: time.Now(),
+	// 2. 部分更新：空字符串表示不修改，discoverable 为 optional 字段，未传时保持原值。
+	//    最后在线时间与自定义状态不由本接口修改，也不会被覆盖。
+	var discoverable *bool
+	if in.Discoverable != nil {
+		v := in.GetDiscoverable()
+		discoverable = &v
 	}
-
-	// 3. 写回数据库，持久化更新后的用户资料。
-	if err := l.svcCtx.UserProfileMod.UpdateProfile(p); err != nil {
+	p, err := l.svcCtx.UserProfileMod.UpdateProfile(id, in.GetNickname(), in.GetAvatarUrl(), in.GetBio(), discoverable)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
 		return nil, status.Errorf(codes.Internal, "update user profile failed: %v", err)
 	}
 
 	// 将内部模型转换为对外返回的 protobuf User 结构。
 	u := toProtoUser(p)
 
-	// 4. 刷新缓存：
-	//    - 把最新的用户资料序列化为 JSON 存入 Redis；
-	//    - TTL 同样来自配置，未设置时默认使用 600 秒；
-	//    - 如果写缓存失败，只记录日志，不影响更新流程的主路径。
+	// 3. 使缓存失效：
+	//    - 写后删除而不是写后回填，避免并发的 GetUser 把更新前读到的旧资料写回缓存后覆盖新值；
+	//    - 删除失败只记录日志，缓存会随 TTL 过期。
 	key := fmt.Sprintf("user:profile:%s", id)
-	if buf, e := json.Marshal(u); e == nil {
-		ttl := l.svcCtx.Config.UserProfileTTLSeconds
-		if ttl <= 0 {
-			ttl = 600
-		}
-		if e := l.svcCtx.Redis.Set(ctx, key, buf, time.Duration(ttl)*time.Second).Err(); e != nil && e != redis.Nil {
-			l.Errorf("redis SET %s error: %v", key, e)
+	if e := l.svcCtx.Redis.Del(ctx, key).Err(); e != nil {
+		l.Errorf("redis DEL %s error: %v", key, e)
+	}
+
+	// 4. 版本号变化说明昵称、头像或签名有修改，通知关注该用户资料的在线用户。
+	if p.Version != existing.Version {
+		if e := l.svcCtx.MQ.PublishJSON("user.updated", map[string]interface{}{
+			"userId":    u.GetId(),
+			"nickname":  u.GetNickname(),
+			"avatarUrl": u.GetAvatarUrl(),
+			"bio":       u.GetBio(),
+			"version":   u.GetVersion(),
+			"updatedAt": u.GetUpdatedAt(),
+		}); e != nil {
+			l.Errorf("publish user.updated failed: %v", e)
 		}
-	} else {
-		l.Errorf("marshal updated user profile failed: %v", e)
 	}
 
 	return &pb.UpdateUserResponse{User: u}, nil
 }
-
-// chooseNonEmpty 在更新逻辑中实现“非空覆盖”策略：
-// - 如果传入的新值 v 非空，则使用 v；
-// - 否则回退到原有值 fallback。
-func chooseNonEmpty(v, fallback string) string {
-	if v != "" {
-		return v
-	}
-	return fallback
-}
@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/HappyLadySauce/Beehive/services/user/pb"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type WithdrawContactRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewWithdrawContactRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WithdrawContactRequestLogic {
+	return &WithdrawContactRequestLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+func (l *WithdrawContactRequestLogic) WithdrawContactRequest(in *pb.WithdrawContactRequestRequest) (*pb.WithdrawContactRequestResponse, error) {
+	if in.GetUserId() == "" || in.GetRequestId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and request_id are required")
+	}
+	err := l.svcCtx.ContactRequestMod.Withdraw(in.GetRequestId(), in.GetUserId())
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "request not found or not pending")
+		}
+		l.Errorf("withdraw contact request failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "withdraw contact request failed: %v", err)
+	}
+	return &pb.WithdrawContactRequestResponse{}, nil
+}
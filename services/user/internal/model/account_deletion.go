@@ -0,0 +1,128 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// PlaceholderUserID 占位用户，被清除账号发送过的消息改挂到该用户名下
+	PlaceholderUserID = "0000000000"
+	// UserStatusDeleted users.status：账号已清除，仅保留墓碑行
+	UserStatusDeleted = "deleted"
+)
+
+// AccountDeletion 对应 account_deletions 表，宽限期内的注销申请
+type AccountDeletion struct {
+	UserID      string    `gorm:"column:user_id;type:varchar(10);primaryKey"`
+	RequestedBy string    `gorm:"column:requested_by;type:varchar(10);not null;default:''"`
+	RequestedAt time.Time `gorm:"column:requested_at;type:timestamptz;not null"`
+	PurgeAt     time.Time `gorm:"column:purge_at;type:timestamptz;not null"`
+}
+
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+type AccountDeletionModel struct {
+	db *gorm.DB
+}
+
+func NewAccountDeletionModel(db *gorm.DB) *AccountDeletionModel {
+	return &AccountDeletionModel{db: db}
+}
+
+// Schedule 登记注销申请；已有申请时保留原申请时间与发起人，清除时间取两者中较早的一个（管理员可提前，重复申请不会推迟）
+func (m *AccountDeletionModel) Schedule(userID, requestedBy string, purgeAt time.Time) (*AccountDeletion, error) {
+	var d AccountDeletion
+	err := m.db.Raw(`
+		INSERT INTO account_deletions (user_id, requested_by, requested_at, purge_at) VALUES (?, ?, NOW(), ?)
+		ON CONFLICT (user_id) DO UPDATE SET purge_at = LEAST(account_deletions.purge_at, EXCLUDED.purge_at)
+		RETURNING user_id, requested_by, requested_at, purge_at`,
+		userID, requestedBy, purgeAt).Scan(&d).Error
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (m *AccountDeletionModel) Find(userID string) (*AccountDeletion, error) {
+	var d AccountDeletion
+	if err := m.db.Where("user_id = ?", userID).First(&d).Error; err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// Cancel 撤销注销申请；没有待处理的申请时返回 gorm.ErrRecordNotFound
+func (m *AccountDeletionModel) Cancel(userID string) error {
+	res := m.db.Where("user_id = ?", userID).Delete(&AccountDeletion{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListDue 返回至多 limit 个清除时间已到的用户 ID
+func (m *AccountDeletionModel) ListDue(now time.Time, limit int) ([]string, error) {
+	var ids []string
+	err := m.db.Model(&AccountDeletion{}).
+		Where("purge_at <= ?", now).
+		Order("purge_at ASC").
+		Limit(limit).
+		Pluck("user_id", &ids).Error
+	return ids, err
+}
+
+// Purge 在一个事务内清除账号（导出任务及其归档一并删除）。
+// 申请已被撤销（或已被其他副本处理）时返回 gorm.ErrRecordNotFound，申请行加锁保证同一账号只被清除一次。
+// - 发送与接收的消息改挂到 PlaceholderUserID，消息内容保留，其他成员的聊天记录不受影响；
+// - 对方通讯录中的该联系人软删除，增量同步据此下发删除；本人的联系人、申请、拉黑、隐私、推荐、资料、角色直接删除；
+// - 会话成员关系（退群、群主转让、频道订阅、入群申请）不在这里处理，调用方须先通过 ConversationService.RemoveUserEverywhere 完成；
+// - users 行保留为 status = deleted 的墓碑，用户名改为 deleted_<id>，密码、邮箱、手机号清空，改名保留的旧用户名一并释放。
+func (m *AccountDeletionModel) Purge(userID string) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		var d AccountDeletion
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).First(&d).Error; err != nil {
+			return err
+		}
+		args := map[string]interface{}{"user": userID, "placeholder": PlaceholderUserID}
+		stmts := []string{
+			`UPDATE messages SET from_user_id = @placeholder WHERE from_user_id = @user`,
+			`UPDATE messages SET to_user_id = @placeholder WHERE to_user_id = @user`,
+			`DELETE FROM scheduled_messages WHERE from_user_id = @user`,
+			`UPDATE contacts SET status = 'removed' WHERE contact_user_id = @user AND status = 'accepted'`,
+			`DELETE FROM contacts WHERE owner_id = @user`,
+			`DELETE FROM contact_requests WHERE from_user_id = @user OR to_user_id = @user`,
+			`DELETE FROM user_blocks WHERE blocker_id = @user OR blocked_id = @user`,
+			`DELETE FROM user_privacy_settings WHERE user_id = @user`,
+			`DELETE FROM contact_suggestions WHERE user_id = @user OR suggested_user_id = @user`,
+			`DELETE FROM contact_suggestion_state WHERE user_id = @user`,
+			`DELETE FROM user_profiles WHERE user_id = @user`,
+			`DELETE FROM user_roles WHERE user_id = @user`,
+			`DELETE FROM username_reservations WHERE user_id = @user`,
+			`DELETE FROM conversation_read WHERE user_id = @user`,
+			`DELETE FROM message_hidden WHERE user_id = @user`,
+			`DELETE FROM conversation_clear WHERE user_id = @user`,
+		}
+		for _, s := range stmts {
+			if err := tx.Exec(s, args).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Exec(`DELETE FROM user_data_exports WHERE user_id = ?`, userID).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(`UPDATE users SET status = ?, username = 'deleted_' || id, password_hash = '',
+			email = NULL, email_verified_at = NULL, phone = NULL, phone_verified_at = NULL, updated_at = NOW() WHERE id = ?`,
+			UserStatusDeleted, userID).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&AccountDeletion{}).Error
+	})
+}
@@ -3,15 +3,27 @@ package model
 import (
 	"time"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
-// Contact 对应 contacts 表，owner_id 与 contact_user_id 均为 10 位用户 ID
+// 联系人状态：removed 为软删除，保留记录用于增量同步
+const (
+	ContactStatusAccepted = "accepted"
+	ContactStatusRemoved  = "removed"
+)
+
+// Contact 对应 contacts 表，owner_id 与 contact_user_id 均为 10 位用户 ID；Remark/Tags/Starred/Note 仅 owner 可见
 type Contact struct {
-	OwnerID       string    `gorm:"column:owner_id;type:char(10);primaryKey"`
-	ContactUserID string    `gorm:"column:contact_user_id;type:char(10);primaryKey"`
-	Status        string    `gorm:"column:status;type:text;not null;default:accepted"`
-	CreatedAt     time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+	OwnerID       string         `gorm:"column:owner_id;type:char(10);primaryKey"`
+	ContactUserID string         `gorm:"column:contact_user_id;type:char(10);primaryKey"`
+	Status        string         `gorm:"column:status;type:text;not null;default:accepted"`
+	CreatedAt     time.Time      `gorm:"column:created_at;type:timestamptz;not null"`
+	Remark        string         `gorm:"column:remark;type:text;not null;default:''"`
+	Tags          pq.StringArray `gorm:"column:tags;type:text[];not null;default:'{}'"`
+	Starred       bool           `gorm:"column:starred;not null;default:false"`
+	Note          string         `gorm:"column:note;type:text;not null;default:''"`
+	UpdatedAt     time.Time      `gorm:"column:updated_at;type:timestamptz;not null;default:now()"`
 }
 
 func (Contact) TableName() string {
@@ -26,27 +38,114 @@ func NewContactModel(db *gorm.DB) *ContactModel {
 	return &ContactModel{db: db}
 }
 
+// Add 添加联系人；已删除的联系人恢复为 accepted 并清空备注等信息，已是联系人时不做修改
 func (m *ContactModel) Add(ownerID, contactUserID string) error {
-	return m.db.Create(&Contact{
-		OwnerID:       ownerID,
-		ContactUserID: contactUserID,
-		Status:        "accepted",
-		CreatedAt:     time.Now(),
-	}).Error
+	return addContact(m.db, ownerID, contactUserID, time.Now())
 }
 
+func addContact(db *gorm.DB, ownerID, contactUserID string, now time.Time) error {
+	return db.Exec(`
+		INSERT INTO contacts (owner_id, contact_user_id, status, created_at, updated_at)
+		VALUES (?, ?, 'accepted', ?, ?)
+		ON CONFLICT (owner_id, contact_user_id) DO UPDATE
+		SET status = 'accepted', created_at = EXCLUDED.created_at, remark = '', tags = '{}', starred = FALSE, note = ''
+		WHERE contacts.status = 'removed'`,
+		ownerID, contactUserID, now, now).Error
+}
+
+// Remove 软删除联系人并清空备注等信息
 func (m *ContactModel) Remove(ownerID, contactUserID string) error {
-	return m.db.Where("owner_id = ? AND contact_user_id = ?", ownerID, contactUserID).Delete(&Contact{}).Error
+	return m.db.Model(&Contact{}).
+		Where("owner_id = ? AND contact_user_id = ? AND status = ?", ownerID, contactUserID, ContactStatusAccepted).
+		Updates(map[string]interface{}{
+			"status":  ContactStatusRemoved,
+			"remark":  "",
+			"tags":    pq.StringArray{},
+			"starred": false,
+			"note":    "",
+		}).Error
 }
 
 func (m *ContactModel) ListContactUserIDs(ownerID string) ([]string, error) {
 	var ids []string
-	err := m.db.Model(&Contact{}).Where("owner_id = ?", ownerID).Pluck("contact_user_id", &ids).Error
+	err := m.db.Model(&Contact{}).Where("owner_id = ? AND status = ?", ownerID, ContactStatusAccepted).Pluck("contact_user_id", &ids).Error
 	return ids, err
 }
 
 func (m *ContactModel) Exists(ownerID, contactUserID string) (bool, error) {
 	var n int64
-	err := m.db.Model(&Contact{}).Where("owner_id = ? AND contact_user_id = ?", ownerID, contactUserID).Count(&n).Error
+	err := m.db.Model(&Contact{}).
+		Where("owner_id = ? AND contact_user_id = ? AND status = ?", ownerID, contactUserID, ContactStatusAccepted).
+		Count(&n).Error
+	return n > 0, err
+}
+
+// Find 查询一条有效联系人记录
+func (m *ContactModel) Find(ownerID, contactUserID string) (*Contact, error) {
+	var c Contact
+	err := m.db.Where("owner_id = ? AND contact_user_id = ? AND status = ?", ownerID, contactUserID, ContactStatusAccepted).First(&c).Error
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// UpdateMeta 修改备注、标签、星标、备忘，updates 的键为列名；联系人不存在时返回 gorm.ErrRecordNotFound
+func (m *ContactModel) UpdateMeta(ownerID, contactUserID string, updates map[string]interface{}) error {
+	res := m.db.Model(&Contact{}).
+		Where("owner_id = ? AND contact_user_id = ? AND status = ?", ownerID, contactUserID, ContactStatusAccepted).
+		Updates(updates)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ContactFilter 联系人列表过滤条件，零值表示不过滤
+type ContactFilter struct {
+	StarredOnly bool
+	Tag         string
+}
+
+// List 按 contact_user_id 正序分页列出有效联系人，afterUserID 为上一页最后一条
+func (m *ContactModel) List(ownerID string, f ContactFilter, afterUserID string, limit int) ([]*Contact, error) {
+	q := m.db.Where("owner_id = ? AND status = ?", ownerID, ContactStatusAccepted)
+	if f.StarredOnly {
+		q = q.Where("starred")
+	}
+	if f.Tag != "" {
+		q = q.Where("tags @> ?", pq.StringArray{f.Tag})
+	}
+	if afterUserID != "" {
+		q = q.Where("contact_user_id > ?", afterUserID)
+	}
+	var list []*Contact
+	err := q.Order("contact_user_id").Limit(limit).Find(&list).Error
+	return list, err
+}
+
+// ListChangedSince 增量同步：返回 (updated_at, contact_user_id) 在同步点之后的联系人，含已删除的记录，按变化时间正序
+func (m *ContactModel) ListChangedSince(ownerID string, since time.Time, afterUserID string, limit int) ([]*Contact, error) {
+	var list []*Contact
+	err := m.db.Where("owner_id = ? AND (updated_at, contact_user_id) > (?, ?)", ownerID, since, afterUserID).
+		Order("updated_at, contact_user_id").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// HasMutualContact 判断 a 与 b 是否有共同联系人（a 的某个联系人同时也是 b 的联系人）
+func (m *ContactModel) HasMutualContact(a, b string) (bool, error) {
+	var n int64
+	err := m.db.Raw(`
+		SELECT COUNT(*) FROM (
+			SELECT 1 FROM contacts ca
+			JOIN contacts cb ON cb.contact_user_id = ca.contact_user_id
+			WHERE ca.owner_id = ? AND cb.owner_id = ? AND ca.status = 'accepted' AND cb.status = 'accepted'
+			LIMIT 1
+		) t`, a, b).Scan(&n).Error
 	return n > 0, err
 }
@@ -1,21 +1,35 @@
 package model
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
-// ContactRequest 对应 contact_requests 表
+// 好友申请状态
+const (
+	ContactRequestPending   = "pending"
+	ContactRequestAccepted  = "accepted"
+	ContactRequestDeclined  = "declined"
+	ContactRequestWithdrawn = "withdrawn"
+	ContactRequestExpired   = "expired"
+)
+
+// ErrContactRequestCooldown 上一次申请被拒绝或撤回后仍在冷却期内
+var ErrContactRequestCooldown = errors.New("contact request cooldown")
+
+// ContactRequest 对应 contact_requests 表；同一对用户可有多条历史申请，但同时只有一条 pending
 type ContactRequest struct {
 	ID         string    `gorm:"column:id;type:uuid;primaryKey"`
-	FromUserID string    `gorm:"column:from_user_id;type:varchar(10);not null;uniqueIndex:uq_contact_request_pair"`
-	ToUserID   string    `gorm:"column:to_user_id;type:varchar(10);not null;uniqueIndex:uq_contact_request_pair"`
+	FromUserID string    `gorm:"column:from_user_id;type:varchar(10);not null"`
+	ToUserID   string    `gorm:"column:to_user_id;type:varchar(10);not null"`
 	Status     string    `gorm:"column:status;type:text;not null;default:pending"`
 	Message    string    `gorm:"column:message;type:text;not null;default:''"`
 	CreatedAt  time.Time `gorm:"column:created_at;type:timestamptz;not null"`
 	UpdatedAt  time.Time `gorm:"column:updated_at;type:timestamptz;not null"`
+	ExpiresAt  time.Time `gorm:"column:expires_at;type:timestamptz;not null"`
 }
 
 func (ContactRequest) TableName() string {
@@ -30,48 +44,68 @@ func NewContactRequestModel(db *gorm.DB) *ContactRequestModel {
 	return &ContactRequestModel{db: db}
 }
 
-// CreateOrReapply 创建申请；若已存在且为 declined 则更新为 pending 并返回
-func (m *ContactRequestModel) CreateOrReapply(fromUserID, toUserID, message string) (*ContactRequest, error) {
+// Create 发起申请：已有未过期的 pending 申请时原样返回（created=false）；
+// 最近一次申请在 cooldown 内被拒绝或撤回时返回 ErrContactRequestCooldown；否则新建一条 pending 申请，有效期 ttl。
+// 同一对用户的并发调用按 (from, to) 串行
+func (m *ContactRequestModel) Create(fromUserID, toUserID, message string, ttl, cooldown time.Duration) (req *ContactRequest, created bool, err error) {
 	now := time.Now()
-	var existing ContactRequest
-	err := m.db.Where("from_user_id = ? AND to_user_id = ?", fromUserID, toUserID).First(&existing).Error
-	if err == nil {
-		if existing.Status == "pending" {
-			return &existing, nil
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		// 同一对用户的并发申请在事务级 advisory 锁上串行，后到者能看到先到者新建的 pending，不会撞唯一索引
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext('contact_request'), hashtext(? || ':' || ?))", fromUserID, toUserID).Error; err != nil {
+			return err
 		}
-		if existing.Status == "accepted" {
-			return nil, gorm.ErrRecordNotFound // 已通过，可返回已存在或业务错误
+		var last ContactRequest
+		err := tx.Where("from_user_id = ? AND to_user_id = ?", fromUserID, toUserID).Order("updated_at DESC").First(&last).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			return err
 		}
-		// declined：更新为 pending
-		existing.Status = "pending"
-		existing.Message = message
-		existing.UpdatedAt = now
-		if err := m.db.Save(&existing).Error; err != nil {
-			return nil, err
+		if err == nil {
+			switch last.Status {
+			case ContactRequestPending:
+				if last.ExpiresAt.After(now) {
+					req = &last
+					return nil
+				}
+				// 已过期的 pending 先落为 expired，腾出唯一索引
+				if err := tx.Model(&ContactRequest{}).Where("id = ?", last.ID).Updates(map[string]interface{}{"status": ContactRequestExpired, "updated_at": now}).Error; err != nil {
+					return err
+				}
+			case ContactRequestDeclined, ContactRequestWithdrawn:
+				if cooldown > 0 && now.Sub(last.UpdatedAt) < cooldown {
+					return ErrContactRequestCooldown
+				}
+			}
 		}
-		return &existing, nil
-	}
-	if err != gorm.ErrRecordNotFound {
-		return nil, err
-	}
-	req := &ContactRequest{
-		ID:         uuid.New().String(),
-		FromUserID: fromUserID,
-		ToUserID:   toUserID,
-		Status:     "pending",
-		Message:    message,
-		CreatedAt:  now,
-		UpdatedAt:  now,
-	}
-	if err := m.db.Create(req).Error; err != nil {
-		return nil, err
+		req = &ContactRequest{
+			ID:         uuid.New().String(),
+			FromUserID: fromUserID,
+			ToUserID:   toUserID,
+			Status:     ContactRequestPending,
+			Message:    message,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			ExpiresAt:  now.Add(ttl),
+		}
+		created = true
+		return tx.Create(req).Error
+	})
+	if err != nil {
+		return nil, false, err
 	}
-	return req, nil
+	return req, created, nil
 }
 
+// ListPendingByToUser 发给 toUserID 且未过期的 pending 申请
 func (m *ContactRequestModel) ListPendingByToUser(toUserID string) ([]*ContactRequest, error) {
 	var list []*ContactRequest
-	err := m.db.Where("to_user_id = ? AND status = ?", toUserID, "pending").Order("created_at DESC").Find(&list).Error
+	err := m.db.Where("to_user_id = ? AND status = ? AND expires_at > ?", toUserID, ContactRequestPending, time.Now()).Order("created_at DESC").Find(&list).Error
+	return list, err
+}
+
+// ListPendingByFromUser fromUserID 发出且未过期的 pending 申请，供发起方查看与撤回
+func (m *ContactRequestModel) ListPendingByFromUser(fromUserID string) ([]*ContactRequest, error) {
+	var list []*ContactRequest
+	err := m.db.Where("from_user_id = ? AND status = ? AND expires_at > ?", fromUserID, ContactRequestPending, time.Now()).Order("created_at DESC").Find(&list).Error
 	return list, err
 }
 
@@ -83,29 +117,51 @@ func (m *ContactRequestModel) FindByID(requestID string) (*ContactRequest, error
 	return &req, nil
 }
 
-func (m *ContactRequestModel) Accept(requestID, toUserID string) error {
-	return m.db.Transaction(func(tx *gorm.DB) error {
-		var req ContactRequest
-		if err := tx.Where("id = ? AND to_user_id = ? AND status = ?", requestID, toUserID, "pending").First(&req).Error; err != nil {
+// Accept 接收方同意未过期的 pending 申请并双向添加好友，返回该申请
+func (m *ContactRequestModel) Accept(requestID, toUserID string) (*ContactRequest, error) {
+	var req ContactRequest
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Where("id = ? AND to_user_id = ? AND status = ? AND expires_at > ?", requestID, toUserID, ContactRequestPending, now).First(&req).Error; err != nil {
 			return err
 		}
-		now := time.Now()
-		if err := tx.Model(&ContactRequest{}).Where("id = ?", requestID).Updates(map[string]interface{}{"status": "accepted", "updated_at": now}).Error; err != nil {
+		if err := tx.Model(&ContactRequest{}).Where("id = ?", requestID).Updates(map[string]interface{}{"status": ContactRequestAccepted, "updated_at": now}).Error; err != nil {
 			return err
 		}
-		// 双向添加好友
-		if err := tx.Create(&Contact{OwnerID: toUserID, ContactUserID: req.FromUserID, Status: "accepted", CreatedAt: now}).Error; err != nil {
+		// 双向添加好友；一方已有（或曾删除）该联系人时沿用 addContact 的恢复语义
+		if err := addContact(tx, toUserID, req.FromUserID, now); err != nil {
 			return err
 		}
-		if err := tx.Create(&Contact{OwnerID: req.FromUserID, ContactUserID: toUserID, Status: "accepted", CreatedAt: now}).Error; err != nil {
+		if err := addContact(tx, req.FromUserID, toUserID, now); err != nil {
 			return err
 		}
-		return nil
+		// 对方同时也向我发过申请时一并结束，避免成为好友后还留着一条 pending
+		return tx.Model(&ContactRequest{}).
+			Where("from_user_id = ? AND to_user_id = ? AND status = ?", toUserID, req.FromUserID, ContactRequestPending).
+			Updates(map[string]interface{}{"status": ContactRequestAccepted, "updated_at": now}).Error
 	})
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
 }
 
+// Decline 接收方拒绝未过期的 pending 申请
 func (m *ContactRequestModel) Decline(requestID, toUserID string) error {
-	res := m.db.Model(&ContactRequest{}).Where("id = ? AND to_user_id = ? AND status = ?", requestID, toUserID, "pending").Updates(map[string]interface{}{"status": "declined", "updated_at": time.Now()})
+	return m.closePending("id = ? AND to_user_id = ?", requestID, toUserID, ContactRequestDeclined)
+}
+
+// Withdraw 发起方撤回未过期的 pending 申请
+func (m *ContactRequestModel) Withdraw(requestID, fromUserID string) error {
+	return m.closePending("id = ? AND from_user_id = ?", requestID, fromUserID, ContactRequestWithdrawn)
+}
+
+func (m *ContactRequestModel) closePending(cond, requestID, userID, status string) error {
+	now := time.Now()
+	res := m.db.Model(&ContactRequest{}).
+		Where(cond, requestID, userID).
+		Where("status = ? AND expires_at > ?", ContactRequestPending, now).
+		Updates(map[string]interface{}{"status": status, "updated_at": now})
 	if res.Error != nil {
 		return res.Error
 	}
@@ -0,0 +1,134 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ContactSuggestion 对应 contact_suggestions 表，预先计算好的好友推荐
+type ContactSuggestion struct {
+	UserID          string `gorm:"column:user_id;type:varchar(10);primaryKey"`
+	SuggestedUserID string `gorm:"column:suggested_user_id;type:varchar(10);primaryKey"`
+	MutualContacts  int    `gorm:"column:mutual_contacts;not null"`
+	SharedGroups    int    `gorm:"column:shared_groups;not null"`
+	Score           int    `gorm:"column:score;not null"`
+}
+
+func (ContactSuggestion) TableName() string {
+	return "contact_suggestions"
+}
+
+// ContactSuggestionState 对应 contact_suggestion_state 表
+type ContactSuggestionState struct {
+	UserID      string    `gorm:"column:user_id;type:varchar(10);primaryKey"`
+	ComputedAt  time.Time `gorm:"column:computed_at;type:timestamptz;not null"`
+	RequestedAt time.Time `gorm:"column:requested_at;type:timestamptz;not null"`
+}
+
+func (ContactSuggestionState) TableName() string {
+	return "contact_suggestion_state"
+}
+
+type ContactSuggestionModel struct {
+	db *gorm.DB
+}
+
+func NewContactSuggestionModel(db *gorm.DB) *ContactSuggestionModel {
+	return &ContactSuggestionModel{db: db}
+}
+
+// Recompute 重新计算 userID 的推荐并整份替换，只保留得分最高的 keep 条。
+// 候选为好友的好友（每个共同好友计 1）与同在 active 群聊中的成员（每个共同群计 1），得分 = 共同好友 * 2 + 共同群；
+// 成员数超过 maxGroupSize 的大群不参与计算，避免结果被大群成员淹没、单次计算过慢。
+func (m *ContactSuggestionModel) Recompute(userID string, maxGroupSize, keep int) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&ContactSuggestion{}).Error; err != nil {
+			return err
+		}
+		err := tx.Exec(`
+			INSERT INTO contact_suggestions (user_id, suggested_user_id, mutual_contacts, shared_groups, score)
+			SELECT @user, t.uid, SUM(t.mc), SUM(t.sg), SUM(t.mc) * 2 + SUM(t.sg)
+			FROM (
+				SELECT c2.contact_user_id AS uid, 1 AS mc, 0 AS sg
+				FROM contacts c1
+				JOIN contacts c2 ON c2.owner_id = c1.contact_user_id AND c2.status = 'accepted'
+				WHERE c1.owner_id = @user AND c1.status = 'accepted'
+				UNION ALL
+				SELECT m2.user_id AS uid, 0 AS mc, 1 AS sg
+				FROM conversation_members m1
+				JOIN conversations cv ON cv.id = m1.conversation_id AND cv.type = 'group' AND cv.status = 'active'
+				JOIN conversation_members m2 ON m2.conversation_id = m1.conversation_id AND m2.status = 'active'
+				WHERE m1.user_id = @user AND m1.status = 'active'
+					AND (SELECT COUNT(*) FROM conversation_members x WHERE x.conversation_id = m1.conversation_id AND x.status = 'active') <= @max_group
+			) t
+			JOIN users u ON u.id = t.uid AND u.status = 'normal'
+			WHERE t.uid <> @user
+				AND NOT EXISTS (SELECT 1 FROM contacts c WHERE c.owner_id = @user AND c.contact_user_id = t.uid AND c.status = 'accepted')
+			GROUP BY t.uid
+			ORDER BY SUM(t.mc) * 2 + SUM(t.sg) DESC, t.uid
+			LIMIT @keep
+			ON CONFLICT (user_id, suggested_user_id) DO UPDATE
+				SET mutual_contacts = EXCLUDED.mutual_contacts, shared_groups = EXCLUDED.shared_groups, score = EXCLUDED.score`,
+			map[string]interface{}{
+				"user":      userID,
+				"max_group": maxGroupSize,
+				"keep":      keep,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Exec(`
+			INSERT INTO contact_suggestion_state (user_id, computed_at, requested_at) VALUES (?, NOW(), NOW())
+			ON CONFLICT (user_id) DO UPDATE SET computed_at = EXCLUDED.computed_at`, userID).Error
+	})
+}
+
+// FindState 返回 userID 的计算状态；从未计算过时返回 gorm.ErrRecordNotFound
+func (m *ContactSuggestionModel) FindState(userID string) (*ContactSuggestionState, error) {
+	var st ContactSuggestionState
+	if err := m.db.Where("user_id = ?", userID).First(&st).Error; err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// Touch 记录 userID 最近一次请求推荐的时间，后台据此决定是否重算
+func (m *ContactSuggestionModel) Touch(userID string) error {
+	return m.db.Model(&ContactSuggestionState{}).Where("user_id = ?", userID).Update("requested_at", time.Now()).Error
+}
+
+// List 按得分倒序分页返回推荐，读取时排除已是好友、任一方拉黑对方、任一方有未过期待处理申请以及状态异常的用户
+func (m *ContactSuggestionModel) List(userID string, offset, limit int) ([]*ContactSuggestion, error) {
+	var list []*ContactSuggestion
+	err := m.db.Raw(`
+		SELECT s.user_id, s.suggested_user_id, s.mutual_contacts, s.shared_groups, s.score
+		FROM contact_suggestions s
+		JOIN users u ON u.id = s.suggested_user_id AND u.status = 'normal'
+		LEFT JOIN user_profiles p ON p.user_id = u.id
+		WHERE s.user_id = @user AND COALESCE(p.status, 'normal') <> 'banned'
+			AND NOT EXISTS (SELECT 1 FROM contacts c WHERE c.owner_id = @user AND c.contact_user_id = s.suggested_user_id AND c.status = 'accepted')
+			AND NOT EXISTS (SELECT 1 FROM user_blocks b
+				WHERE (b.blocker_id = @user AND b.blocked_id = s.suggested_user_id) OR (b.blocker_id = s.suggested_user_id AND b.blocked_id = @user))
+			AND NOT EXISTS (SELECT 1 FROM contact_requests r
+				WHERE r.status = 'pending' AND r.expires_at > NOW()
+					AND ((r.from_user_id = @user AND r.to_user_id = s.suggested_user_id) OR (r.from_user_id = s.suggested_user_id AND r.to_user_id = @user)))
+		ORDER BY s.score DESC, s.suggested_user_id
+		OFFSET @offset LIMIT @limit`,
+		map[string]interface{}{
+			"user":   userID,
+			"offset": offset,
+			"limit":  limit,
+		}).Scan(&list).Error
+	return list, err
+}
+
+// ListStale 返回 activeSince 之后请求过推荐、且上次计算早于 before 的用户，供后台重算
+func (m *ContactSuggestionModel) ListStale(before, activeSince time.Time, limit int) ([]string, error) {
+	var ids []string
+	err := m.db.Model(&ContactSuggestionState{}).
+		Where("computed_at < ? AND requested_at > ?", before, activeSince).
+		Order("computed_at").Limit(limit).
+		Pluck("user_id", &ids).Error
+	return ids, err
+}
@@ -0,0 +1,246 @@
+package model
+
+import (
+	"io"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 个人数据导出任务状态
+const (
+	DataExportPending = "pending"
+	DataExportRunning = "running"
+	DataExportReady   = "ready"
+	DataExportFailed  = "failed"
+)
+
+// DataExport 对应 user_data_exports 表
+type DataExport struct {
+	ID          string     `gorm:"column:id;type:uuid;primaryKey;default:gen_random_uuid()"`
+	UserID      string     `gorm:"column:user_id;type:varchar(10);not null"`
+	RequestedBy string     `gorm:"column:requested_by;type:varchar(10);not null;default:''"`
+	Status      string     `gorm:"column:status;type:text;not null;default:pending"`
+	ChunkCount  int        `gorm:"column:chunk_count;not null;default:0"`
+	SizeBytes   int64      `gorm:"column:size_bytes;not null;default:0"`
+	Error       string     `gorm:"column:error;type:text;not null;default:''"`
+	CreatedAt   time.Time  `gorm:"column:created_at;type:timestamptz;not null"`
+	StartedAt   *time.Time `gorm:"column:started_at;type:timestamptz"`
+	CompletedAt *time.Time `gorm:"column:completed_at;type:timestamptz"`
+	ExpiresAt   *time.Time `gorm:"column:expires_at;type:timestamptz"`
+}
+
+func (DataExport) TableName() string {
+	return "user_data_exports"
+}
+
+// DataExportChunk 对应 user_data_export_chunks 表，归档按 seq 顺序拼接
+type DataExportChunk struct {
+	ExportID string `gorm:"column:export_id;type:uuid;primaryKey"`
+	Seq      int    `gorm:"column:seq;primaryKey"`
+	Data     []byte `gorm:"column:data;type:bytea;not null"`
+}
+
+func (DataExportChunk) TableName() string {
+	return "user_data_export_chunks"
+}
+
+// ExportedConversation 导出归档中的会话条目：用户所在（或曾在）的会话及其成员身份
+type ExportedConversation struct {
+	ConversationID string    `json:"conversationId" gorm:"column:conversation_id"`
+	Type           string    `json:"type" gorm:"column:type"`
+	Name           string    `json:"name" gorm:"column:name"`
+	Role           string    `json:"role" gorm:"column:role"`
+	Status         string    `json:"status" gorm:"column:status"`
+	Nickname       string    `json:"nickname" gorm:"column:nickname"`
+	Alias          string    `json:"alias" gorm:"column:alias"`
+	JoinedAt       time.Time `json:"joinedAt" gorm:"column:joined_at"`
+}
+
+// ExportedMessage 导出归档中的消息条目：仅包含用户本人发送的消息
+type ExportedMessage struct {
+	ID             string `json:"-" gorm:"column:id"`
+	ServerMsgID    string `json:"serverMsgId" gorm:"column:server_msg_id"`
+	ConversationID string `json:"conversationId" gorm:"column:conversation_id"`
+	ToUserID       string `json:"toUserId,omitempty" gorm:"column:to_user_id"`
+	BodyType       string `json:"bodyType" gorm:"column:body_type"`
+	BodyText       string `json:"bodyText" gorm:"column:body_text"`
+	ServerTime     int64  `json:"serverTime" gorm:"column:server_time"`
+}
+
+type DataExportModel struct {
+	db *gorm.DB
+}
+
+func NewDataExportModel(db *gorm.DB) *DataExportModel {
+	return &DataExportModel{db: db}
+}
+
+// Create 创建导出任务；该用户已有 pending / running 的任务时直接返回该任务（created=false），不重复排队
+func (m *DataExportModel) Create(userID, requestedBy string) (exp *DataExport, created bool, err error) {
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		// 锁住用户行，保证同一用户并发申请时只排一个任务
+		if err := tx.Exec(`SELECT 1 FROM users WHERE id = ? FOR UPDATE`, userID).Error; err != nil {
+			return err
+		}
+		var active DataExport
+		err := tx.Where("user_id = ? AND status IN ?", userID, []string{DataExportPending, DataExportRunning}).
+			Order("created_at DESC").First(&active).Error
+		if err == nil {
+			exp = &active
+			return nil
+		}
+		if err != gorm.ErrRecordNotFound {
+			return err
+		}
+		e := &DataExport{UserID: userID, RequestedBy: requestedBy, Status: DataExportPending, CreatedAt: time.Now()}
+		if err := tx.Create(e).Error; err != nil {
+			return err
+		}
+		exp, created = e, true
+		return nil
+	})
+	return exp, created, err
+}
+
+func (m *DataExportModel) Find(userID, id string) (*DataExport, error) {
+	var e DataExport
+	if err := m.db.Where("id = ? AND user_id = ?", id, userID).First(&e).Error; err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// Claim 领取一个待执行的任务并标记为 running；running 超过 staleAfter 仍未完成（如进程崩溃）的任务可被重新领取。
+// SKIP LOCKED 保证多副本并发时同一任务只被一个副本领取；没有可领取的任务时返回 gorm.ErrRecordNotFound
+func (m *DataExportModel) Claim(staleAfter time.Duration) (*DataExport, error) {
+	var e DataExport
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND started_at < ?)", DataExportPending, DataExportRunning, now.Add(-staleAfter)).
+			Order("created_at ASC").
+			First(&e).Error; err != nil {
+			return err
+		}
+		e.Status = DataExportRunning
+		e.StartedAt = &now
+		return tx.Model(&DataExport{}).Where("id = ?", e.ID).
+			Updates(map[string]interface{}{"status": DataExportRunning, "started_at": now}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// MarkReady 在一个事务内将归档 r 按 chunkSize 分块写入 user_data_export_chunks 并标记为 ready，返回归档字节数；
+// 先删除该任务已有的分块（任务被重新领取时可能残留）
+func (m *DataExportModel) MarkReady(id string, r io.Reader, chunkSize int, expiresAt time.Time) (int64, error) {
+	var size int64
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("export_id = ?", id).Delete(&DataExportChunk{}).Error; err != nil {
+			return err
+		}
+		buf := make([]byte, chunkSize)
+		seq := 0
+		for {
+			n, err := io.ReadFull(r, buf)
+			if n > 0 {
+				if err := tx.Create(&DataExportChunk{ExportID: id, Seq: seq, Data: buf[:n]}).Error; err != nil {
+					return err
+				}
+				seq++
+				size += int64(n)
+			}
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(&DataExport{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"status":       DataExportReady,
+			"chunk_count":  seq,
+			"size_bytes":   size,
+			"error":        "",
+			"completed_at": time.Now(),
+			"expires_at":   expiresAt,
+		}).Error
+	})
+	return size, err
+}
+
+// Chunk 读取归档的第 seq 个分块
+func (m *DataExportModel) Chunk(exportID string, seq int) ([]byte, error) {
+	var c DataExportChunk
+	if err := m.db.Where("export_id = ? AND seq = ?", exportID, seq).First(&c).Error; err != nil {
+		return nil, err
+	}
+	return c.Data, nil
+}
+
+func (m *DataExportModel) MarkFailed(id, msg string) error {
+	return m.db.Model(&DataExport{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":       DataExportFailed,
+		"error":        msg,
+		"completed_at": time.Now(),
+	}).Error
+}
+
+// DeleteExpired 删除至多 limit 个已过期的归档任务（分块级联删除），返回删除的任务数
+func (m *DataExportModel) DeleteExpired(now time.Time, limit int) (int64, error) {
+	res := m.db.Exec(`
+		DELETE FROM user_data_exports
+		WHERE id IN (
+			SELECT id FROM user_data_exports
+			WHERE expires_at IS NOT NULL AND expires_at <= ?
+			ORDER BY expires_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)`, now, limit)
+	return res.RowsAffected, res.Error
+}
+
+// ListContacts 返回 ownerID 当前全部联系人（含备注、标签等），用于导出
+func (m *DataExportModel) ListContacts(ownerID string) ([]*Contact, error) {
+	var list []*Contact
+	err := m.db.Where("owner_id = ? AND status = ?", ownerID, ContactStatusAccepted).
+		Order("created_at ASC, contact_user_id ASC").
+		Find(&list).Error
+	return list, err
+}
+
+// ListBlocked 返回 blockerID 拉黑的全部用户，用于导出
+func (m *DataExportModel) ListBlocked(blockerID string) ([]*UserBlock, error) {
+	var list []*UserBlock
+	err := m.db.Where("blocker_id = ?", blockerID).Order("created_at ASC").Find(&list).Error
+	return list, err
+}
+
+// ListConversations 返回用户的全部会话成员记录（含已退出的），用于导出
+func (m *DataExportModel) ListConversations(userID string) ([]*ExportedConversation, error) {
+	var list []*ExportedConversation
+	err := m.db.Raw(`
+		SELECT m.conversation_id, c.type, c.name, m.role, m.status, m.nickname, m.alias, m.joined_at
+		FROM conversation_members m
+		JOIN conversations c ON c.id = m.conversation_id
+		WHERE m.user_id = ?
+		ORDER BY m.joined_at ASC, m.conversation_id ASC`, userID).Scan(&list).Error
+	return list, err
+}
+
+// ListMessages 按 (server_time, id) 正序游标分页读取用户本人发送的消息；afterID 为空表示从头开始
+func (m *DataExportModel) ListMessages(userID string, afterTime int64, afterID string, limit int) ([]*ExportedMessage, error) {
+	var list []*ExportedMessage
+	q := m.db.Table("messages").
+		Select("id, server_msg_id, conversation_id, COALESCE(to_user_id, '') AS to_user_id, body_type, body_text, server_time").
+		Where("from_user_id = ?", userID)
+	if afterID != "" {
+		q = q.Where("(server_time, id) > (?, ?)", afterTime, afterID)
+	}
+	err := q.Order("server_time ASC, id ASC").Limit(limit).Scan(&list).Error
+	return list, err
+}
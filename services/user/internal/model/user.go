@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -14,6 +15,9 @@ type User struct {
 	Status       string    `gorm:"column:status;type:text;not null;default:'normal'"`
 	CreatedAt    time.Time `gorm:"column:created_at;type:timestamptz;not null;default:now()"`
 	UpdatedAt    time.Time `gorm:"column:updated_at;type:timestamptz;not null;default:now()"`
+	// 已验证的邮箱、手机号由 AuthService 绑定，这里只读，用于个人数据导出
+	Email *string `gorm:"column:email;type:text"`
+	Phone *string `gorm:"column:phone;type:text"`
 }
 
 func (User) TableName() string {
@@ -52,6 +56,16 @@ type UserProfile struct {
 	Bio       string    `gorm:"column:bio;type:text;not null;default:''"`
 	Status    string    `gorm:"column:status;type:text;not null;default:'normal'"`
 	UpdatedAt time.Time `gorm:"column:updated_at;type:timestamptz;not null;default:now()"`
+	// Discoverable 为 false 时不出现在 SearchUsers 结果中
+	Discoverable bool `gorm:"column:discoverable;not null;default:true"`
+	// LastSeenAt 最后一个在线会话结束的时间，由 Presence 服务写入；nil 表示从未记录
+	LastSeenAt *time.Time `gorm:"column:last_seen_at;type:timestamptz"`
+	// 自定义状态；CustomStatusExpiresAt 为 nil 表示不过期
+	CustomStatusEmoji     string     `gorm:"column:custom_status_emoji;type:text;not null;default:''"`
+	CustomStatusText      string     `gorm:"column:custom_status_text;type:text;not null;default:''"`
+	CustomStatusExpiresAt *time.Time `gorm:"column:custom_status_expires_at;type:timestamptz"`
+	// Version 资料版本号，昵称、头像、签名每次修改加一
+	Version int64 `gorm:"column:version;not null;default:1"`
 }
 
 func (UserProfile) TableName() string {
@@ -85,15 +99,119 @@ func (m *UserProfileModel) FindByIDs(ids []string) ([]*UserProfile, error) {
 	return profiles, nil
 }
 
-func (m *UserProfileModel) UpdateProfile(p *UserProfile) error {
-	return m.db.Model(&UserProfile{}).
-		Where("user_id = ?", p.UserID).
-		Updates(map[string]interface{}{
-			"nickname":   p.Nickname,
-			"avatar_url": p.AvatarURL,
-			"bio":        p.Bio,
-			"status":     p.Status,
-			"updated_at": time.Now(),
-		}).Error
+// UpdateProfile 在一条语句内部分更新资料并返回更新后的整行：字符串参数为空表示不修改，discoverable 为 nil 表示不修改；
+// 昵称、头像、签名与原值不同时 version 加一。不读改写整行，避免覆盖并发写入的最后在线时间、自定义状态。
+// 资料不存在时返回 gorm.ErrRecordNotFound
+func (m *UserProfileModel) UpdateProfile(userID, nickname, avatarURL, bio string, discoverable *bool) (*UserProfile, error) {
+	var p UserProfile
+	res := m.db.Raw(`
+		UPDATE user_profiles SET
+			version = version + CASE WHEN (COALESCE(NULLIF(@nickname, ''), nickname), COALESCE(NULLIF(@avatar, ''), avatar_url), COALESCE(NULLIF(@bio, ''), bio))
+				IS DISTINCT FROM (nickname, avatar_url, bio) THEN 1 ELSE 0 END,
+			nickname = COALESCE(NULLIF(@nickname, ''), nickname),
+			avatar_url = COALESCE(NULLIF(@avatar, ''), avatar_url),
+			bio = COALESCE(NULLIF(@bio, ''), bio),
+			discoverable = COALESCE(CAST(@discoverable AS BOOLEAN), discoverable),
+			updated_at = NOW()
+		WHERE user_id = @id
+		RETURNING *`,
+		map[string]interface{}{
+			"id":           userID,
+			"nickname":     nickname,
+			"avatar":       avatarURL,
+			"bio":          bio,
+			"discoverable": discoverable,
+		}).Scan(&p)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &p, nil
+}
+
+// ListProfileAudience 按用户 ID 游标分页返回应收到 userID 资料变更的用户：本人、通讯录中有该用户的人、与其同在某会话的 active 成员；
+// 排除被 userID 拉黑的用户。after 为空表示从头开始
+func (m *UserProfileModel) ListProfileAudience(userID, after string, limit int) ([]string, error) {
+	var ids []string
+	err := m.db.Raw(`
+		SELECT a.id FROM (
+			SELECT CAST(@user AS VARCHAR) AS id
+			UNION
+			SELECT owner_id FROM contacts WHERE contact_user_id = @user AND status = 'accepted'
+			UNION
+			SELECT o.user_id FROM conversation_members me
+			JOIN conversation_members o ON o.conversation_id = me.conversation_id AND o.status = 'active'
+			WHERE me.user_id = @user AND me.status = 'active'
+		) a
+		WHERE a.id > @after
+			AND NOT EXISTS (SELECT 1 FROM user_blocks b WHERE b.blocker_id = @user AND b.blocked_id = a.id)
+		ORDER BY a.id
+		LIMIT @limit`,
+		map[string]interface{}{"user": userID, "after": after, "limit": limit}).Scan(&ids).Error
+	return ids, err
+}
+
+// SearchUsers 按用户名、昵称做前缀/模糊（pg_trgm）匹配，q 为 10 位数字时同时按用户 ID 精确匹配。
+// 排除非正常状态、被封禁、关闭 discoverable、隐私设置 find_by 不允许 viewerID 查找的用户，以及 viewerID 本人和拉黑了 viewerID 的用户。
+// 排序：用户名或 ID 完全匹配优先，其次前缀匹配，再按相似度降序，相同时按 ID 升序。
+func (m *UserProfileModel) SearchUsers(viewerID, q string, offset, limit int) ([]*UserProfile, error) {
+	var list []*UserProfile
+	err := m.db.Raw(`
+		SELECT u.id AS user_id, COALESCE(p.nickname, '') AS nickname, COALESCE(p.avatar_url, '') AS avatar_url,
+			COALESCE(p.bio, '') AS bio, COALESCE(p.status, u.status) AS status, COALESCE(p.updated_at, u.updated_at) AS updated_at,
+			COALESCE(p.discoverable, TRUE) AS discoverable, COALESCE(p.version, 0) AS version
+		FROM users u
+		LEFT JOIN user_profiles p ON p.user_id = u.id
+		LEFT JOIN user_privacy_settings ps ON ps.user_id = u.id
+		WHERE u.status = 'normal' AND COALESCE(p.status, 'normal') <> 'banned' AND COALESCE(p.discoverable, TRUE)
+			AND u.id <> @viewer
+			AND NOT EXISTS (SELECT 1 FROM user_blocks b WHERE b.blocker_id = u.id AND b.blocked_id = @viewer)
+			AND (COALESCE(ps.find_by, 'everyone') = 'everyone'
+				OR (ps.find_by = 'contacts' AND EXISTS (SELECT 1 FROM contacts c WHERE c.owner_id = u.id AND c.contact_user_id = @viewer AND c.status = 'accepted')))
+			AND (u.id = @q OR u.username ILIKE @prefix OR p.nickname ILIKE @prefix OR u.username % @q OR p.nickname % @q)
+		ORDER BY (u.id = @q OR lower(u.username) = lower(@q)) DESC,
+			(u.username ILIKE @prefix OR COALESCE(p.nickname, '') ILIKE @prefix) DESC,
+			GREATEST(similarity(u.username, @q), similarity(p.nickname, @q)) DESC,
+			u.id
+		OFFSET @offset LIMIT @limit`,
+		map[string]interface{}{
+			"viewer": viewerID,
+			"q":      q,
+			"prefix": escapeLike(q) + "%",
+			"offset": offset,
+			"limit":  limit,
+		}).Scan(&list).Error
+	return list, err
 }
 
+// escapeLike 转义 LIKE 通配符，使用户输入按字面匹配
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+// UpdateLastSeen 记录最后在线时间，只会前移；尚无 profile 时插入一条默认资料
+func (m *UserProfileModel) UpdateLastSeen(userID string, at time.Time) error {
+	return m.db.Exec(`
+		INSERT INTO user_profiles (user_id, last_seen_at) VALUES (?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET last_seen_at = GREATEST(user_profiles.last_seen_at, EXCLUDED.last_seen_at)`,
+		userID, at).Error
+}
+
+// UpdateCustomStatus 设置自定义状态，emoji 与 text 均为空表示清除；尚无 profile 时插入一条默认资料
+func (m *UserProfileModel) UpdateCustomStatus(userID, emoji, text string, expiresAt *time.Time) error {
+	return m.db.Exec(`
+		INSERT INTO user_profiles (user_id, custom_status_emoji, custom_status_text, custom_status_expires_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET custom_status_emoji = EXCLUDED.custom_status_emoji,
+			custom_status_text = EXCLUDED.custom_status_text, custom_status_expires_at = EXCLUDED.custom_status_expires_at`,
+		userID, emoji, text, expiresAt).Error
+}
+
+// UpdateDiscoverable 仅修改是否允许被搜索；新注册用户可能尚无 profile，此时插入一条默认资料
+func (m *UserProfileModel) UpdateDiscoverable(userID string, discoverable bool) error {
+	return m.db.Exec(`
+		INSERT INTO user_profiles (user_id, discoverable) VALUES (?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET discoverable = EXCLUDED.discoverable, updated_at = NOW()`,
+		userID, discoverable).Error
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UserBlock 对应 user_blocks 表，BlockerID 拉黑了 BlockedID
+type UserBlock struct {
+	BlockerID string    `gorm:"column:blocker_id;type:varchar(10);primaryKey"`
+	BlockedID string    `gorm:"column:blocked_id;type:varchar(10);primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at;type:timestamptz;not null"`
+}
+
+func (UserBlock) TableName() string {
+	return "user_blocks"
+}
+
+type UserBlockModel struct {
+	db *gorm.DB
+}
+
+func NewUserBlockModel(db *gorm.DB) *UserBlockModel {
+	return &UserBlockModel{db: db}
+}
+
+// Block 拉黑，重复拉黑不报错也不改变拉黑时间
+func (m *UserBlockModel) Block(blockerID, blockedID string) error {
+	return m.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&UserBlock{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+		CreatedAt: time.Now(),
+	}).Error
+}
+
+func (m *UserBlockModel) Unblock(blockerID, blockedID string) error {
+	return m.db.Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Delete(&UserBlock{}).Error
+}
+
+// BlockListCursor 黑名单分页游标，按 (created_at, blocked_id) 倒序
+type BlockListCursor struct {
+	CreatedAt time.Time
+	BlockedID string
+}
+
+// List 按拉黑时间倒序分页返回 blockerID 的黑名单
+func (m *UserBlockModel) List(blockerID string, after *BlockListCursor, limit int) ([]*UserBlock, error) {
+	q := m.db.Where("blocker_id = ?", blockerID)
+	if after != nil {
+		q = q.Where("(created_at, blocked_id) < (?, ?)", after.CreatedAt, after.BlockedID)
+	}
+	var list []*UserBlock
+	err := q.Order("created_at DESC, blocked_id DESC").Limit(limit).Find(&list).Error
+	return list, err
+}
+
+// ListBlockedIDs 返回 blockerID 拉黑的全部用户 ID，用于整份写入缓存
+func (m *UserBlockModel) ListBlockedIDs(blockerID string) ([]string, error) {
+	var ids []string
+	err := m.db.Model(&UserBlock{}).Where("blocker_id = ?", blockerID).Pluck("blocked_id", &ids).Error
+	return ids, err
+}
+
+// ListBlockerIDs 返回拉黑了 blockedID 的全部用户 ID，用于账号清除后失效这些用户的黑名单缓存
+func (m *UserBlockModel) ListBlockerIDs(blockedID string) ([]string, error) {
+	var ids []string
+	err := m.db.Model(&UserBlock{}).Where("blocked_id = ?", blockedID).Pluck("blocker_id", &ids).Error
+	return ids, err
+}
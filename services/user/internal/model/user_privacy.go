@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 隐私设置取值
+const (
+	PrivacyEveryone           = "everyone"
+	PrivacyContacts           = "contacts"
+	PrivacyContactsOfContacts = "contacts_of_contacts"
+	PrivacyNobody             = "nobody"
+)
+
+// UserPrivacy 对应 user_privacy_settings 表，没有记录的用户使用 DefaultUserPrivacy
+type UserPrivacy struct {
+	UserID             string    `gorm:"column:user_id;type:varchar(10);primaryKey"`
+	FindBy             string    `gorm:"column:find_by;type:text;not null;default:'everyone'"`
+	ContactRequest     string    `gorm:"column:contact_request;type:text;not null;default:'everyone'"`
+	GroupAdd           string    `gorm:"column:group_add;type:text;not null;default:'everyone'"`
+	PresenceVisibility string    `gorm:"column:presence_visibility;type:text;not null;default:'everyone'"`
+	UpdatedAt          time.Time `gorm:"column:updated_at;type:timestamptz;not null;default:now()"`
+}
+
+func (UserPrivacy) TableName() string {
+	return "user_privacy_settings"
+}
+
+// DefaultUserPrivacy 未设置隐私时的默认值：所有人可见、可申请、可拉群
+func DefaultUserPrivacy(userID string) *UserPrivacy {
+	return &UserPrivacy{
+		UserID:             userID,
+		FindBy:             PrivacyEveryone,
+		ContactRequest:     PrivacyEveryone,
+		GroupAdd:           PrivacyEveryone,
+		PresenceVisibility: PrivacyEveryone,
+	}
+}
+
+type UserPrivacyModel struct {
+	db *gorm.DB
+}
+
+func NewUserPrivacyModel(db *gorm.DB) *UserPrivacyModel {
+	return &UserPrivacyModel{db: db}
+}
+
+// FindByUserID 查询隐私设置，没有记录时返回默认值
+func (m *UserPrivacyModel) FindByUserID(userID string) (*UserPrivacy, error) {
+	var p UserPrivacy
+	err := m.db.Where("user_id = ?", userID).First(&p).Error
+	if err == gorm.ErrRecordNotFound {
+		return DefaultUserPrivacy(userID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// Save 整行写入隐私设置，不存在则插入
+func (m *UserPrivacyModel) Save(p *UserPrivacy) error {
+	p.UpdatedAt = time.Now()
+	return m.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"find_by", "contact_request", "group_add", "presence_visibility", "updated_at"}),
+	}).Create(p).Error
+}
@@ -0,0 +1,76 @@
+package mq
+
+import (
+	"encoding/json"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Publisher 向 RabbitMQ 交换机发布用户事件（如 contact.request.received）；未配置 URL 时为 nil，发布为 no-op。
+type Publisher struct {
+	conn     *amqp.Connection
+	ch       *amqp.Channel
+	exchange string
+	mu       sync.Mutex
+}
+
+// NewPublisher 连接 RabbitMQ；若 url 为空则返回 nil（调用方可不发布事件）。
+func NewPublisher(url, exchange string) (*Publisher, error) {
+	if url == "" {
+		return nil, nil
+	}
+	if exchange == "" {
+		exchange = "im.events"
+	}
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if err := ch.ExchangeDeclare(exchange, "topic", true, false, false, false, nil); err != nil {
+		_ = ch.Close()
+		_ = conn.Close()
+		return nil, err
+	}
+	return &Publisher{conn: conn, ch: ch, exchange: exchange}, nil
+}
+
+// PublishJSON 将 v 序列化为 JSON 并以 routeKey 发布到配置的 exchange。
+func (p *Publisher) PublishJSON(routeKey string, v interface{}) error {
+	if p == nil {
+		return nil
+	}
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.ch.Publish(p.exchange, routeKey, false, false, amqp.Publishing{
+		ContentType: "application/json",
+		Body:        body,
+	})
+}
+
+// Close 关闭连接与 channel。
+func (p *Publisher) Close() error {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.ch != nil {
+		_ = p.ch.Close()
+		p.ch = nil
+	}
+	if p.conn != nil {
+		_ = p.conn.Close()
+		p.conn = nil
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/logic"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// exportPollInterval 检查待生成导出任务的间隔，较短以便用户尽快拿到归档
+const exportPollInterval = 10 * time.Second
+
+// AccountPurger 周期性清除宽限期已到的账号；多副本同时运行时由申请行锁保证同一账号只清除一次。
+type AccountPurger struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewAccountPurger(svcCtx *svc.ServiceContext) *AccountPurger {
+	return &AccountPurger{svcCtx: svcCtx}
+}
+
+// Run 阻塞运行直到 ctx 取消。
+func (p *AccountPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.purgeAll(ctx)
+		}
+	}
+}
+
+func (p *AccountPurger) purgeAll(ctx context.Context) {
+	l := logic.NewPurgeAccountsLogic(ctx, p.svcCtx)
+	for ctx.Err() == nil {
+		n, err := l.PurgeDue(batchSize)
+		if err != nil {
+			logx.Errorf("account purger: purge failed: %v", err)
+			return
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
+
+// DataExportWorker 周期性生成排队中的个人数据导出归档并清理过期归档；多副本时由 SKIP LOCKED 领取保证任务不重复执行。
+type DataExportWorker struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewDataExportWorker(svcCtx *svc.ServiceContext) *DataExportWorker {
+	return &DataExportWorker{svcCtx: svcCtx}
+}
+
+// Run 阻塞运行直到 ctx 取消。
+func (w *DataExportWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(exportPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.buildAll(ctx)
+			w.cleanup(ctx)
+		}
+	}
+}
+
+func (w *DataExportWorker) buildAll(ctx context.Context) {
+	l := logic.NewBuildDataExportsLogic(ctx, w.svcCtx)
+	for ctx.Err() == nil {
+		n, err := l.BuildPending(batchSize)
+		if err != nil {
+			logx.Errorf("data export worker: build failed: %v", err)
+			return
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
+
+func (w *DataExportWorker) cleanup(ctx context.Context) {
+	l := logic.NewBuildDataExportsLogic(ctx, w.svcCtx)
+	for ctx.Err() == nil {
+		n, err := l.CleanupExpired(batchSize)
+		if err != nil {
+			logx.Errorf("data export worker: cleanup failed: %v", err)
+			return
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
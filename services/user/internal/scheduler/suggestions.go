@@ -0,0 +1,55 @@
+// Package scheduler 提供 User 服务的后台任务：定期重算好友推荐、清除到期注销的账号、生成与清理个人数据导出。
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/HappyLadySauce/Beehive/services/user/internal/logic"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// batchSize 每批重算的用户数；一批领满时立即继续下一批，避免积压。
+const batchSize = 100
+
+// pollInterval 检查是否有需要重算用户的间隔；是否过期由 SuggestionRefreshSeconds 决定。
+const pollInterval = time.Minute
+
+// SuggestionRefresher 周期性重算近期活跃用户已过期的好友推荐。
+// 多副本同时运行时可能重复计算同一用户，结果按用户整份替换，不影响正确性。
+type SuggestionRefresher struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewSuggestionRefresher(svcCtx *svc.ServiceContext) *SuggestionRefresher {
+	return &SuggestionRefresher{svcCtx: svcCtx}
+}
+
+// Run 阻塞运行直到 ctx 取消。
+func (r *SuggestionRefresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.refreshAll(ctx)
+		}
+	}
+}
+
+func (r *SuggestionRefresher) refreshAll(ctx context.Context) {
+	l := logic.NewRefreshSuggestionsLogic(ctx, r.svcCtx)
+	for ctx.Err() == nil {
+		n, err := l.RefreshStale(batchSize)
+		if err != nil {
+			logx.Errorf("suggestion refresher: refresh failed: %v", err)
+			return
+		}
+		if n < batchSize {
+			return
+		}
+	}
+}
@@ -77,3 +77,108 @@ func (s *UserServiceServer) DeclineContactRequest(ctx context.Context, in *pb.De
 	l := logic.NewDeclineContactRequestLogic(ctx, s.svcCtx)
 	return l.DeclineContactRequest(in)
 }
+
+func (s *UserServiceServer) BlockUser(ctx context.Context, in *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	l := logic.NewBlockUserLogic(ctx, s.svcCtx)
+	return l.BlockUser(in)
+}
+
+func (s *UserServiceServer) UnblockUser(ctx context.Context, in *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	l := logic.NewUnblockUserLogic(ctx, s.svcCtx)
+	return l.UnblockUser(in)
+}
+
+func (s *UserServiceServer) ListBlocked(ctx context.Context, in *pb.ListBlockedRequest) (*pb.ListBlockedResponse, error) {
+	l := logic.NewListBlockedLogic(ctx, s.svcCtx)
+	return l.ListBlocked(in)
+}
+
+func (s *UserServiceServer) CheckBlock(ctx context.Context, in *pb.CheckBlockRequest) (*pb.CheckBlockResponse, error) {
+	l := logic.NewCheckBlockLogic(ctx, s.svcCtx)
+	return l.CheckBlock(in)
+}
+
+func (s *UserServiceServer) SearchUsers(ctx context.Context, in *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	l := logic.NewSearchUsersLogic(ctx, s.svcCtx)
+	return l.SearchUsers(in)
+}
+
+func (s *UserServiceServer) GetPrivacySettings(ctx context.Context, in *pb.GetPrivacySettingsRequest) (*pb.GetPrivacySettingsResponse, error) {
+	l := logic.NewGetPrivacySettingsLogic(ctx, s.svcCtx)
+	return l.GetPrivacySettings(in)
+}
+
+func (s *UserServiceServer) UpdatePrivacySettings(ctx context.Context, in *pb.UpdatePrivacySettingsRequest) (*pb.UpdatePrivacySettingsResponse, error) {
+	l := logic.NewUpdatePrivacySettingsLogic(ctx, s.svcCtx)
+	return l.UpdatePrivacySettings(in)
+}
+
+func (s *UserServiceServer) CheckPrivacy(ctx context.Context, in *pb.CheckPrivacyRequest) (*pb.CheckPrivacyResponse, error) {
+	l := logic.NewCheckPrivacyLogic(ctx, s.svcCtx)
+	return l.CheckPrivacy(in)
+}
+
+func (s *UserServiceServer) UpdateContact(ctx context.Context, in *pb.UpdateContactRequest) (*pb.UpdateContactResponse, error) {
+	l := logic.NewUpdateContactLogic(ctx, s.svcCtx)
+	return l.UpdateContact(in)
+}
+
+func (s *UserServiceServer) SetContactTags(ctx context.Context, in *pb.SetContactTagsRequest) (*pb.SetContactTagsResponse, error) {
+	l := logic.NewSetContactTagsLogic(ctx, s.svcCtx)
+	return l.SetContactTags(in)
+}
+
+func (s *UserServiceServer) WithdrawContactRequest(ctx context.Context, in *pb.WithdrawContactRequestRequest) (*pb.WithdrawContactRequestResponse, error) {
+	l := logic.NewWithdrawContactRequestLogic(ctx, s.svcCtx)
+	return l.WithdrawContactRequest(in)
+}
+
+func (s *UserServiceServer) SuggestContacts(ctx context.Context, in *pb.SuggestContactsRequest) (*pb.SuggestContactsResponse, error) {
+	l := logic.NewSuggestContactsLogic(ctx, s.svcCtx)
+	return l.SuggestContacts(in)
+}
+
+func (s *UserServiceServer) SetLastSeen(ctx context.Context, in *pb.SetLastSeenRequest) (*pb.SetLastSeenResponse, error) {
+	l := logic.NewSetLastSeenLogic(ctx, s.svcCtx)
+	return l.SetLastSeen(in)
+}
+
+func (s *UserServiceServer) SetCustomStatus(ctx context.Context, in *pb.SetCustomStatusRequest) (*pb.SetCustomStatusResponse, error) {
+	l := logic.NewSetCustomStatusLogic(ctx, s.svcCtx)
+	return l.SetCustomStatus(in)
+}
+
+func (s *UserServiceServer) RequestAccountDeletion(ctx context.Context, in *pb.RequestAccountDeletionRequest) (*pb.RequestAccountDeletionResponse, error) {
+	l := logic.NewRequestAccountDeletionLogic(ctx, s.svcCtx)
+	return l.RequestAccountDeletion(in)
+}
+
+func (s *UserServiceServer) CancelAccountDeletion(ctx context.Context, in *pb.CancelAccountDeletionRequest) (*pb.CancelAccountDeletionResponse, error) {
+	l := logic.NewCancelAccountDeletionLogic(ctx, s.svcCtx)
+	return l.CancelAccountDeletion(in)
+}
+
+func (s *UserServiceServer) GetAccountDeletion(ctx context.Context, in *pb.GetAccountDeletionRequest) (*pb.GetAccountDeletionResponse, error) {
+	l := logic.NewGetAccountDeletionLogic(ctx, s.svcCtx)
+	return l.GetAccountDeletion(in)
+}
+
+func (s *UserServiceServer) RequestDataExport(ctx context.Context, in *pb.RequestDataExportRequest) (*pb.RequestDataExportResponse, error) {
+	l := logic.NewRequestDataExportLogic(ctx, s.svcCtx)
+	return l.RequestDataExport(in)
+}
+
+func (s *UserServiceServer) GetDataExport(ctx context.Context, in *pb.GetDataExportRequest) (*pb.GetDataExportResponse, error) {
+	l := logic.NewGetDataExportLogic(ctx, s.svcCtx)
+	return l.GetDataExport(in)
+}
+
+func (s *UserServiceServer) DownloadDataExport(in *pb.DownloadDataExportRequest, stream pb.UserService_DownloadDataExportServer) error {
+	l := logic.NewDownloadDataExportLogic(stream.Context(), s.svcCtx)
+	return l.DownloadDataExport(in, stream)
+}
+
+func (s *UserServiceServer) StreamProfileAudience(in *pb.StreamProfileAudienceRequest, stream pb.UserService_StreamProfileAudienceServer) error {
+	l := logic.NewStreamProfileAudienceLogic(stream.Context(), s.svcCtx)
+	return l.StreamProfileAudience(in, stream)
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"time"
 
+	"github.com/HappyLadySauce/Beehive/services/auth/authservice"
+	"github.com/HappyLadySauce/Beehive/services/conversation/conversationservice"
 	"github.com/HappyLadySauce/Beehive/services/user/internal/config"
 	"github.com/HappyLadySauce/Beehive/services/user/internal/model"
+	"github.com/HappyLadySauce/Beehive/services/user/internal/mq"
 	"github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/zrpc"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -20,6 +24,17 @@ type ServiceContext struct {
 	UserProfileMod     *model.UserProfileModel
 	ContactMod         *model.ContactModel
 	ContactRequestMod  *model.ContactRequestModel
+	UserBlockMod       *model.UserBlockModel
+	UserPrivacyMod     *model.UserPrivacyModel
+	SuggestionMod      *model.ContactSuggestionModel
+	DeletionMod        *model.AccountDeletionModel
+	ExportMod          *model.DataExportModel
+	// MQ 未配置 RabbitMQ 时为 nil，发布为 no-op
+	MQ *mq.Publisher
+	// AuthSvc 用于账号清除时吊销 token；未配置时为 nil，跳过吊销
+	AuthSvc authservice.AuthService
+	// ConversationSvc 用于账号清除时退出所有会话；未配置时为 nil，不执行清除
+	ConversationSvc conversationservice.ConversationService
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -43,6 +58,23 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		sqlDB.SetConnMaxLifetime(time.Hour)
 	}
 
+	var pub *mq.Publisher
+	if c.RabbitMQURL != "" {
+		pub, err = mq.NewPublisher(c.RabbitMQURL, c.RabbitMQExchange)
+		if err != nil {
+			panic("rabbitmq: " + err.Error())
+		}
+	}
+
+	var authSvc authservice.AuthService
+	if c.AuthRpcConfigured() {
+		authSvc = authservice.NewAuthService(zrpc.MustNewClient(c.AuthRpc))
+	}
+	var convSvc conversationservice.ConversationService
+	if c.ConversationRpcConfigured() {
+		convSvc = conversationservice.NewConversationService(zrpc.MustNewClient(c.ConversationRpc))
+	}
+
 	return &ServiceContext{
 		Config:         c,
 		DB:             db,
@@ -51,5 +83,13 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		UserProfileMod:    model.NewUserProfileModel(db),
 		ContactMod:        model.NewContactModel(db),
 		ContactRequestMod: model.NewContactRequestModel(db),
+		UserBlockMod:      model.NewUserBlockModel(db),
+		UserPrivacyMod:    model.NewUserPrivacyModel(db),
+		SuggestionMod:     model.NewContactSuggestionModel(db),
+		DeletionMod:       model.NewAccountDeletionModel(db),
+		ExportMod:         model.NewDataExportModel(db),
+		MQ:                pub,
+		AuthSvc:           authSvc,
+		ConversationSvc:   convSvc,
 	}
 }
@@ -22,8 +22,10 @@ const (
 )
 
 type GetUserByUsernameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// 查询者；非空且不是本人时按对方隐私设置 find_by 校验，不允许时返回 NotFound；presence_visibility 不允许时隐藏 last_seen_at 与 custom_status
+	ViewerId      string `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,9 +67,20 @@ func (x *GetUserByUsernameRequest) GetUsername() string {
 	return ""
 }
 
+func (x *GetUserByUsernameRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
 type GetUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 同 GetUserByUsernameRequest.viewer_id
+	ViewerId string `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	// 调用方已缓存的资料版本号；与当前版本一致时返回 not_modified = true 且不返回 user
+	IfVersion     int64 `protobuf:"varint,3,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -109,9 +122,24 @@ func (x *GetUserRequest) GetId() string {
 	return ""
 }
 
+func (x *GetUserRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *GetUserRequest) GetIfVersion() int64 {
+	if x != nil {
+		return x.IfVersion
+	}
+	return 0
+}
+
 type GetUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	NotModified   bool                   `protobuf:"varint,2,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -153,9 +181,20 @@ func (x *GetUserResponse) GetUser() *User {
 	return nil
 }
 
+func (x *GetUserResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
 type BatchGetUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Ids   []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// 查询者；非空时按各用户隐私设置 presence_visibility 隐藏 last_seen_at 与 custom_status
+	ViewerId string `protobuf:"bytes,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	// 调用方已缓存的资料版本号（用户 ID → version）；版本一致的用户不在 users 中返回，而是列入 unchanged_ids
+	KnownVersions map[string]int64 `protobuf:"bytes,3,rep,name=known_versions,json=knownVersions,proto3" json:"known_versions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -197,9 +236,24 @@ func (x *BatchGetUsersRequest) GetIds() []string {
 	return nil
 }
 
+func (x *BatchGetUsersRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *BatchGetUsersRequest) GetKnownVersions() map[string]int64 {
+	if x != nil {
+		return x.KnownVersions
+	}
+	return nil
+}
+
 type BatchGetUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	UnchangedIds  []string               `protobuf:"bytes,2,rep,name=unchanged_ids,json=unchangedIds,proto3" json:"unchanged_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -241,12 +295,21 @@ func (x *BatchGetUsersResponse) GetUsers() []*User {
 	return nil
 }
 
+func (x *BatchGetUsersResponse) GetUnchangedIds() []string {
+	if x != nil {
+		return x.UnchangedIds
+	}
+	return nil
+}
+
 type UpdateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname  string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Bio       string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	// 是否允许被 SearchUsers 搜索到；不传则不修改
+	Discoverable  *bool `protobuf:"varint,5,opt,name=discoverable,proto3,oneof" json:"discoverable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -309,6 +372,13 @@ func (x *UpdateUserRequest) GetBio() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetDiscoverable() bool {
+	if x != nil && x.Discoverable != nil {
+		return *x.Discoverable
+	}
+	return false
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -354,12 +424,20 @@ func (x *UpdateUserResponse) GetUser() *User {
 }
 
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname  string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Bio       string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	Status    string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// 最后在线时间（Unix 秒）；0 表示未记录，或对方隐私设置 presence_visibility 不允许查询者查看
+	LastSeenAt int64 `protobuf:"varint,6,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// 自定义状态；未设置、已过期或对查询者隐藏时为空，隐藏规则同 last_seen_at
+	CustomStatus *CustomStatus `protobuf:"bytes,7,opt,name=custom_status,json=customStatus,proto3" json:"custom_status,omitempty"`
+	// 资料版本号，昵称、头像、签名每次修改加一，可作为 ETag；0 表示尚无资料。最后在线时间与自定义状态不计入版本
+	Version int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// 资料最后修改时间（Unix 秒）
+	UpdatedAt     int64 `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -429,28 +507,56 @@ func (x *User) GetStatus() string {
 	return ""
 }
 
-type AddContactRequest struct {
+func (x *User) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return 0
+}
+
+func (x *User) GetCustomStatus() *CustomStatus {
+	if x != nil {
+		return x.CustomStatus
+	}
+	return nil
+}
+
+func (x *User) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *User) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type StreamProfileAudienceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // 每批条数，默认 1000，最大 5000
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddContactRequest) Reset() {
-	*x = AddContactRequest{}
+func (x *StreamProfileAudienceRequest) Reset() {
+	*x = StreamProfileAudienceRequest{}
 	mi := &file_proto_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddContactRequest) String() string {
+func (x *StreamProfileAudienceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddContactRequest) ProtoMessage() {}
+func (*StreamProfileAudienceRequest) ProtoMessage() {}
 
-func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamProfileAudienceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,45 +568,46 @@ func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddContactRequest.ProtoReflect.Descriptor instead.
-func (*AddContactRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamProfileAudienceRequest.ProtoReflect.Descriptor instead.
+func (*StreamProfileAudienceRequest) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AddContactRequest) GetOwnerId() string {
+func (x *StreamProfileAudienceRequest) GetUserId() string {
 	if x != nil {
-		return x.OwnerId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *AddContactRequest) GetContactUserId() string {
+func (x *StreamProfileAudienceRequest) GetBatchSize() int32 {
 	if x != nil {
-		return x.ContactUserId
+		return x.BatchSize
 	}
-	return ""
+	return 0
 }
 
-type AddContactResponse struct {
+type ProfileAudienceChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddContactResponse) Reset() {
-	*x = AddContactResponse{}
+func (x *ProfileAudienceChunk) Reset() {
+	*x = ProfileAudienceChunk{}
 	mi := &file_proto_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddContactResponse) String() string {
+func (x *ProfileAudienceChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddContactResponse) ProtoMessage() {}
+func (*ProfileAudienceChunk) ProtoMessage() {}
 
-func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
+func (x *ProfileAudienceChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -512,32 +619,41 @@ func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddContactResponse.ProtoReflect.Descriptor instead.
-func (*AddContactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfileAudienceChunk.ProtoReflect.Descriptor instead.
+func (*ProfileAudienceChunk) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{9}
 }
 
-type ListContactsRequest struct {
+func (x *ProfileAudienceChunk) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type CustomStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 过期时间（Unix 秒），0 表示不过期
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListContactsRequest) Reset() {
-	*x = ListContactsRequest{}
+func (x *CustomStatus) Reset() {
+	*x = CustomStatus{}
 	mi := &file_proto_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContactsRequest) String() string {
+func (x *CustomStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContactsRequest) ProtoMessage() {}
+func (*CustomStatus) ProtoMessage() {}
 
-func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+func (x *CustomStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,85 +665,56 @@ func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
-func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomStatus.ProtoReflect.Descriptor instead.
+func (*CustomStatus) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListContactsRequest) GetOwnerId() string {
+func (x *CustomStatus) GetEmoji() string {
 	if x != nil {
-		return x.OwnerId
+		return x.Emoji
 	}
 	return ""
 }
 
-type ListContactsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ContactUserIds []string               `protobuf:"bytes,1,rep,name=contact_user_ids,json=contactUserIds,proto3" json:"contact_user_ids,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *ListContactsResponse) Reset() {
-	*x = ListContactsResponse{}
-	mi := &file_proto_user_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListContactsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListContactsResponse) ProtoMessage() {}
-
-func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[11]
+func (x *CustomStatus) GetText() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Text
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
-func (*ListContactsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{11}
+	return ""
 }
 
-func (x *ListContactsResponse) GetContactUserIds() []string {
+func (x *CustomStatus) GetExpiresAt() int64 {
 	if x != nil {
-		return x.ContactUserIds
+		return x.ExpiresAt
 	}
-	return nil
+	return 0
 }
 
-type RemoveContactRequest struct {
+// SetLastSeen 由 Presence 服务在用户最后一个会话结束时调用，时间只会前移
+type SetLastSeenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastSeenAt    int64                  `protobuf:"varint,2,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` // Unix 秒
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveContactRequest) Reset() {
-	*x = RemoveContactRequest{}
-	mi := &file_proto_user_proto_msgTypes[12]
+func (x *SetLastSeenRequest) Reset() {
+	*x = SetLastSeenRequest{}
+	mi := &file_proto_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveContactRequest) String() string {
+func (x *SetLastSeenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveContactRequest) ProtoMessage() {}
+func (*SetLastSeenRequest) ProtoMessage() {}
 
-func (x *RemoveContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[12]
+func (x *SetLastSeenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -638,46 +725,46 @@ func (x *RemoveContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveContactRequest.ProtoReflect.Descriptor instead.
-func (*RemoveContactRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetLastSeenRequest.ProtoReflect.Descriptor instead.
+func (*SetLastSeenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RemoveContactRequest) GetOwnerId() string {
+func (x *SetLastSeenRequest) GetUserId() string {
 	if x != nil {
-		return x.OwnerId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RemoveContactRequest) GetContactUserId() string {
+func (x *SetLastSeenRequest) GetLastSeenAt() int64 {
 	if x != nil {
-		return x.ContactUserId
+		return x.LastSeenAt
 	}
-	return ""
+	return 0
 }
 
-type RemoveContactResponse struct {
+type SetLastSeenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveContactResponse) Reset() {
-	*x = RemoveContactResponse{}
-	mi := &file_proto_user_proto_msgTypes[13]
+func (x *SetLastSeenResponse) Reset() {
+	*x = SetLastSeenResponse{}
+	mi := &file_proto_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveContactResponse) String() string {
+func (x *SetLastSeenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveContactResponse) ProtoMessage() {}
+func (*SetLastSeenResponse) ProtoMessage() {}
 
-func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[13]
+func (x *SetLastSeenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,36 +775,37 @@ func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveContactResponse.ProtoReflect.Descriptor instead.
-func (*RemoveContactResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SetLastSeenResponse.ProtoReflect.Descriptor instead.
+func (*SetLastSeenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{12}
 }
 
-// 好友申请
-type CreateContactRequestRequest struct {
+// SetCustomStatus emoji 与 text 均为空时清除自定义状态
+type SetCustomStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromUserId    string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
-	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`                           // 最多 8 个字符
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`                             // 最多 64 个字符
+	ExpiresAt     int64                  `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 过期时间（Unix 秒），0 表示不过期，非 0 时须晚于当前时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateContactRequestRequest) Reset() {
-	*x = CreateContactRequestRequest{}
-	mi := &file_proto_user_proto_msgTypes[14]
+func (x *SetCustomStatusRequest) Reset() {
+	*x = SetCustomStatusRequest{}
+	mi := &file_proto_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateContactRequestRequest) String() string {
+func (x *SetCustomStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContactRequestRequest) ProtoMessage() {}
+func (*SetCustomStatusRequest) ProtoMessage() {}
 
-func (x *CreateContactRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[14]
+func (x *SetCustomStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,54 +816,61 @@ func (x *CreateContactRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContactRequestRequest.ProtoReflect.Descriptor instead.
-func (*CreateContactRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SetCustomStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetCustomStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateContactRequestRequest) GetFromUserId() string {
+func (x *SetCustomStatusRequest) GetUserId() string {
 	if x != nil {
-		return x.FromUserId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateContactRequestRequest) GetToUserId() string {
+func (x *SetCustomStatusRequest) GetEmoji() string {
 	if x != nil {
-		return x.ToUserId
+		return x.Emoji
 	}
 	return ""
 }
 
-func (x *CreateContactRequestRequest) GetMessage() string {
+func (x *SetCustomStatusRequest) GetText() string {
 	if x != nil {
-		return x.Message
+		return x.Text
 	}
 	return ""
 }
 
-type CreateContactRequestResponse struct {
+func (x *SetCustomStatusRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type SetCustomStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CustomStatus  *CustomStatus          `protobuf:"bytes,1,opt,name=custom_status,json=customStatus,proto3" json:"custom_status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateContactRequestResponse) Reset() {
-	*x = CreateContactRequestResponse{}
-	mi := &file_proto_user_proto_msgTypes[15]
+func (x *SetCustomStatusResponse) Reset() {
+	*x = SetCustomStatusResponse{}
+	mi := &file_proto_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateContactRequestResponse) String() string {
+func (x *SetCustomStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContactRequestResponse) ProtoMessage() {}
+func (*SetCustomStatusResponse) ProtoMessage() {}
 
-func (x *CreateContactRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[15]
+func (x *SetCustomStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,40 +881,46 @@ func (x *CreateContactRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContactRequestResponse.ProtoReflect.Descriptor instead.
-func (*CreateContactRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetCustomStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetCustomStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CreateContactRequestResponse) GetRequestId() string {
+func (x *SetCustomStatusResponse) GetCustomStatus() *CustomStatus {
 	if x != nil {
-		return x.RequestId
+		return x.CustomStatus
 	}
-	return ""
+	return nil
 }
 
-type ListContactRequestsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 当前用户 ID，返回发给该用户的待处理申请（to_user_id = user_id, status = pending）
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 查询者，不出现在结果中；拉黑了查询者的用户同样不出现
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query  string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// 上一页返回的 next_cursor，为空表示第一页
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 每页条数，默认 20，最大 50
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListContactRequestsRequest) Reset() {
-	*x = ListContactRequestsRequest{}
-	mi := &file_proto_user_proto_msgTypes[16]
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_proto_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContactRequestsRequest) String() string {
+func (x *SearchUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContactRequestsRequest) ProtoMessage() {}
+func (*SearchUsersRequest) ProtoMessage() {}
 
-func (x *ListContactRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[16]
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,44 +931,2616 @@ func (x *ListContactRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContactRequestsRequest.ProtoReflect.Descriptor instead.
-func (*ListContactRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListContactRequestsRequest) GetUserId() string {
+func (x *SearchUsersRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type ContactRequestItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	FromUserId    string                 `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
-	ToUserId      string                 `protobuf:"bytes,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *SearchUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// 为空表示没有更多
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_proto_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type AddContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddContactRequest) Reset() {
+	*x = AddContactRequest{}
+	mi := &file_proto_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddContactRequest) ProtoMessage() {}
+
+func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddContactRequest.ProtoReflect.Descriptor instead.
+func (*AddContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddContactRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *AddContactRequest) GetContactUserId() string {
+	if x != nil {
+		return x.ContactUserId
+	}
+	return ""
+}
+
+type AddContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddContactResponse) Reset() {
+	*x = AddContactResponse{}
+	mi := &file_proto_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddContactResponse) ProtoMessage() {}
+
+func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddContactResponse.ProtoReflect.Descriptor instead.
+func (*AddContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{18}
+}
+
+type ListContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`                               // 上一页返回的 next_cursor，按 contact_user_id 正序分页
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                // 每页条数，默认 100，最大 500
+	StarredOnly   bool                   `protobuf:"varint,4,opt,name=starred_only,json=starredOnly,proto3" json:"starred_only,omitempty"` // 只列出星标联系人
+	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`                                     // 只列出带该标签的联系人
+	SyncToken     string                 `protobuf:"bytes,6,opt,name=sync_token,json=syncToken,proto3" json:"sync_token,omitempty"`        // 非空时为增量模式：只返回该同步点之后变化的联系人（含已删除，由 status 标识；忽略 cursor / starred_only / tag）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactsRequest) Reset() {
+	*x = ListContactsRequest{}
+	mi := &file_proto_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsRequest) ProtoMessage() {}
+
+func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListContactsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *ListContactsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListContactsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListContactsRequest) GetStarredOnly() bool {
+	if x != nil {
+		return x.StarredOnly
+	}
+	return false
+}
+
+func (x *ListContactsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListContactsRequest) GetSyncToken() string {
+	if x != nil {
+		return x.SyncToken
+	}
+	return ""
+}
+
+type ListContactsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContactUserIds []string               `protobuf:"bytes,1,rep,name=contact_user_ids,json=contactUserIds,proto3" json:"contact_user_ids,omitempty"` // 本页联系人 ID，与 items 顺序一致（增量模式下不含已删除的联系人）
+	Items          []*ContactInfo         `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor     string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 为空表示已到末页
+	SyncToken      string                 `protobuf:"bytes,4,opt,name=sync_token,json=syncToken,proto3" json:"sync_token,omitempty"`    // 下次增量同步使用的同步点
+	HasMore        bool                   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`         // 增量模式下是否还有变化未返回，为 true 时应立即用新的 sync_token 继续拉取
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListContactsResponse) Reset() {
+	*x = ListContactsResponse{}
+	mi := &file_proto_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsResponse) ProtoMessage() {}
+
+func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListContactsResponse) GetContactUserIds() []string {
+	if x != nil {
+		return x.ContactUserIds
+	}
+	return nil
+}
+
+func (x *ListContactsResponse) GetItems() []*ContactInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListContactsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListContactsResponse) GetSyncToken() string {
+	if x != nil {
+		return x.SyncToken
+	}
+	return ""
+}
+
+func (x *ListContactsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type ContactInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContactUserId string                 `protobuf:"bytes,1,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // accepted / removed，removed 仅在增量模式下出现
+	Remark        string                 `protobuf:"bytes,3,opt,name=remark,proto3" json:"remark,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Starred       bool                   `protobuf:"varint,5,opt,name=starred,proto3" json:"starred,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 成为联系人的时间（Unix 秒）
+	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	User          *User                  `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"` // 联系人资料，经 BatchGetUsers 读取；已删除的联系人不返回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactInfo) Reset() {
+	*x = ContactInfo{}
+	mi := &file_proto_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactInfo) ProtoMessage() {}
+
+func (x *ContactInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactInfo.ProtoReflect.Descriptor instead.
+func (*ContactInfo) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ContactInfo) GetContactUserId() string {
+	if x != nil {
+		return x.ContactUserId
+	}
+	return ""
+}
+
+func (x *ContactInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ContactInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *ContactInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ContactInfo) GetStarred() bool {
+	if x != nil {
+		return x.Starred
+	}
+	return false
+}
+
+func (x *ContactInfo) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ContactInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ContactInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ContactInfo) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 仅修改传入的字段
+type UpdateContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	Remark        *string                `protobuf:"bytes,3,opt,name=remark,proto3,oneof" json:"remark,omitempty"` // 最多 32 个字符，空串表示清除
+	Starred       *bool                  `protobuf:"varint,4,opt,name=starred,proto3,oneof" json:"starred,omitempty"`
+	Note          *string                `protobuf:"bytes,5,opt,name=note,proto3,oneof" json:"note,omitempty"` // 最多 500 个字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactRequest) Reset() {
+	*x = UpdateContactRequest{}
+	mi := &file_proto_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactRequest) ProtoMessage() {}
+
+func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateContactRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetContactUserId() string {
+	if x != nil {
+		return x.ContactUserId
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetRemark() string {
+	if x != nil && x.Remark != nil {
+		return *x.Remark
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetStarred() bool {
+	if x != nil && x.Starred != nil {
+		return *x.Starred
+	}
+	return false
+}
+
+func (x *UpdateContactRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type UpdateContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *ContactInfo           `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactResponse) Reset() {
+	*x = UpdateContactResponse{}
+	mi := &file_proto_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactResponse) ProtoMessage() {}
+
+func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactResponse.ProtoReflect.Descriptor instead.
+func (*UpdateContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateContactResponse) GetContact() *ContactInfo {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+// 整体替换联系人标签；空列表表示清除
+type SetContactTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"` // 最多 20 个，每个最多 16 个字符，去重并去掉首尾空白
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetContactTagsRequest) Reset() {
+	*x = SetContactTagsRequest{}
+	mi := &file_proto_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetContactTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactTagsRequest) ProtoMessage() {}
+
+func (x *SetContactTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetContactTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetContactTagsRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SetContactTagsRequest) GetContactUserId() string {
+	if x != nil {
+		return x.ContactUserId
+	}
+	return ""
+}
+
+func (x *SetContactTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetContactTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *ContactInfo           `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetContactTagsResponse) Reset() {
+	*x = SetContactTagsResponse{}
+	mi := &file_proto_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetContactTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactTagsResponse) ProtoMessage() {}
+
+func (x *SetContactTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetContactTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetContactTagsResponse) GetContact() *ContactInfo {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type RemoveContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	ContactUserId string                 `protobuf:"bytes,2,opt,name=contact_user_id,json=contactUserId,proto3" json:"contact_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveContactRequest) Reset() {
+	*x = RemoveContactRequest{}
+	mi := &file_proto_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContactRequest) ProtoMessage() {}
+
+func (x *RemoveContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContactRequest.ProtoReflect.Descriptor instead.
+func (*RemoveContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveContactRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RemoveContactRequest) GetContactUserId() string {
+	if x != nil {
+		return x.ContactUserId
+	}
+	return ""
+}
+
+type RemoveContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveContactResponse) Reset() {
+	*x = RemoveContactResponse{}
+	mi := &file_proto_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContactResponse) ProtoMessage() {}
+
+func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContactResponse.ProtoReflect.Descriptor instead.
+func (*RemoveContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{27}
+}
+
+type SuggestContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，首页为空
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`  // 默认 20，最大 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestContactsRequest) Reset() {
+	*x = SuggestContactsRequest{}
+	mi := &file_proto_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestContactsRequest) ProtoMessage() {}
+
+func (x *SuggestContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestContactsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestContactsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SuggestContactsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuggestContactsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SuggestContactsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ContactSuggestion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	MutualContacts int32                  `protobuf:"varint,2,opt,name=mutual_contacts,json=mutualContacts,proto3" json:"mutual_contacts,omitempty"` // 共同好友数
+	SharedGroups   int32                  `protobuf:"varint,3,opt,name=shared_groups,json=sharedGroups,proto3" json:"shared_groups,omitempty"`       // 共同群数
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ContactSuggestion) Reset() {
+	*x = ContactSuggestion{}
+	mi := &file_proto_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactSuggestion) ProtoMessage() {}
+
+func (x *ContactSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactSuggestion.ProtoReflect.Descriptor instead.
+func (*ContactSuggestion) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ContactSuggestion) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ContactSuggestion) GetMutualContacts() int32 {
+	if x != nil {
+		return x.MutualContacts
+	}
+	return 0
+}
+
+func (x *ContactSuggestion) GetSharedGroups() int32 {
+	if x != nil {
+		return x.SharedGroups
+	}
+	return 0
+}
+
+type SuggestContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ContactSuggestion   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 为空表示没有更多；隐私过滤后单页可能少于 limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestContactsResponse) Reset() {
+	*x = SuggestContactsResponse{}
+	mi := &file_proto_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestContactsResponse) ProtoMessage() {}
+
+func (x *SuggestContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestContactsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestContactsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SuggestContactsResponse) GetItems() []*ContactSuggestion {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SuggestContactsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// 好友申请
+type CreateContactRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    string                 `protobuf:"bytes,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContactRequestRequest) Reset() {
+	*x = CreateContactRequestRequest{}
+	mi := &file_proto_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContactRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactRequestRequest) ProtoMessage() {}
+
+func (x *CreateContactRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateContactRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateContactRequestRequest) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *CreateContactRequestRequest) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *CreateContactRequestRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CreateContactRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 申请过期时间（Unix 秒）；已有待处理申请时返回原申请
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContactRequestResponse) Reset() {
+	*x = CreateContactRequestResponse{}
+	mi := &file_proto_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContactRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactRequestResponse) ProtoMessage() {}
+
+func (x *CreateContactRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreateContactRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateContactRequestResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CreateContactRequestResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ListContactRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 当前用户 ID，返回发给该用户的待处理申请（to_user_id = user_id, status = pending）
+	Sent          bool                   `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`                  // true 时改为返回该用户发出的待处理申请，用于查看与撤回
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactRequestsRequest) Reset() {
+	*x = ListContactRequestsRequest{}
+	mi := &file_proto_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactRequestsRequest) ProtoMessage() {}
+
+func (x *ListContactRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListContactRequestsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListContactRequestsRequest) GetSent() bool {
+	if x != nil {
+		return x.Sent
+	}
+	return false
+}
+
+type ContactRequestItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	FromUserId    string                 `protobuf:"bytes,2,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,3,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContactRequestItem) Reset() {
+	*x = ContactRequestItem{}
+	mi := &file_proto_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactRequestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactRequestItem) ProtoMessage() {}
+
+func (x *ContactRequestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactRequestItem.ProtoReflect.Descriptor instead.
+func (*ContactRequestItem) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ContactRequestItem) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ContactRequestItem) GetFromUserId() string {
+	if x != nil {
+		return x.FromUserId
+	}
+	return ""
+}
+
+func (x *ContactRequestItem) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *ContactRequestItem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ContactRequestItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ContactRequestItem) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ListContactRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ContactRequestItem  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContactRequestsResponse) Reset() {
+	*x = ListContactRequestsResponse{}
+	mi := &file_proto_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactRequestsResponse) ProtoMessage() {}
+
+func (x *ListContactRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListContactRequestsResponse) GetItems() []*ContactRequestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AcceptContactRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 当前用户（接收方）
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptContactRequestRequest) Reset() {
+	*x = AcceptContactRequestRequest{}
+	mi := &file_proto_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptContactRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptContactRequestRequest) ProtoMessage() {}
+
+func (x *AcceptContactRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptContactRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptContactRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AcceptContactRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptContactRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type AcceptContactRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptContactRequestResponse) Reset() {
+	*x = AcceptContactRequestResponse{}
+	mi := &file_proto_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptContactRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptContactRequestResponse) ProtoMessage() {}
+
+func (x *AcceptContactRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptContactRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptContactRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{37}
+}
+
+type DeclineContactRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineContactRequestRequest) Reset() {
+	*x = DeclineContactRequestRequest{}
+	mi := &file_proto_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineContactRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineContactRequestRequest) ProtoMessage() {}
+
+func (x *DeclineContactRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineContactRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclineContactRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeclineContactRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeclineContactRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type DeclineContactRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineContactRequestResponse) Reset() {
+	*x = DeclineContactRequestResponse{}
+	mi := &file_proto_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineContactRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineContactRequestResponse) ProtoMessage() {}
+
+func (x *DeclineContactRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineContactRequestResponse.ProtoReflect.Descriptor instead.
+func (*DeclineContactRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{39}
+}
+
+type WithdrawContactRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 当前用户（发起方）
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawContactRequestRequest) Reset() {
+	*x = WithdrawContactRequestRequest{}
+	mi := &file_proto_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawContactRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawContactRequestRequest) ProtoMessage() {}
+
+func (x *WithdrawContactRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawContactRequestRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawContactRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WithdrawContactRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WithdrawContactRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type WithdrawContactRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawContactRequestResponse) Reset() {
+	*x = WithdrawContactRequestResponse{}
+	mi := &file_proto_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawContactRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawContactRequestResponse) ProtoMessage() {}
+
+func (x *WithdrawContactRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawContactRequestResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawContactRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{41}
+}
+
+// 黑名单
+type BlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 当前用户（拉黑方）
+	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_proto_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_proto_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{43}
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_proto_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnblockUserRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_proto_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{45}
+}
+
+type ListBlockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，空表示第一页
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`  // 默认 50，最大 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedRequest) Reset() {
+	*x = ListBlockedRequest{}
+	mi := &file_proto_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedRequest) ProtoMessage() {}
+
+func (x *ListBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListBlockedRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListBlockedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBlockedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BlockedAt     int64                  `protobuf:"varint,2,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_proto_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BlockedUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetBlockedAt() int64 {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return 0
+}
+
+type ListBlockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*BlockedUser         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 为空表示已到末页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedResponse) Reset() {
+	*x = ListBlockedResponse{}
+	mi := &file_proto_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedResponse) ProtoMessage() {}
+
+func (x *ListBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListBlockedResponse) GetItems() []*BlockedUser {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListBlockedResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CheckBlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PeerId        string                 `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlockRequest) Reset() {
+	*x = CheckBlockRequest{}
+	mi := &file_proto_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlockRequest) ProtoMessage() {}
+
+func (x *CheckBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlockRequest.ProtoReflect.Descriptor instead.
+func (*CheckBlockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CheckBlockRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckBlockRequest) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+type CheckBlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockedByUser bool                   `protobuf:"varint,1,opt,name=blocked_by_user,json=blockedByUser,proto3" json:"blocked_by_user,omitempty"` // user_id 拉黑了 peer_id
+	BlockedByPeer bool                   `protobuf:"varint,2,opt,name=blocked_by_peer,json=blockedByPeer,proto3" json:"blocked_by_peer,omitempty"` // peer_id 拉黑了 user_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlockResponse) Reset() {
+	*x = CheckBlockResponse{}
+	mi := &file_proto_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlockResponse) ProtoMessage() {}
+
+func (x *CheckBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlockResponse.ProtoReflect.Descriptor instead.
+func (*CheckBlockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CheckBlockResponse) GetBlockedByUser() bool {
+	if x != nil {
+		return x.BlockedByUser
+	}
+	return false
+}
+
+func (x *CheckBlockResponse) GetBlockedByPeer() bool {
+	if x != nil {
+		return x.BlockedByPeer
+	}
+	return false
+}
+
+// PrivacySettings 取值：find_by / group_add / presence_visibility 为 everyone | contacts | nobody，
+// contact_request 为 everyone | contacts_of_contacts | nobody；contacts 指对方在我的联系人中
+type PrivacySettings struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FindBy             string                 `protobuf:"bytes,1,opt,name=find_by,json=findBy,proto3" json:"find_by,omitempty"`
+	ContactRequest     string                 `protobuf:"bytes,2,opt,name=contact_request,json=contactRequest,proto3" json:"contact_request,omitempty"`
+	GroupAdd           string                 `protobuf:"bytes,3,opt,name=group_add,json=groupAdd,proto3" json:"group_add,omitempty"`
+	PresenceVisibility string                 `protobuf:"bytes,4,opt,name=presence_visibility,json=presenceVisibility,proto3" json:"presence_visibility,omitempty"`
+	// 是否出现在 SearchUsers 结果中
+	Discoverable  bool `protobuf:"varint,5,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivacySettings) Reset() {
+	*x = PrivacySettings{}
+	mi := &file_proto_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettings) ProtoMessage() {}
+
+func (x *PrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettings.ProtoReflect.Descriptor instead.
+func (*PrivacySettings) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PrivacySettings) GetFindBy() string {
+	if x != nil {
+		return x.FindBy
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetContactRequest() string {
+	if x != nil {
+		return x.ContactRequest
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetGroupAdd() string {
+	if x != nil {
+		return x.GroupAdd
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetPresenceVisibility() string {
+	if x != nil {
+		return x.PresenceVisibility
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetDiscoverable() bool {
+	if x != nil {
+		return x.Discoverable
+	}
+	return false
+}
+
+type GetPrivacySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingsRequest) Reset() {
+	*x = GetPrivacySettingsRequest{}
+	mi := &file_proto_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsRequest) ProtoMessage() {}
+
+func (x *GetPrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPrivacySettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetPrivacySettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *PrivacySettings       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingsResponse) Reset() {
+	*x = GetPrivacySettingsResponse{}
+	mi := &file_proto_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsResponse) ProtoMessage() {}
+
+func (x *GetPrivacySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetPrivacySettingsResponse) GetSettings() *PrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// 仅修改传入的字段
+type UpdatePrivacySettingsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FindBy             *string                `protobuf:"bytes,2,opt,name=find_by,json=findBy,proto3,oneof" json:"find_by,omitempty"`
+	ContactRequest     *string                `protobuf:"bytes,3,opt,name=contact_request,json=contactRequest,proto3,oneof" json:"contact_request,omitempty"`
+	GroupAdd           *string                `protobuf:"bytes,4,opt,name=group_add,json=groupAdd,proto3,oneof" json:"group_add,omitempty"`
+	PresenceVisibility *string                `protobuf:"bytes,5,opt,name=presence_visibility,json=presenceVisibility,proto3,oneof" json:"presence_visibility,omitempty"`
+	Discoverable       *bool                  `protobuf:"varint,6,opt,name=discoverable,proto3,oneof" json:"discoverable,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsRequest) Reset() {
+	*x = UpdatePrivacySettingsRequest{}
+	mi := &file_proto_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdatePrivacySettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetFindBy() string {
+	if x != nil && x.FindBy != nil {
+		return *x.FindBy
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetContactRequest() string {
+	if x != nil && x.ContactRequest != nil {
+		return *x.ContactRequest
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetGroupAdd() string {
+	if x != nil && x.GroupAdd != nil {
+		return *x.GroupAdd
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetPresenceVisibility() string {
+	if x != nil && x.PresenceVisibility != nil {
+		return *x.PresenceVisibility
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsRequest) GetDiscoverable() bool {
+	if x != nil && x.Discoverable != nil {
+		return *x.Discoverable
+	}
+	return false
+}
+
+type UpdatePrivacySettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *PrivacySettings       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsResponse) Reset() {
+	*x = UpdatePrivacySettingsResponse{}
+	mi := &file_proto_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsResponse) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdatePrivacySettingsResponse) GetSettings() *PrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type CheckPrivacyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId string                 `protobuf:"bytes,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	// find | contact_request | group_add | presence
+	Action        string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	UserIds       []string `protobuf:"bytes,3,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPrivacyRequest) Reset() {
+	*x = CheckPrivacyRequest{}
+	mi := &file_proto_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPrivacyRequest) ProtoMessage() {}
+
+func (x *CheckPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*CheckPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CheckPrivacyRequest) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *CheckPrivacyRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CheckPrivacyRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type CheckPrivacyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 不允许 viewer 执行 action 的用户
+	DeniedUserIds []string `protobuf:"bytes,1,rep,name=denied_user_ids,json=deniedUserIds,proto3" json:"denied_user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPrivacyResponse) Reset() {
+	*x = CheckPrivacyResponse{}
+	mi := &file_proto_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPrivacyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPrivacyResponse) ProtoMessage() {}
+
+func (x *CheckPrivacyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPrivacyResponse.ProtoReflect.Descriptor instead.
+func (*CheckPrivacyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CheckPrivacyResponse) GetDeniedUserIds() []string {
+	if x != nil {
+		return x.DeniedUserIds
+	}
+	return nil
+}
+
+type AccountDeletion struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 发起人用户 ID，用户本人申请时等于 user_id
+	RequestedBy string `protobuf:"bytes,2,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt int64  `protobuf:"varint,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// 到达该时间（Unix 秒）后账号被清除，此前可撤销
+	PurgeAt       int64 `protobuf:"varint,4,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountDeletion) Reset() {
+	*x = AccountDeletion{}
+	mi := &file_proto_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDeletion) ProtoMessage() {}
+
+func (x *AccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDeletion.ProtoReflect.Descriptor instead.
+func (*AccountDeletion) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AccountDeletion) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetPurgeAt() int64 {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return 0
+}
+
+type RequestAccountDeletionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 管理员代为申请时为管理员用户 ID，为空表示用户本人申请
+	OperatorId string `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// 宽限期（秒），仅管理员申请时生效；<=0 使用服务端默认值
+	GraceSeconds  int64 `protobuf:"varint,3,opt,name=grace_seconds,json=graceSeconds,proto3" json:"grace_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccountDeletionRequest) Reset() {
+	*x = RequestAccountDeletionRequest{}
+	mi := &file_proto_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountDeletionRequest) ProtoMessage() {}
+
+func (x *RequestAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*RequestAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RequestAccountDeletionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestAccountDeletionRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *RequestAccountDeletionRequest) GetGraceSeconds() int64 {
+	if x != nil {
+		return x.GraceSeconds
+	}
+	return 0
+}
+
+type RequestAccountDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deletion      *AccountDeletion       `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccountDeletionResponse) Reset() {
+	*x = RequestAccountDeletionResponse{}
+	mi := &file_proto_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountDeletionResponse) ProtoMessage() {}
+
+func (x *RequestAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccountDeletionResponse.ProtoReflect.Descriptor instead.
+func (*RequestAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RequestAccountDeletionResponse) GetDeletion() *AccountDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+type CancelAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionRequest) Reset() {
+	*x = CancelAccountDeletionRequest{}
+	mi := &file_proto_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionRequest) ProtoMessage() {}
+
+func (x *CancelAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CancelAccountDeletionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CancelAccountDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionResponse) Reset() {
+	*x = CancelAccountDeletionResponse{}
+	mi := &file_proto_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (x *ContactRequestItem) Reset() {
-	*x = ContactRequestItem{}
-	mi := &file_proto_user_proto_msgTypes[17]
+func (*CancelAccountDeletionResponse) ProtoMessage() {}
+
+func (x *CancelAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionResponse.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{62}
+}
+
+type GetAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountDeletionRequest) Reset() {
+	*x = GetAccountDeletionRequest{}
+	mi := &file_proto_user_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContactRequestItem) String() string {
+func (x *GetAccountDeletionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContactRequestItem) ProtoMessage() {}
+func (*GetAccountDeletionRequest) ProtoMessage() {}
 
-func (x *ContactRequestItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[17]
+func (x *GetAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,68 +3551,241 @@ func (x *ContactRequestItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContactRequestItem.ProtoReflect.Descriptor instead.
-func (*ContactRequestItem) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ContactRequestItem) GetRequestId() string {
+func (x *GetAccountDeletionRequest) GetUserId() string {
 	if x != nil {
-		return x.RequestId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ContactRequestItem) GetFromUserId() string {
+type GetAccountDeletionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 没有待处理的注销申请时为空
+	Deletion      *AccountDeletion `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountDeletionResponse) Reset() {
+	*x = GetAccountDeletionResponse{}
+	mi := &file_proto_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountDeletionResponse) ProtoMessage() {}
+
+func (x *GetAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[64]
 	if x != nil {
-		return x.FromUserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountDeletionResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetAccountDeletionResponse) GetDeletion() *AccountDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+type DataExport struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// pending | running | ready | failed
+	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	SizeBytes int64  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// failed 时的错误信息
+	Error       string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt int64  `protobuf:"varint,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// ready 后归档的保留截止时间，过期后删除
+	ExpiresAt     int64 `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_proto_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DataExport) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ContactRequestItem) GetToUserId() string {
+func (x *DataExport) GetUserId() string {
 	if x != nil {
-		return x.ToUserId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ContactRequestItem) GetMessage() string {
+func (x *DataExport) GetStatus() string {
 	if x != nil {
-		return x.Message
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ContactRequestItem) GetCreatedAt() int64 {
+func (x *DataExport) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DataExport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DataExport) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-type ListContactRequestsResponse struct {
+func (x *DataExport) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *DataExport) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type RequestDataExportRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 管理员代为申请时为管理员用户 ID，为空表示用户本人申请
+	OperatorId    string `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	mi := &file_proto_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportRequest) ProtoMessage() {}
+
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RequestDataExportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestDataExportRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type RequestDataExportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ContactRequestItem  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Export        *DataExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListContactRequestsResponse) Reset() {
-	*x = ListContactRequestsResponse{}
-	mi := &file_proto_user_proto_msgTypes[18]
+func (x *RequestDataExportResponse) Reset() {
+	*x = RequestDataExportResponse{}
+	mi := &file_proto_user_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContactRequestsResponse) String() string {
+func (x *RequestDataExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContactRequestsResponse) ProtoMessage() {}
+func (*RequestDataExportResponse) ProtoMessage() {}
 
-func (x *ListContactRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[18]
+func (x *RequestDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,41 +3796,41 @@ func (x *ListContactRequestsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContactRequestsResponse.ProtoReflect.Descriptor instead.
-func (*ListContactRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use RequestDataExportResponse.ProtoReflect.Descriptor instead.
+func (*RequestDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListContactRequestsResponse) GetItems() []*ContactRequestItem {
+func (x *RequestDataExportResponse) GetExport() *DataExport {
 	if x != nil {
-		return x.Items
+		return x.Export
 	}
 	return nil
 }
 
-type AcceptContactRequestRequest struct {
+type GetDataExportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 当前用户（接收方）
-	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExportId      string                 `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptContactRequestRequest) Reset() {
-	*x = AcceptContactRequestRequest{}
-	mi := &file_proto_user_proto_msgTypes[19]
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_proto_user_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptContactRequestRequest) String() string {
+func (x *GetDataExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptContactRequestRequest) ProtoMessage() {}
+func (*GetDataExportRequest) ProtoMessage() {}
 
-func (x *AcceptContactRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[19]
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,46 +3841,47 @@ func (x *AcceptContactRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptContactRequestRequest.ProtoReflect.Descriptor instead.
-func (*AcceptContactRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *AcceptContactRequestRequest) GetUserId() string {
+func (x *GetDataExportRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *AcceptContactRequestRequest) GetRequestId() string {
+func (x *GetDataExportRequest) GetExportId() string {
 	if x != nil {
-		return x.RequestId
+		return x.ExportId
 	}
 	return ""
 }
 
-type AcceptContactRequestResponse struct {
+type GetDataExportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *DataExport            `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptContactRequestResponse) Reset() {
-	*x = AcceptContactRequestResponse{}
-	mi := &file_proto_user_proto_msgTypes[20]
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	mi := &file_proto_user_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptContactRequestResponse) String() string {
+func (x *GetDataExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptContactRequestResponse) ProtoMessage() {}
+func (*GetDataExportResponse) ProtoMessage() {}
 
-func (x *AcceptContactRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[20]
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,34 +3892,41 @@ func (x *AcceptContactRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptContactRequestResponse.ProtoReflect.Descriptor instead.
-func (*AcceptContactRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{69}
 }
 
-type DeclineContactRequestRequest struct {
+func (x *GetDataExportResponse) GetExport() *DataExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type DownloadDataExportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ExportId      string                 `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeclineContactRequestRequest) Reset() {
-	*x = DeclineContactRequestRequest{}
-	mi := &file_proto_user_proto_msgTypes[21]
+func (x *DownloadDataExportRequest) Reset() {
+	*x = DownloadDataExportRequest{}
+	mi := &file_proto_user_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeclineContactRequestRequest) String() string {
+func (x *DownloadDataExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeclineContactRequestRequest) ProtoMessage() {}
+func (*DownloadDataExportRequest) ProtoMessage() {}
 
-func (x *DeclineContactRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[21]
+func (x *DownloadDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,46 +3937,47 @@ func (x *DeclineContactRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeclineContactRequestRequest.ProtoReflect.Descriptor instead.
-func (*DeclineContactRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DownloadDataExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeclineContactRequestRequest) GetUserId() string {
+func (x *DownloadDataExportRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeclineContactRequestRequest) GetRequestId() string {
+func (x *DownloadDataExportRequest) GetExportId() string {
 	if x != nil {
-		return x.RequestId
+		return x.ExportId
 	}
 	return ""
 }
 
-type DeclineContactRequestResponse struct {
+type DataExportChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeclineContactRequestResponse) Reset() {
-	*x = DeclineContactRequestResponse{}
-	mi := &file_proto_user_proto_msgTypes[22]
+func (x *DataExportChunk) Reset() {
+	*x = DataExportChunk{}
+	mi := &file_proto_user_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeclineContactRequestResponse) String() string {
+func (x *DataExportChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeclineContactRequestResponse) ProtoMessage() {}
+func (*DataExportChunk) ProtoMessage() {}
 
-func (x *DeclineContactRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[22]
+func (x *DataExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,64 +3988,180 @@ func (x *DeclineContactRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeclineContactRequestResponse.ProtoReflect.Descriptor instead.
-func (*DeclineContactRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use DataExportChunk.ProtoReflect.Descriptor instead.
+func (*DataExportChunk) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DataExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
 var File_proto_user_proto protoreflect.FileDescriptor
 
 const file_proto_user_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/user.proto\x12\fbeehive.user\"6\n" +
+	"\x10proto/user.proto\x12\fbeehive.user\"S\n" +
 	"\x18GetUserByUsernameRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\" \n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\"\\\n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\x1d\n" +
+	"\n" +
+	"if_version\x18\x03 \x01(\x03R\tifVersion\"\\\n" +
 	"\x0fGetUserResponse\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.beehive.user.UserR\x04user\"(\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.beehive.user.UserR\x04user\x12!\n" +
+	"\fnot_modified\x18\x02 \x01(\bR\vnotModified\"\xe5\x01\n" +
 	"\x14BatchGetUsersRequest\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\tR\x03ids\"A\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\tR\bviewerId\x12\\\n" +
+	"\x0eknown_versions\x18\x03 \x03(\v25.beehive.user.BatchGetUsersRequest.KnownVersionsEntryR\rknownVersions\x1a@\n" +
+	"\x12KnownVersionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"f\n" +
 	"\x15BatchGetUsersResponse\x12(\n" +
-	"\x05users\x18\x01 \x03(\v2\x12.beehive.user.UserR\x05users\"p\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.beehive.user.UserR\x05users\x12#\n" +
+	"\runchanged_ids\x18\x02 \x03(\tR\funchangedIds\"\xaa\x01\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1d\n" +
 	"\n" +
 	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x10\n" +
-	"\x03bio\x18\x04 \x01(\tR\x03bio\"<\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\x12'\n" +
+	"\fdiscoverable\x18\x05 \x01(\bH\x00R\fdiscoverable\x88\x01\x01B\x0f\n" +
+	"\r_discoverable\"<\n" +
 	"\x12UpdateUserResponse\x12&\n" +
-	"\x04user\x18\x01 \x01(\v2\x12.beehive.user.UserR\x04user\"{\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.beehive.user.UserR\x04user\"\x97\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1d\n" +
 	"\n" +
 	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x10\n" +
 	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\"V\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12 \n" +
+	"\flast_seen_at\x18\x06 \x01(\x03R\n" +
+	"lastSeenAt\x12?\n" +
+	"\rcustom_status\x18\a \x01(\v2\x1a.beehive.user.CustomStatusR\fcustomStatus\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\"V\n" +
+	"\x1cStreamProfileAudienceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x02 \x01(\x05R\tbatchSize\"1\n" +
+	"\x14ProfileAudienceChunk\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"W\n" +
+	"\fCustomStatus\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"O\n" +
+	"\x12SetLastSeenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\flast_seen_at\x18\x02 \x01(\x03R\n" +
+	"lastSeenAt\"\x15\n" +
+	"\x13SetLastSeenResponse\"z\n" +
+	"\x16SetCustomStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\x03R\texpiresAt\"Z\n" +
+	"\x17SetCustomStatusResponse\x12?\n" +
+	"\rcustom_status\x18\x01 \x01(\v2\x1a.beehive.user.CustomStatusR\fcustomStatus\"q\n" +
+	"\x12SearchUsersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"`\n" +
+	"\x13SearchUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.beehive.user.UserR\x05users\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"V\n" +
 	"\x11AddContactRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12&\n" +
 	"\x0fcontact_user_id\x18\x02 \x01(\tR\rcontactUserId\"\x14\n" +
-	"\x12AddContactResponse\"0\n" +
+	"\x12AddContactResponse\"\xb2\x01\n" +
 	"\x13ListContactsRequest\x12\x19\n" +
-	"\bowner_id\x18\x01 \x01(\tR\aownerId\"@\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12!\n" +
+	"\fstarred_only\x18\x04 \x01(\bR\vstarredOnly\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"sync_token\x18\x06 \x01(\tR\tsyncToken\"\xcc\x01\n" +
 	"\x14ListContactsResponse\x12(\n" +
-	"\x10contact_user_ids\x18\x01 \x03(\tR\x0econtactUserIds\"Y\n" +
+	"\x10contact_user_ids\x18\x01 \x03(\tR\x0econtactUserIds\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.beehive.user.ContactInfoR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x1d\n" +
+	"\n" +
+	"sync_token\x18\x04 \x01(\tR\tsyncToken\x12\x19\n" +
+	"\bhas_more\x18\x05 \x01(\bR\ahasMore\"\x8d\x02\n" +
+	"\vContactInfo\x12&\n" +
+	"\x0fcontact_user_id\x18\x01 \x01(\tR\rcontactUserId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06remark\x18\x03 \x01(\tR\x06remark\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x18\n" +
+	"\astarred\x18\x05 \x01(\bR\astarred\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03R\tupdatedAt\x12&\n" +
+	"\x04user\x18\t \x01(\v2\x12.beehive.user.UserR\x04user\"\xce\x01\n" +
+	"\x14UpdateContactRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12&\n" +
+	"\x0fcontact_user_id\x18\x02 \x01(\tR\rcontactUserId\x12\x1b\n" +
+	"\x06remark\x18\x03 \x01(\tH\x00R\x06remark\x88\x01\x01\x12\x1d\n" +
+	"\astarred\x18\x04 \x01(\bH\x01R\astarred\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x05 \x01(\tH\x02R\x04note\x88\x01\x01B\t\n" +
+	"\a_remarkB\n" +
+	"\n" +
+	"\b_starredB\a\n" +
+	"\x05_note\"L\n" +
+	"\x15UpdateContactResponse\x123\n" +
+	"\acontact\x18\x01 \x01(\v2\x19.beehive.user.ContactInfoR\acontact\"n\n" +
+	"\x15SetContactTagsRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12&\n" +
+	"\x0fcontact_user_id\x18\x02 \x01(\tR\rcontactUserId\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"M\n" +
+	"\x16SetContactTagsResponse\x123\n" +
+	"\acontact\x18\x01 \x01(\v2\x19.beehive.user.ContactInfoR\acontact\"Y\n" +
 	"\x14RemoveContactRequest\x12\x19\n" +
 	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12&\n" +
 	"\x0fcontact_user_id\x18\x02 \x01(\tR\rcontactUserId\"\x17\n" +
-	"\x15RemoveContactResponse\"w\n" +
+	"\x15RemoveContactResponse\"_\n" +
+	"\x16SuggestContactsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x89\x01\n" +
+	"\x11ContactSuggestion\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.beehive.user.UserR\x04user\x12'\n" +
+	"\x0fmutual_contacts\x18\x02 \x01(\x05R\x0emutualContacts\x12#\n" +
+	"\rshared_groups\x18\x03 \x01(\x05R\fsharedGroups\"q\n" +
+	"\x17SuggestContactsResponse\x125\n" +
+	"\x05items\x18\x01 \x03(\v2\x1f.beehive.user.ContactSuggestionR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"w\n" +
 	"\x1bCreateContactRequestRequest\x12 \n" +
 	"\ffrom_user_id\x18\x01 \x01(\tR\n" +
 	"fromUserId\x12\x1c\n" +
 	"\n" +
 	"to_user_id\x18\x02 \x01(\tR\btoUserId\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"=\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\\\n" +
 	"\x1cCreateContactRequestResponse\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\"5\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"I\n" +
 	"\x1aListContactRequestsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xac\x01\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04sent\x18\x02 \x01(\bR\x04sent\"\xcb\x01\n" +
 	"\x12ContactRequestItem\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12 \n" +
@@ -1200,7 +4171,9 @@ const file_proto_user_proto_rawDesc = "" +
 	"to_user_id\x18\x03 \x01(\tR\btoUserId\x12\x18\n" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"U\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\"U\n" +
 	"\x1bListContactRequestsResponse\x126\n" +
 	"\x05items\x18\x01 \x03(\v2 .beehive.user.ContactRequestItemR\x05items\"U\n" +
 	"\x1bAcceptContactRequestRequest\x12\x17\n" +
@@ -1212,21 +4185,154 @@ const file_proto_user_proto_rawDesc = "" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x02 \x01(\tR\trequestId\"\x1f\n" +
-	"\x1dDeclineContactRequestResponse2\x9a\b\n" +
+	"\x1dDeclineContactRequestResponse\"W\n" +
+	"\x1dWithdrawContactRequestRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\" \n" +
+	"\x1eWithdrawContactRequestResponse\"Q\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\tR\ftargetUserId\"\x13\n" +
+	"\x11BlockUserResponse\"S\n" +
+	"\x12UnblockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\tR\ftargetUserId\"\x15\n" +
+	"\x13UnblockUserResponse\"[\n" +
+	"\x12ListBlockedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"E\n" +
+	"\vBlockedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"blocked_at\x18\x02 \x01(\x03R\tblockedAt\"g\n" +
+	"\x13ListBlockedResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.beehive.user.BlockedUserR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"E\n" +
+	"\x11CheckBlockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\apeer_id\x18\x02 \x01(\tR\x06peerId\"d\n" +
+	"\x12CheckBlockResponse\x12&\n" +
+	"\x0fblocked_by_user\x18\x01 \x01(\bR\rblockedByUser\x12&\n" +
+	"\x0fblocked_by_peer\x18\x02 \x01(\bR\rblockedByPeer\"\xc5\x01\n" +
+	"\x0fPrivacySettings\x12\x17\n" +
+	"\afind_by\x18\x01 \x01(\tR\x06findBy\x12'\n" +
+	"\x0fcontact_request\x18\x02 \x01(\tR\x0econtactRequest\x12\x1b\n" +
+	"\tgroup_add\x18\x03 \x01(\tR\bgroupAdd\x12/\n" +
+	"\x13presence_visibility\x18\x04 \x01(\tR\x12presenceVisibility\x12\"\n" +
+	"\fdiscoverable\x18\x05 \x01(\bR\fdiscoverable\"4\n" +
+	"\x19GetPrivacySettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"W\n" +
+	"\x1aGetPrivacySettingsResponse\x129\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1d.beehive.user.PrivacySettingsR\bsettings\"\xdb\x02\n" +
+	"\x1cUpdatePrivacySettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\afind_by\x18\x02 \x01(\tH\x00R\x06findBy\x88\x01\x01\x12,\n" +
+	"\x0fcontact_request\x18\x03 \x01(\tH\x01R\x0econtactRequest\x88\x01\x01\x12 \n" +
+	"\tgroup_add\x18\x04 \x01(\tH\x02R\bgroupAdd\x88\x01\x01\x124\n" +
+	"\x13presence_visibility\x18\x05 \x01(\tH\x03R\x12presenceVisibility\x88\x01\x01\x12'\n" +
+	"\fdiscoverable\x18\x06 \x01(\bH\x04R\fdiscoverable\x88\x01\x01B\n" +
+	"\n" +
+	"\b_find_byB\x12\n" +
+	"\x10_contact_requestB\f\n" +
+	"\n" +
+	"_group_addB\x16\n" +
+	"\x14_presence_visibilityB\x0f\n" +
+	"\r_discoverable\"Z\n" +
+	"\x1dUpdatePrivacySettingsResponse\x129\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1d.beehive.user.PrivacySettingsR\bsettings\"e\n" +
+	"\x13CheckPrivacyRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\tR\bviewerId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x19\n" +
+	"\buser_ids\x18\x03 \x03(\tR\auserIds\">\n" +
+	"\x14CheckPrivacyResponse\x12&\n" +
+	"\x0fdenied_user_ids\x18\x01 \x03(\tR\rdeniedUserIds\"\x8b\x01\n" +
+	"\x0fAccountDeletion\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\frequested_by\x18\x02 \x01(\tR\vrequestedBy\x12!\n" +
+	"\frequested_at\x18\x03 \x01(\x03R\vrequestedAt\x12\x19\n" +
+	"\bpurge_at\x18\x04 \x01(\x03R\apurgeAt\"~\n" +
+	"\x1dRequestAccountDeletionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12#\n" +
+	"\rgrace_seconds\x18\x03 \x01(\x03R\fgraceSeconds\"[\n" +
+	"\x1eRequestAccountDeletionResponse\x129\n" +
+	"\bdeletion\x18\x01 \x01(\v2\x1d.beehive.user.AccountDeletionR\bdeletion\"7\n" +
+	"\x1cCancelAccountDeletionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1f\n" +
+	"\x1dCancelAccountDeletionResponse\"4\n" +
+	"\x19GetAccountDeletionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"W\n" +
+	"\x1aGetAccountDeletionResponse\x129\n" +
+	"\bdeletion\x18\x01 \x01(\v2\x1d.beehive.user.AccountDeletionR\bdeletion\"\xe3\x01\n" +
+	"\n" +
+	"DataExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\a \x01(\x03R\vcompletedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\x03R\texpiresAt\"T\n" +
+	"\x18RequestDataExportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\"M\n" +
+	"\x19RequestDataExportResponse\x120\n" +
+	"\x06export\x18\x01 \x01(\v2\x18.beehive.user.DataExportR\x06export\"L\n" +
+	"\x14GetDataExportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\tR\bexportId\"I\n" +
+	"\x15GetDataExportResponse\x120\n" +
+	"\x06export\x18\x01 \x01(\v2\x18.beehive.user.DataExportR\x06export\"Q\n" +
+	"\x19DownloadDataExportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\tR\bexportId\"%\n" +
+	"\x0fDataExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data2\x82\x18\n" +
 	"\vUserService\x12F\n" +
 	"\aGetUser\x12\x1c.beehive.user.GetUserRequest\x1a\x1d.beehive.user.GetUserResponse\x12Z\n" +
 	"\x11GetUserByUsername\x12&.beehive.user.GetUserByUsernameRequest\x1a\x1d.beehive.user.GetUserResponse\x12X\n" +
 	"\rBatchGetUsers\x12\".beehive.user.BatchGetUsersRequest\x1a#.beehive.user.BatchGetUsersResponse\x12O\n" +
 	"\n" +
-	"UpdateUser\x12\x1f.beehive.user.UpdateUserRequest\x1a .beehive.user.UpdateUserResponse\x12O\n" +
+	"UpdateUser\x12\x1f.beehive.user.UpdateUserRequest\x1a .beehive.user.UpdateUserResponse\x12i\n" +
+	"\x15StreamProfileAudience\x12*.beehive.user.StreamProfileAudienceRequest\x1a\".beehive.user.ProfileAudienceChunk0\x01\x12R\n" +
+	"\vSetLastSeen\x12 .beehive.user.SetLastSeenRequest\x1a!.beehive.user.SetLastSeenResponse\x12^\n" +
+	"\x0fSetCustomStatus\x12$.beehive.user.SetCustomStatusRequest\x1a%.beehive.user.SetCustomStatusResponse\x12R\n" +
+	"\vSearchUsers\x12 .beehive.user.SearchUsersRequest\x1a!.beehive.user.SearchUsersResponse\x12O\n" +
 	"\n" +
 	"AddContact\x12\x1f.beehive.user.AddContactRequest\x1a .beehive.user.AddContactResponse\x12U\n" +
 	"\fListContacts\x12!.beehive.user.ListContactsRequest\x1a\".beehive.user.ListContactsResponse\x12X\n" +
-	"\rRemoveContact\x12\".beehive.user.RemoveContactRequest\x1a#.beehive.user.RemoveContactResponse\x12m\n" +
+	"\rRemoveContact\x12\".beehive.user.RemoveContactRequest\x1a#.beehive.user.RemoveContactResponse\x12X\n" +
+	"\rUpdateContact\x12\".beehive.user.UpdateContactRequest\x1a#.beehive.user.UpdateContactResponse\x12[\n" +
+	"\x0eSetContactTags\x12#.beehive.user.SetContactTagsRequest\x1a$.beehive.user.SetContactTagsResponse\x12^\n" +
+	"\x0fSuggestContacts\x12$.beehive.user.SuggestContactsRequest\x1a%.beehive.user.SuggestContactsResponse\x12m\n" +
 	"\x14CreateContactRequest\x12).beehive.user.CreateContactRequestRequest\x1a*.beehive.user.CreateContactRequestResponse\x12j\n" +
 	"\x13ListContactRequests\x12(.beehive.user.ListContactRequestsRequest\x1a).beehive.user.ListContactRequestsResponse\x12m\n" +
 	"\x14AcceptContactRequest\x12).beehive.user.AcceptContactRequestRequest\x1a*.beehive.user.AcceptContactRequestResponse\x12p\n" +
-	"\x15DeclineContactRequest\x12*.beehive.user.DeclineContactRequestRequest\x1a+.beehive.user.DeclineContactRequestResponseB\x14Z\x12./services/user/pbb\x06proto3"
+	"\x15DeclineContactRequest\x12*.beehive.user.DeclineContactRequestRequest\x1a+.beehive.user.DeclineContactRequestResponse\x12s\n" +
+	"\x16WithdrawContactRequest\x12+.beehive.user.WithdrawContactRequestRequest\x1a,.beehive.user.WithdrawContactRequestResponse\x12L\n" +
+	"\tBlockUser\x12\x1e.beehive.user.BlockUserRequest\x1a\x1f.beehive.user.BlockUserResponse\x12R\n" +
+	"\vUnblockUser\x12 .beehive.user.UnblockUserRequest\x1a!.beehive.user.UnblockUserResponse\x12R\n" +
+	"\vListBlocked\x12 .beehive.user.ListBlockedRequest\x1a!.beehive.user.ListBlockedResponse\x12O\n" +
+	"\n" +
+	"CheckBlock\x12\x1f.beehive.user.CheckBlockRequest\x1a .beehive.user.CheckBlockResponse\x12g\n" +
+	"\x12GetPrivacySettings\x12'.beehive.user.GetPrivacySettingsRequest\x1a(.beehive.user.GetPrivacySettingsResponse\x12p\n" +
+	"\x15UpdatePrivacySettings\x12*.beehive.user.UpdatePrivacySettingsRequest\x1a+.beehive.user.UpdatePrivacySettingsResponse\x12U\n" +
+	"\fCheckPrivacy\x12!.beehive.user.CheckPrivacyRequest\x1a\".beehive.user.CheckPrivacyResponse\x12s\n" +
+	"\x16RequestAccountDeletion\x12+.beehive.user.RequestAccountDeletionRequest\x1a,.beehive.user.RequestAccountDeletionResponse\x12p\n" +
+	"\x15CancelAccountDeletion\x12*.beehive.user.CancelAccountDeletionRequest\x1a+.beehive.user.CancelAccountDeletionResponse\x12g\n" +
+	"\x12GetAccountDeletion\x12'.beehive.user.GetAccountDeletionRequest\x1a(.beehive.user.GetAccountDeletionResponse\x12d\n" +
+	"\x11RequestDataExport\x12&.beehive.user.RequestDataExportRequest\x1a'.beehive.user.RequestDataExportResponse\x12X\n" +
+	"\rGetDataExport\x12\".beehive.user.GetDataExportRequest\x1a#.beehive.user.GetDataExportResponse\x12^\n" +
+	"\x12DownloadDataExport\x12'.beehive.user.DownloadDataExportRequest\x1a\x1d.beehive.user.DataExportChunk0\x01B\x14Z\x12./services/user/pbb\x06proto3"
 
 var (
 	file_proto_user_proto_rawDescOnce sync.Once
@@ -1240,64 +4346,173 @@ func file_proto_user_proto_rawDescGZIP() []byte {
 	return file_proto_user_proto_rawDescData
 }
 
-var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_proto_user_proto_goTypes = []any{
-	(*GetUserByUsernameRequest)(nil),      // 0: beehive.user.GetUserByUsernameRequest
-	(*GetUserRequest)(nil),                // 1: beehive.user.GetUserRequest
-	(*GetUserResponse)(nil),               // 2: beehive.user.GetUserResponse
-	(*BatchGetUsersRequest)(nil),          // 3: beehive.user.BatchGetUsersRequest
-	(*BatchGetUsersResponse)(nil),         // 4: beehive.user.BatchGetUsersResponse
-	(*UpdateUserRequest)(nil),             // 5: beehive.user.UpdateUserRequest
-	(*UpdateUserResponse)(nil),            // 6: beehive.user.UpdateUserResponse
-	(*User)(nil),                          // 7: beehive.user.User
-	(*AddContactRequest)(nil),             // 8: beehive.user.AddContactRequest
-	(*AddContactResponse)(nil),            // 9: beehive.user.AddContactResponse
-	(*ListContactsRequest)(nil),           // 10: beehive.user.ListContactsRequest
-	(*ListContactsResponse)(nil),          // 11: beehive.user.ListContactsResponse
-	(*RemoveContactRequest)(nil),          // 12: beehive.user.RemoveContactRequest
-	(*RemoveContactResponse)(nil),         // 13: beehive.user.RemoveContactResponse
-	(*CreateContactRequestRequest)(nil),   // 14: beehive.user.CreateContactRequestRequest
-	(*CreateContactRequestResponse)(nil),  // 15: beehive.user.CreateContactRequestResponse
-	(*ListContactRequestsRequest)(nil),    // 16: beehive.user.ListContactRequestsRequest
-	(*ContactRequestItem)(nil),            // 17: beehive.user.ContactRequestItem
-	(*ListContactRequestsResponse)(nil),   // 18: beehive.user.ListContactRequestsResponse
-	(*AcceptContactRequestRequest)(nil),   // 19: beehive.user.AcceptContactRequestRequest
-	(*AcceptContactRequestResponse)(nil),  // 20: beehive.user.AcceptContactRequestResponse
-	(*DeclineContactRequestRequest)(nil),  // 21: beehive.user.DeclineContactRequestRequest
-	(*DeclineContactRequestResponse)(nil), // 22: beehive.user.DeclineContactRequestResponse
+	(*GetUserByUsernameRequest)(nil),       // 0: beehive.user.GetUserByUsernameRequest
+	(*GetUserRequest)(nil),                 // 1: beehive.user.GetUserRequest
+	(*GetUserResponse)(nil),                // 2: beehive.user.GetUserResponse
+	(*BatchGetUsersRequest)(nil),           // 3: beehive.user.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),          // 4: beehive.user.BatchGetUsersResponse
+	(*UpdateUserRequest)(nil),              // 5: beehive.user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),             // 6: beehive.user.UpdateUserResponse
+	(*User)(nil),                           // 7: beehive.user.User
+	(*StreamProfileAudienceRequest)(nil),   // 8: beehive.user.StreamProfileAudienceRequest
+	(*ProfileAudienceChunk)(nil),           // 9: beehive.user.ProfileAudienceChunk
+	(*CustomStatus)(nil),                   // 10: beehive.user.CustomStatus
+	(*SetLastSeenRequest)(nil),             // 11: beehive.user.SetLastSeenRequest
+	(*SetLastSeenResponse)(nil),            // 12: beehive.user.SetLastSeenResponse
+	(*SetCustomStatusRequest)(nil),         // 13: beehive.user.SetCustomStatusRequest
+	(*SetCustomStatusResponse)(nil),        // 14: beehive.user.SetCustomStatusResponse
+	(*SearchUsersRequest)(nil),             // 15: beehive.user.SearchUsersRequest
+	(*SearchUsersResponse)(nil),            // 16: beehive.user.SearchUsersResponse
+	(*AddContactRequest)(nil),              // 17: beehive.user.AddContactRequest
+	(*AddContactResponse)(nil),             // 18: beehive.user.AddContactResponse
+	(*ListContactsRequest)(nil),            // 19: beehive.user.ListContactsRequest
+	(*ListContactsResponse)(nil),           // 20: beehive.user.ListContactsResponse
+	(*ContactInfo)(nil),                    // 21: beehive.user.ContactInfo
+	(*UpdateContactRequest)(nil),           // 22: beehive.user.UpdateContactRequest
+	(*UpdateContactResponse)(nil),          // 23: beehive.user.UpdateContactResponse
+	(*SetContactTagsRequest)(nil),          // 24: beehive.user.SetContactTagsRequest
+	(*SetContactTagsResponse)(nil),         // 25: beehive.user.SetContactTagsResponse
+	(*RemoveContactRequest)(nil),           // 26: beehive.user.RemoveContactRequest
+	(*RemoveContactResponse)(nil),          // 27: beehive.user.RemoveContactResponse
+	(*SuggestContactsRequest)(nil),         // 28: beehive.user.SuggestContactsRequest
+	(*ContactSuggestion)(nil),              // 29: beehive.user.ContactSuggestion
+	(*SuggestContactsResponse)(nil),        // 30: beehive.user.SuggestContactsResponse
+	(*CreateContactRequestRequest)(nil),    // 31: beehive.user.CreateContactRequestRequest
+	(*CreateContactRequestResponse)(nil),   // 32: beehive.user.CreateContactRequestResponse
+	(*ListContactRequestsRequest)(nil),     // 33: beehive.user.ListContactRequestsRequest
+	(*ContactRequestItem)(nil),             // 34: beehive.user.ContactRequestItem
+	(*ListContactRequestsResponse)(nil),    // 35: beehive.user.ListContactRequestsResponse
+	(*AcceptContactRequestRequest)(nil),    // 36: beehive.user.AcceptContactRequestRequest
+	(*AcceptContactRequestResponse)(nil),   // 37: beehive.user.AcceptContactRequestResponse
+	(*DeclineContactRequestRequest)(nil),   // 38: beehive.user.DeclineContactRequestRequest
+	(*DeclineContactRequestResponse)(nil),  // 39: beehive.user.DeclineContactRequestResponse
+	(*WithdrawContactRequestRequest)(nil),  // 40: beehive.user.WithdrawContactRequestRequest
+	(*WithdrawContactRequestResponse)(nil), // 41: beehive.user.WithdrawContactRequestResponse
+	(*BlockUserRequest)(nil),               // 42: beehive.user.BlockUserRequest
+	(*BlockUserResponse)(nil),              // 43: beehive.user.BlockUserResponse
+	(*UnblockUserRequest)(nil),             // 44: beehive.user.UnblockUserRequest
+	(*UnblockUserResponse)(nil),            // 45: beehive.user.UnblockUserResponse
+	(*ListBlockedRequest)(nil),             // 46: beehive.user.ListBlockedRequest
+	(*BlockedUser)(nil),                    // 47: beehive.user.BlockedUser
+	(*ListBlockedResponse)(nil),            // 48: beehive.user.ListBlockedResponse
+	(*CheckBlockRequest)(nil),              // 49: beehive.user.CheckBlockRequest
+	(*CheckBlockResponse)(nil),             // 50: beehive.user.CheckBlockResponse
+	(*PrivacySettings)(nil),                // 51: beehive.user.PrivacySettings
+	(*GetPrivacySettingsRequest)(nil),      // 52: beehive.user.GetPrivacySettingsRequest
+	(*GetPrivacySettingsResponse)(nil),     // 53: beehive.user.GetPrivacySettingsResponse
+	(*UpdatePrivacySettingsRequest)(nil),   // 54: beehive.user.UpdatePrivacySettingsRequest
+	(*UpdatePrivacySettingsResponse)(nil),  // 55: beehive.user.UpdatePrivacySettingsResponse
+	(*CheckPrivacyRequest)(nil),            // 56: beehive.user.CheckPrivacyRequest
+	(*CheckPrivacyResponse)(nil),           // 57: beehive.user.CheckPrivacyResponse
+	(*AccountDeletion)(nil),                // 58: beehive.user.AccountDeletion
+	(*RequestAccountDeletionRequest)(nil),  // 59: beehive.user.RequestAccountDeletionRequest
+	(*RequestAccountDeletionResponse)(nil), // 60: beehive.user.RequestAccountDeletionResponse
+	(*CancelAccountDeletionRequest)(nil),   // 61: beehive.user.CancelAccountDeletionRequest
+	(*CancelAccountDeletionResponse)(nil),  // 62: beehive.user.CancelAccountDeletionResponse
+	(*GetAccountDeletionRequest)(nil),      // 63: beehive.user.GetAccountDeletionRequest
+	(*GetAccountDeletionResponse)(nil),     // 64: beehive.user.GetAccountDeletionResponse
+	(*DataExport)(nil),                     // 65: beehive.user.DataExport
+	(*RequestDataExportRequest)(nil),       // 66: beehive.user.RequestDataExportRequest
+	(*RequestDataExportResponse)(nil),      // 67: beehive.user.RequestDataExportResponse
+	(*GetDataExportRequest)(nil),           // 68: beehive.user.GetDataExportRequest
+	(*GetDataExportResponse)(nil),          // 69: beehive.user.GetDataExportResponse
+	(*DownloadDataExportRequest)(nil),      // 70: beehive.user.DownloadDataExportRequest
+	(*DataExportChunk)(nil),                // 71: beehive.user.DataExportChunk
+	nil,                                    // 72: beehive.user.BatchGetUsersRequest.KnownVersionsEntry
 }
 var file_proto_user_proto_depIdxs = []int32{
 	7,  // 0: beehive.user.GetUserResponse.user:type_name -> beehive.user.User
-	7,  // 1: beehive.user.BatchGetUsersResponse.users:type_name -> beehive.user.User
-	7,  // 2: beehive.user.UpdateUserResponse.user:type_name -> beehive.user.User
-	17, // 3: beehive.user.ListContactRequestsResponse.items:type_name -> beehive.user.ContactRequestItem
-	1,  // 4: beehive.user.UserService.GetUser:input_type -> beehive.user.GetUserRequest
-	0,  // 5: beehive.user.UserService.GetUserByUsername:input_type -> beehive.user.GetUserByUsernameRequest
-	3,  // 6: beehive.user.UserService.BatchGetUsers:input_type -> beehive.user.BatchGetUsersRequest
-	5,  // 7: beehive.user.UserService.UpdateUser:input_type -> beehive.user.UpdateUserRequest
-	8,  // 8: beehive.user.UserService.AddContact:input_type -> beehive.user.AddContactRequest
-	10, // 9: beehive.user.UserService.ListContacts:input_type -> beehive.user.ListContactsRequest
-	12, // 10: beehive.user.UserService.RemoveContact:input_type -> beehive.user.RemoveContactRequest
-	14, // 11: beehive.user.UserService.CreateContactRequest:input_type -> beehive.user.CreateContactRequestRequest
-	16, // 12: beehive.user.UserService.ListContactRequests:input_type -> beehive.user.ListContactRequestsRequest
-	19, // 13: beehive.user.UserService.AcceptContactRequest:input_type -> beehive.user.AcceptContactRequestRequest
-	21, // 14: beehive.user.UserService.DeclineContactRequest:input_type -> beehive.user.DeclineContactRequestRequest
-	2,  // 15: beehive.user.UserService.GetUser:output_type -> beehive.user.GetUserResponse
-	2,  // 16: beehive.user.UserService.GetUserByUsername:output_type -> beehive.user.GetUserResponse
-	4,  // 17: beehive.user.UserService.BatchGetUsers:output_type -> beehive.user.BatchGetUsersResponse
-	6,  // 18: beehive.user.UserService.UpdateUser:output_type -> beehive.user.UpdateUserResponse
-	9,  // 19: beehive.user.UserService.AddContact:output_type -> beehive.user.AddContactResponse
-	11, // 20: beehive.user.UserService.ListContacts:output_type -> beehive.user.ListContactsResponse
-	13, // 21: beehive.user.UserService.RemoveContact:output_type -> beehive.user.RemoveContactResponse
-	15, // 22: beehive.user.UserService.CreateContactRequest:output_type -> beehive.user.CreateContactRequestResponse
-	18, // 23: beehive.user.UserService.ListContactRequests:output_type -> beehive.user.ListContactRequestsResponse
-	20, // 24: beehive.user.UserService.AcceptContactRequest:output_type -> beehive.user.AcceptContactRequestResponse
-	22, // 25: beehive.user.UserService.DeclineContactRequest:output_type -> beehive.user.DeclineContactRequestResponse
-	15, // [15:26] is the sub-list for method output_type
-	4,  // [4:15] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	72, // 1: beehive.user.BatchGetUsersRequest.known_versions:type_name -> beehive.user.BatchGetUsersRequest.KnownVersionsEntry
+	7,  // 2: beehive.user.BatchGetUsersResponse.users:type_name -> beehive.user.User
+	7,  // 3: beehive.user.UpdateUserResponse.user:type_name -> beehive.user.User
+	10, // 4: beehive.user.User.custom_status:type_name -> beehive.user.CustomStatus
+	10, // 5: beehive.user.SetCustomStatusResponse.custom_status:type_name -> beehive.user.CustomStatus
+	7,  // 6: beehive.user.SearchUsersResponse.users:type_name -> beehive.user.User
+	21, // 7: beehive.user.ListContactsResponse.items:type_name -> beehive.user.ContactInfo
+	7,  // 8: beehive.user.ContactInfo.user:type_name -> beehive.user.User
+	21, // 9: beehive.user.UpdateContactResponse.contact:type_name -> beehive.user.ContactInfo
+	21, // 10: beehive.user.SetContactTagsResponse.contact:type_name -> beehive.user.ContactInfo
+	7,  // 11: beehive.user.ContactSuggestion.user:type_name -> beehive.user.User
+	29, // 12: beehive.user.SuggestContactsResponse.items:type_name -> beehive.user.ContactSuggestion
+	34, // 13: beehive.user.ListContactRequestsResponse.items:type_name -> beehive.user.ContactRequestItem
+	47, // 14: beehive.user.ListBlockedResponse.items:type_name -> beehive.user.BlockedUser
+	51, // 15: beehive.user.GetPrivacySettingsResponse.settings:type_name -> beehive.user.PrivacySettings
+	51, // 16: beehive.user.UpdatePrivacySettingsResponse.settings:type_name -> beehive.user.PrivacySettings
+	58, // 17: beehive.user.RequestAccountDeletionResponse.deletion:type_name -> beehive.user.AccountDeletion
+	58, // 18: beehive.user.GetAccountDeletionResponse.deletion:type_name -> beehive.user.AccountDeletion
+	65, // 19: beehive.user.RequestDataExportResponse.export:type_name -> beehive.user.DataExport
+	65, // 20: beehive.user.GetDataExportResponse.export:type_name -> beehive.user.DataExport
+	1,  // 21: beehive.user.UserService.GetUser:input_type -> beehive.user.GetUserRequest
+	0,  // 22: beehive.user.UserService.GetUserByUsername:input_type -> beehive.user.GetUserByUsernameRequest
+	3,  // 23: beehive.user.UserService.BatchGetUsers:input_type -> beehive.user.BatchGetUsersRequest
+	5,  // 24: beehive.user.UserService.UpdateUser:input_type -> beehive.user.UpdateUserRequest
+	8,  // 25: beehive.user.UserService.StreamProfileAudience:input_type -> beehive.user.StreamProfileAudienceRequest
+	11, // 26: beehive.user.UserService.SetLastSeen:input_type -> beehive.user.SetLastSeenRequest
+	13, // 27: beehive.user.UserService.SetCustomStatus:input_type -> beehive.user.SetCustomStatusRequest
+	15, // 28: beehive.user.UserService.SearchUsers:input_type -> beehive.user.SearchUsersRequest
+	17, // 29: beehive.user.UserService.AddContact:input_type -> beehive.user.AddContactRequest
+	19, // 30: beehive.user.UserService.ListContacts:input_type -> beehive.user.ListContactsRequest
+	26, // 31: beehive.user.UserService.RemoveContact:input_type -> beehive.user.RemoveContactRequest
+	22, // 32: beehive.user.UserService.UpdateContact:input_type -> beehive.user.UpdateContactRequest
+	24, // 33: beehive.user.UserService.SetContactTags:input_type -> beehive.user.SetContactTagsRequest
+	28, // 34: beehive.user.UserService.SuggestContacts:input_type -> beehive.user.SuggestContactsRequest
+	31, // 35: beehive.user.UserService.CreateContactRequest:input_type -> beehive.user.CreateContactRequestRequest
+	33, // 36: beehive.user.UserService.ListContactRequests:input_type -> beehive.user.ListContactRequestsRequest
+	36, // 37: beehive.user.UserService.AcceptContactRequest:input_type -> beehive.user.AcceptContactRequestRequest
+	38, // 38: beehive.user.UserService.DeclineContactRequest:input_type -> beehive.user.DeclineContactRequestRequest
+	40, // 39: beehive.user.UserService.WithdrawContactRequest:input_type -> beehive.user.WithdrawContactRequestRequest
+	42, // 40: beehive.user.UserService.BlockUser:input_type -> beehive.user.BlockUserRequest
+	44, // 41: beehive.user.UserService.UnblockUser:input_type -> beehive.user.UnblockUserRequest
+	46, // 42: beehive.user.UserService.ListBlocked:input_type -> beehive.user.ListBlockedRequest
+	49, // 43: beehive.user.UserService.CheckBlock:input_type -> beehive.user.CheckBlockRequest
+	52, // 44: beehive.user.UserService.GetPrivacySettings:input_type -> beehive.user.GetPrivacySettingsRequest
+	54, // 45: beehive.user.UserService.UpdatePrivacySettings:input_type -> beehive.user.UpdatePrivacySettingsRequest
+	56, // 46: beehive.user.UserService.CheckPrivacy:input_type -> beehive.user.CheckPrivacyRequest
+	59, // 47: beehive.user.UserService.RequestAccountDeletion:input_type -> beehive.user.RequestAccountDeletionRequest
+	61, // 48: beehive.user.UserService.CancelAccountDeletion:input_type -> beehive.user.CancelAccountDeletionRequest
+	63, // 49: beehive.user.UserService.GetAccountDeletion:input_type -> beehive.user.GetAccountDeletionRequest
+	66, // 50: beehive.user.UserService.RequestDataExport:input_type -> beehive.user.RequestDataExportRequest
+	68, // 51: beehive.user.UserService.GetDataExport:input_type -> beehive.user.GetDataExportRequest
+	70, // 52: beehive.user.UserService.DownloadDataExport:input_type -> beehive.user.DownloadDataExportRequest
+	2,  // 53: beehive.user.UserService.GetUser:output_type -> beehive.user.GetUserResponse
+	2,  // 54: beehive.user.UserService.GetUserByUsername:output_type -> beehive.user.GetUserResponse
+	4,  // 55: beehive.user.UserService.BatchGetUsers:output_type -> beehive.user.BatchGetUsersResponse
+	6,  // 56: beehive.user.UserService.UpdateUser:output_type -> beehive.user.UpdateUserResponse
+	9,  // 57: beehive.user.UserService.StreamProfileAudience:output_type -> beehive.user.ProfileAudienceChunk
+	12, // 58: beehive.user.UserService.SetLastSeen:output_type -> beehive.user.SetLastSeenResponse
+	14, // 59: beehive.user.UserService.SetCustomStatus:output_type -> beehive.user.SetCustomStatusResponse
+	16, // 60: beehive.user.UserService.SearchUsers:output_type -> beehive.user.SearchUsersResponse
+	18, // 61: beehive.user.UserService.AddContact:output_type -> beehive.user.AddContactResponse
+	20, // 62: beehive.user.UserService.ListContacts:output_type -> beehive.user.ListContactsResponse
+	27, // 63: beehive.user.UserService.RemoveContact:output_type -> beehive.user.RemoveContactResponse
+	23, // 64: beehive.user.UserService.UpdateContact:output_type -> beehive.user.UpdateContactResponse
+	25, // 65: beehive.user.UserService.SetContactTags:output_type -> beehive.user.SetContactTagsResponse
+	30, // 66: beehive.user.UserService.SuggestContacts:output_type -> beehive.user.SuggestContactsResponse
+	32, // 67: beehive.user.UserService.CreateContactRequest:output_type -> beehive.user.CreateContactRequestResponse
+	35, // 68: beehive.user.UserService.ListContactRequests:output_type -> beehive.user.ListContactRequestsResponse
+	37, // 69: beehive.user.UserService.AcceptContactRequest:output_type -> beehive.user.AcceptContactRequestResponse
+	39, // 70: beehive.user.UserService.DeclineContactRequest:output_type -> beehive.user.DeclineContactRequestResponse
+	41, // 71: beehive.user.UserService.WithdrawContactRequest:output_type -> beehive.user.WithdrawContactRequestResponse
+	43, // 72: beehive.user.UserService.BlockUser:output_type -> beehive.user.BlockUserResponse
+	45, // 73: beehive.user.UserService.UnblockUser:output_type -> beehive.user.UnblockUserResponse
+	48, // 74: beehive.user.UserService.ListBlocked:output_type -> beehive.user.ListBlockedResponse
+	50, // 75: beehive.user.UserService.CheckBlock:output_type -> beehive.user.CheckBlockResponse
+	53, // 76: beehive.user.UserService.GetPrivacySettings:output_type -> beehive.user.GetPrivacySettingsResponse
+	55, // 77: beehive.user.UserService.UpdatePrivacySettings:output_type -> beehive.user.UpdatePrivacySettingsResponse
+	57, // 78: beehive.user.UserService.CheckPrivacy:output_type -> beehive.user.CheckPrivacyResponse
+	60, // 79: beehive.user.UserService.RequestAccountDeletion:output_type -> beehive.user.RequestAccountDeletionResponse
+	62, // 80: beehive.user.UserService.CancelAccountDeletion:output_type -> beehive.user.CancelAccountDeletionResponse
+	64, // 81: beehive.user.UserService.GetAccountDeletion:output_type -> beehive.user.GetAccountDeletionResponse
+	67, // 82: beehive.user.UserService.RequestDataExport:output_type -> beehive.user.RequestDataExportResponse
+	69, // 83: beehive.user.UserService.GetDataExport:output_type -> beehive.user.GetDataExportResponse
+	71, // 84: beehive.user.UserService.DownloadDataExport:output_type -> beehive.user.DataExportChunk
+	53, // [53:85] is the sub-list for method output_type
+	21, // [21:53] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_proto_init() }
@@ -1305,13 +4520,16 @@ func file_proto_user_proto_init() {
 	if File_proto_user_proto != nil {
 		return
 	}
+	file_proto_user_proto_msgTypes[5].OneofWrappers = []any{}
+	file_proto_user_proto_msgTypes[22].OneofWrappers = []any{}
+	file_proto_user_proto_msgTypes[54].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_proto_rawDesc), len(file_proto_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,17 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_GetUser_FullMethodName               = "/beehive.user.UserService/GetUser"
-	UserService_GetUserByUsername_FullMethodName     = "/beehive.user.UserService/GetUserByUsername"
-	UserService_BatchGetUsers_FullMethodName         = "/beehive.user.UserService/BatchGetUsers"
-	UserService_UpdateUser_FullMethodName            = "/beehive.user.UserService/UpdateUser"
-	UserService_AddContact_FullMethodName            = "/beehive.user.UserService/AddContact"
-	UserService_ListContacts_FullMethodName          = "/beehive.user.UserService/ListContacts"
-	UserService_RemoveContact_FullMethodName         = "/beehive.user.UserService/RemoveContact"
-	UserService_CreateContactRequest_FullMethodName  = "/beehive.user.UserService/CreateContactRequest"
-	UserService_ListContactRequests_FullMethodName   = "/beehive.user.UserService/ListContactRequests"
-	UserService_AcceptContactRequest_FullMethodName  = "/beehive.user.UserService/AcceptContactRequest"
-	UserService_DeclineContactRequest_FullMethodName = "/beehive.user.UserService/DeclineContactRequest"
+	UserService_GetUser_FullMethodName                = "/beehive.user.UserService/GetUser"
+	UserService_GetUserByUsername_FullMethodName      = "/beehive.user.UserService/GetUserByUsername"
+	UserService_BatchGetUsers_FullMethodName          = "/beehive.user.UserService/BatchGetUsers"
+	UserService_UpdateUser_FullMethodName             = "/beehive.user.UserService/UpdateUser"
+	UserService_StreamProfileAudience_FullMethodName  = "/beehive.user.UserService/StreamProfileAudience"
+	UserService_SetLastSeen_FullMethodName            = "/beehive.user.UserService/SetLastSeen"
+	UserService_SetCustomStatus_FullMethodName        = "/beehive.user.UserService/SetCustomStatus"
+	UserService_SearchUsers_FullMethodName            = "/beehive.user.UserService/SearchUsers"
+	UserService_AddContact_FullMethodName             = "/beehive.user.UserService/AddContact"
+	UserService_ListContacts_FullMethodName           = "/beehive.user.UserService/ListContacts"
+	UserService_RemoveContact_FullMethodName          = "/beehive.user.UserService/RemoveContact"
+	UserService_UpdateContact_FullMethodName          = "/beehive.user.UserService/UpdateContact"
+	UserService_SetContactTags_FullMethodName         = "/beehive.user.UserService/SetContactTags"
+	UserService_SuggestContacts_FullMethodName        = "/beehive.user.UserService/SuggestContacts"
+	UserService_CreateContactRequest_FullMethodName   = "/beehive.user.UserService/CreateContactRequest"
+	UserService_ListContactRequests_FullMethodName    = "/beehive.user.UserService/ListContactRequests"
+	UserService_AcceptContactRequest_FullMethodName   = "/beehive.user.UserService/AcceptContactRequest"
+	UserService_DeclineContactRequest_FullMethodName  = "/beehive.user.UserService/DeclineContactRequest"
+	UserService_WithdrawContactRequest_FullMethodName = "/beehive.user.UserService/WithdrawContactRequest"
+	UserService_BlockUser_FullMethodName              = "/beehive.user.UserService/BlockUser"
+	UserService_UnblockUser_FullMethodName            = "/beehive.user.UserService/UnblockUser"
+	UserService_ListBlocked_FullMethodName            = "/beehive.user.UserService/ListBlocked"
+	UserService_CheckBlock_FullMethodName             = "/beehive.user.UserService/CheckBlock"
+	UserService_GetPrivacySettings_FullMethodName     = "/beehive.user.UserService/GetPrivacySettings"
+	UserService_UpdatePrivacySettings_FullMethodName  = "/beehive.user.UserService/UpdatePrivacySettings"
+	UserService_CheckPrivacy_FullMethodName           = "/beehive.user.UserService/CheckPrivacy"
+	UserService_RequestAccountDeletion_FullMethodName = "/beehive.user.UserService/RequestAccountDeletion"
+	UserService_CancelAccountDeletion_FullMethodName  = "/beehive.user.UserService/CancelAccountDeletion"
+	UserService_GetAccountDeletion_FullMethodName     = "/beehive.user.UserService/GetAccountDeletion"
+	UserService_RequestDataExport_FullMethodName      = "/beehive.user.UserService/RequestDataExport"
+	UserService_GetDataExport_FullMethodName          = "/beehive.user.UserService/GetDataExport"
+	UserService_DownloadDataExport_FullMethodName     = "/beehive.user.UserService/DownloadDataExport"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -39,16 +60,50 @@ type UserServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	GetUserByUsername(ctx context.Context, in *GetUserByUsernameRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	BatchGetUsers(ctx context.Context, in *BatchGetUsersRequest, opts ...grpc.CallOption) (*BatchGetUsersResponse, error)
+	// UpdateUser 修改昵称、头像、签名时资料版本号加一，并在 im.events 上发布 user.updated
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	// StreamProfileAudience 分批流式返回应收到 user.updated 的用户（本人、通讯录中有该用户的人、共同会话的 active 成员），供 Gateway 推送 fan-out 使用
+	StreamProfileAudience(ctx context.Context, in *StreamProfileAudienceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProfileAudienceChunk], error)
+	// 最后在线时间与自定义状态，随 User 返回，按隐私设置 presence_visibility 对查询者隐藏
+	SetLastSeen(ctx context.Context, in *SetLastSeenRequest, opts ...grpc.CallOption) (*SetLastSeenResponse, error)
+	SetCustomStatus(ctx context.Context, in *SetCustomStatusRequest, opts ...grpc.CallOption) (*SetCustomStatusResponse, error)
+	// SearchUsers 按用户名、昵称前缀/模糊搜索用户，排除封禁、关闭 discoverable 及拉黑了查询者的用户
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	// 联系人
 	AddContact(ctx context.Context, in *AddContactRequest, opts ...grpc.CallOption) (*AddContactResponse, error)
 	ListContacts(ctx context.Context, in *ListContactsRequest, opts ...grpc.CallOption) (*ListContactsResponse, error)
 	RemoveContact(ctx context.Context, in *RemoveContactRequest, opts ...grpc.CallOption) (*RemoveContactResponse, error)
+	// 修改联系人备注、星标、备忘与标签，仅 owner 可见
+	UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error)
+	SetContactTags(ctx context.Context, in *SetContactTagsRequest, opts ...grpc.CallOption) (*SetContactTagsResponse, error)
+	// SuggestContacts 好友推荐：按共同好友数、共同群数排序的非好友用户，结果由后台定期预计算
+	SuggestContacts(ctx context.Context, in *SuggestContactsRequest, opts ...grpc.CallOption) (*SuggestContactsResponse, error)
 	// 好友申请
 	CreateContactRequest(ctx context.Context, in *CreateContactRequestRequest, opts ...grpc.CallOption) (*CreateContactRequestResponse, error)
 	ListContactRequests(ctx context.Context, in *ListContactRequestsRequest, opts ...grpc.CallOption) (*ListContactRequestsResponse, error)
 	AcceptContactRequest(ctx context.Context, in *AcceptContactRequestRequest, opts ...grpc.CallOption) (*AcceptContactRequestResponse, error)
 	DeclineContactRequest(ctx context.Context, in *DeclineContactRequestRequest, opts ...grpc.CallOption) (*DeclineContactRequestResponse, error)
+	// 发起方撤回未过期的待处理申请；撤回后冷却期内不能再向同一用户申请
+	WithdrawContactRequest(ctx context.Context, in *WithdrawContactRequestRequest, opts ...grpc.CallOption) (*WithdrawContactRequestResponse, error)
+	// 黑名单：被拉黑方不能向拉黑方发送单聊消息、好友申请、发起新单聊，也看不到其在线状态
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error)
+	// CheckBlock 查询两个用户之间的拉黑关系，走 Redis 缓存，供 Message/Conversation/Presence 服务在热路径调用
+	CheckBlock(ctx context.Context, in *CheckBlockRequest, opts ...grpc.CallOption) (*CheckBlockResponse, error)
+	// 隐私设置：谁能查到我、向我发好友申请、直接拉我进群、看到我的在线状态
+	GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*GetPrivacySettingsResponse, error)
+	UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingsResponse, error)
+	// CheckPrivacy 批量判断 viewer 能否对 user_ids 执行 action，供 Conversation/Presence 服务调用；任一方拉黑对方时同样拒绝
+	CheckPrivacy(ctx context.Context, in *CheckPrivacyRequest, opts ...grpc.CallOption) (*CheckPrivacyResponse, error)
+	// 账号注销：申请后进入宽限期，到期由后台清除资料、联系人与 token，发送过的消息改挂到占位用户
+	RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionRequest, opts ...grpc.CallOption) (*RequestAccountDeletionResponse, error)
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...grpc.CallOption) (*CancelAccountDeletionResponse, error)
+	GetAccountDeletion(ctx context.Context, in *GetAccountDeletionRequest, opts ...grpc.CallOption) (*GetAccountDeletionResponse, error)
+	// 个人数据导出：异步生成 zip 归档（资料、联系人、会话、本人发送的消息），就绪后通过 DownloadDataExport 分块下载
+	RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
+	GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error)
+	DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DataExportChunk], error)
 }
 
 type userServiceClient struct {
@@ -99,6 +154,55 @@ func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) StreamProfileAudience(ctx context.Context, in *StreamProfileAudienceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProfileAudienceChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_StreamProfileAudience_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamProfileAudienceRequest, ProfileAudienceChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_StreamProfileAudienceClient = grpc.ServerStreamingClient[ProfileAudienceChunk]
+
+func (c *userServiceClient) SetLastSeen(ctx context.Context, in *SetLastSeenRequest, opts ...grpc.CallOption) (*SetLastSeenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLastSeenResponse)
+	err := c.cc.Invoke(ctx, UserService_SetLastSeen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetCustomStatus(ctx context.Context, in *SetCustomStatusRequest, opts ...grpc.CallOption) (*SetCustomStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCustomStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_SetCustomStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) AddContact(ctx context.Context, in *AddContactRequest, opts ...grpc.CallOption) (*AddContactResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddContactResponse)
@@ -129,6 +233,36 @@ func (c *userServiceClient) RemoveContact(ctx context.Context, in *RemoveContact
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateContactResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetContactTags(ctx context.Context, in *SetContactTagsRequest, opts ...grpc.CallOption) (*SetContactTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetContactTagsResponse)
+	err := c.cc.Invoke(ctx, UserService_SetContactTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SuggestContacts(ctx context.Context, in *SuggestContactsRequest, opts ...grpc.CallOption) (*SuggestContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestContactsResponse)
+	err := c.cc.Invoke(ctx, UserService_SuggestContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreateContactRequest(ctx context.Context, in *CreateContactRequestRequest, opts ...grpc.CallOption) (*CreateContactRequestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateContactRequestResponse)
@@ -169,6 +303,155 @@ func (c *userServiceClient) DeclineContactRequest(ctx context.Context, in *Decli
 	return out, nil
 }
 
+func (c *userServiceClient) WithdrawContactRequest(ctx context.Context, in *WithdrawContactRequestRequest, opts ...grpc.CallOption) (*WithdrawContactRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawContactRequestResponse)
+	err := c.cc.Invoke(ctx, UserService_WithdrawContactRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, UserService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, UserService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedResponse)
+	err := c.cc.Invoke(ctx, UserService_ListBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CheckBlock(ctx context.Context, in *CheckBlockRequest, opts ...grpc.CallOption) (*CheckBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckBlockResponse)
+	err := c.cc.Invoke(ctx, UserService_CheckBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*GetPrivacySettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrivacySettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePrivacySettingsResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdatePrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CheckPrivacy(ctx context.Context, in *CheckPrivacyRequest, opts ...grpc.CallOption) (*CheckPrivacyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPrivacyResponse)
+	err := c.cc.Invoke(ctx, UserService_CheckPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionRequest, opts ...grpc.CallOption) (*RequestAccountDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestAccountDeletionResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...grpc.CallOption) (*CancelAccountDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAccountDeletionResponse)
+	err := c.cc.Invoke(ctx, UserService_CancelAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionRequest, opts ...grpc.CallOption) (*GetAccountDeletionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountDeletionResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDataExportResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataExportResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDataExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DataExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[1], UserService_DownloadDataExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadDataExportRequest, DataExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_DownloadDataExportClient = grpc.ServerStreamingClient[DataExportChunk]
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -176,16 +459,50 @@ type UserServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	GetUserByUsername(context.Context, *GetUserByUsernameRequest) (*GetUserResponse, error)
 	BatchGetUsers(context.Context, *BatchGetUsersRequest) (*BatchGetUsersResponse, error)
+	// UpdateUser 修改昵称、头像、签名时资料版本号加一，并在 im.events 上发布 user.updated
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// StreamProfileAudience 分批流式返回应收到 user.updated 的用户（本人、通讯录中有该用户的人、共同会话的 active 成员），供 Gateway 推送 fan-out 使用
+	StreamProfileAudience(*StreamProfileAudienceRequest, grpc.ServerStreamingServer[ProfileAudienceChunk]) error
+	// 最后在线时间与自定义状态，随 User 返回，按隐私设置 presence_visibility 对查询者隐藏
+	SetLastSeen(context.Context, *SetLastSeenRequest) (*SetLastSeenResponse, error)
+	SetCustomStatus(context.Context, *SetCustomStatusRequest) (*SetCustomStatusResponse, error)
+	// SearchUsers 按用户名、昵称前缀/模糊搜索用户，排除封禁、关闭 discoverable 及拉黑了查询者的用户
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	// 联系人
 	AddContact(context.Context, *AddContactRequest) (*AddContactResponse, error)
 	ListContacts(context.Context, *ListContactsRequest) (*ListContactsResponse, error)
 	RemoveContact(context.Context, *RemoveContactRequest) (*RemoveContactResponse, error)
+	// 修改联系人备注、星标、备忘与标签，仅 owner 可见
+	UpdateContact(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error)
+	SetContactTags(context.Context, *SetContactTagsRequest) (*SetContactTagsResponse, error)
+	// SuggestContacts 好友推荐：按共同好友数、共同群数排序的非好友用户，结果由后台定期预计算
+	SuggestContacts(context.Context, *SuggestContactsRequest) (*SuggestContactsResponse, error)
 	// 好友申请
 	CreateContactRequest(context.Context, *CreateContactRequestRequest) (*CreateContactRequestResponse, error)
 	ListContactRequests(context.Context, *ListContactRequestsRequest) (*ListContactRequestsResponse, error)
 	AcceptContactRequest(context.Context, *AcceptContactRequestRequest) (*AcceptContactRequestResponse, error)
 	DeclineContactRequest(context.Context, *DeclineContactRequestRequest) (*DeclineContactRequestResponse, error)
+	// 发起方撤回未过期的待处理申请；撤回后冷却期内不能再向同一用户申请
+	WithdrawContactRequest(context.Context, *WithdrawContactRequestRequest) (*WithdrawContactRequestResponse, error)
+	// 黑名单：被拉黑方不能向拉黑方发送单聊消息、好友申请、发起新单聊，也看不到其在线状态
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	ListBlocked(context.Context, *ListBlockedRequest) (*ListBlockedResponse, error)
+	// CheckBlock 查询两个用户之间的拉黑关系，走 Redis 缓存，供 Message/Conversation/Presence 服务在热路径调用
+	CheckBlock(context.Context, *CheckBlockRequest) (*CheckBlockResponse, error)
+	// 隐私设置：谁能查到我、向我发好友申请、直接拉我进群、看到我的在线状态
+	GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*GetPrivacySettingsResponse, error)
+	UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*UpdatePrivacySettingsResponse, error)
+	// CheckPrivacy 批量判断 viewer 能否对 user_ids 执行 action，供 Conversation/Presence 服务调用；任一方拉黑对方时同样拒绝
+	CheckPrivacy(context.Context, *CheckPrivacyRequest) (*CheckPrivacyResponse, error)
+	// 账号注销：申请后进入宽限期，到期由后台清除资料、联系人与 token，发送过的消息改挂到占位用户
+	RequestAccountDeletion(context.Context, *RequestAccountDeletionRequest) (*RequestAccountDeletionResponse, error)
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionRequest) (*CancelAccountDeletionResponse, error)
+	GetAccountDeletion(context.Context, *GetAccountDeletionRequest) (*GetAccountDeletionResponse, error)
+	// 个人数据导出：异步生成 zip 归档（资料、联系人、会话、本人发送的消息），就绪后通过 DownloadDataExport 分块下载
+	RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error)
+	GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error)
+	DownloadDataExport(*DownloadDataExportRequest, grpc.ServerStreamingServer[DataExportChunk]) error
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -208,6 +525,18 @@ func (UnimplementedUserServiceServer) BatchGetUsers(context.Context, *BatchGetUs
 func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateUser not implemented")
 }
+func (UnimplementedUserServiceServer) StreamProfileAudience(*StreamProfileAudienceRequest, grpc.ServerStreamingServer[ProfileAudienceChunk]) error {
+	return status.Error(codes.Unimplemented, "method StreamProfileAudience not implemented")
+}
+func (UnimplementedUserServiceServer) SetLastSeen(context.Context, *SetLastSeenRequest) (*SetLastSeenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetLastSeen not implemented")
+}
+func (UnimplementedUserServiceServer) SetCustomStatus(context.Context, *SetCustomStatusRequest) (*SetCustomStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCustomStatus not implemented")
+}
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedUserServiceServer) AddContact(context.Context, *AddContactRequest) (*AddContactResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddContact not implemented")
 }
@@ -217,6 +546,15 @@ func (UnimplementedUserServiceServer) ListContacts(context.Context, *ListContact
 func (UnimplementedUserServiceServer) RemoveContact(context.Context, *RemoveContactRequest) (*RemoveContactResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RemoveContact not implemented")
 }
+func (UnimplementedUserServiceServer) UpdateContact(context.Context, *UpdateContactRequest) (*UpdateContactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateContact not implemented")
+}
+func (UnimplementedUserServiceServer) SetContactTags(context.Context, *SetContactTagsRequest) (*SetContactTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetContactTags not implemented")
+}
+func (UnimplementedUserServiceServer) SuggestContacts(context.Context, *SuggestContactsRequest) (*SuggestContactsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SuggestContacts not implemented")
+}
 func (UnimplementedUserServiceServer) CreateContactRequest(context.Context, *CreateContactRequestRequest) (*CreateContactRequestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateContactRequest not implemented")
 }
@@ -229,6 +567,48 @@ func (UnimplementedUserServiceServer) AcceptContactRequest(context.Context, *Acc
 func (UnimplementedUserServiceServer) DeclineContactRequest(context.Context, *DeclineContactRequestRequest) (*DeclineContactRequestResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeclineContactRequest not implemented")
 }
+func (UnimplementedUserServiceServer) WithdrawContactRequest(context.Context, *WithdrawContactRequestRequest) (*WithdrawContactRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WithdrawContactRequest not implemented")
+}
+func (UnimplementedUserServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListBlocked(context.Context, *ListBlockedRequest) (*ListBlockedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBlocked not implemented")
+}
+func (UnimplementedUserServiceServer) CheckBlock(context.Context, *CheckBlockRequest) (*CheckBlockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckBlock not implemented")
+}
+func (UnimplementedUserServiceServer) GetPrivacySettings(context.Context, *GetPrivacySettingsRequest) (*GetPrivacySettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPrivacySettings not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsRequest) (*UpdatePrivacySettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePrivacySettings not implemented")
+}
+func (UnimplementedUserServiceServer) CheckPrivacy(context.Context, *CheckPrivacyRequest) (*CheckPrivacyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckPrivacy not implemented")
+}
+func (UnimplementedUserServiceServer) RequestAccountDeletion(context.Context, *RequestAccountDeletionRequest) (*RequestAccountDeletionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestAccountDeletion not implemented")
+}
+func (UnimplementedUserServiceServer) CancelAccountDeletion(context.Context, *CancelAccountDeletionRequest) (*CancelAccountDeletionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedUserServiceServer) GetAccountDeletion(context.Context, *GetAccountDeletionRequest) (*GetAccountDeletionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAccountDeletion not implemented")
+}
+func (UnimplementedUserServiceServer) RequestDataExport(context.Context, *RequestDataExportRequest) (*RequestDataExportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (UnimplementedUserServiceServer) GetDataExport(context.Context, *GetDataExportRequest) (*GetDataExportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (UnimplementedUserServiceServer) DownloadDataExport(*DownloadDataExportRequest, grpc.ServerStreamingServer[DataExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method DownloadDataExport not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -322,6 +702,71 @@ func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_StreamProfileAudience_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProfileAudienceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).StreamProfileAudience(m, &grpc.GenericServerStream[StreamProfileAudienceRequest, ProfileAudienceChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_StreamProfileAudienceServer = grpc.ServerStreamingServer[ProfileAudienceChunk]
+
+func _UserService_SetLastSeen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLastSeenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetLastSeen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetLastSeen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetLastSeen(ctx, req.(*SetLastSeenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetCustomStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCustomStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetCustomStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetCustomStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetCustomStatus(ctx, req.(*SetCustomStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_AddContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddContactRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +821,60 @@ func _UserService_RemoveContact_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateContact(ctx, req.(*UpdateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetContactTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContactTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetContactTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetContactTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetContactTags(ctx, req.(*SetContactTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuggestContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuggestContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuggestContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuggestContacts(ctx, req.(*SuggestContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreateContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateContactRequestRequest)
 	if err := dec(in); err != nil {
@@ -448,6 +947,251 @@ func _UserService_DeclineContactRequest_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_WithdrawContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawContactRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).WithdrawContactRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_WithdrawContactRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).WithdrawContactRequest(ctx, req.(*WithdrawContactRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListBlocked(ctx, req.(*ListBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CheckBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CheckBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckBlock(ctx, req.(*CheckBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPrivacySettings(ctx, req.(*GetPrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrivacySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePrivacySettings(ctx, req.(*UpdatePrivacySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CheckPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CheckPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckPrivacy(ctx, req.(*CheckPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestAccountDeletion(ctx, req.(*RequestAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CancelAccountDeletion(ctx, req.(*CancelAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAccountDeletion(ctx, req.(*GetAccountDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestDataExport(ctx, req.(*RequestDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDataExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDataExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDataExport(ctx, req.(*GetDataExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DownloadDataExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDataExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).DownloadDataExport(m, &grpc.GenericServerStream[DownloadDataExportRequest, DataExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_DownloadDataExportServer = grpc.ServerStreamingServer[DataExportChunk]
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -471,6 +1215,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _UserService_UpdateUser_Handler,
 		},
+		{
+			MethodName: "SetLastSeen",
+			Handler:    _UserService_SetLastSeen_Handler,
+		},
+		{
+			MethodName: "SetCustomStatus",
+			Handler:    _UserService_SetCustomStatus_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
 		{
 			MethodName: "AddContact",
 			Handler:    _UserService_AddContact_Handler,
@@ -483,6 +1239,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveContact",
 			Handler:    _UserService_RemoveContact_Handler,
 		},
+		{
+			MethodName: "UpdateContact",
+			Handler:    _UserService_UpdateContact_Handler,
+		},
+		{
+			MethodName: "SetContactTags",
+			Handler:    _UserService_SetContactTags_Handler,
+		},
+		{
+			MethodName: "SuggestContacts",
+			Handler:    _UserService_SuggestContacts_Handler,
+		},
 		{
 			MethodName: "CreateContactRequest",
 			Handler:    _UserService_CreateContactRequest_Handler,
@@ -499,7 +1267,70 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeclineContactRequest",
 			Handler:    _UserService_DeclineContactRequest_Handler,
 		},
+		{
+			MethodName: "WithdrawContactRequest",
+			Handler:    _UserService_WithdrawContactRequest_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _UserService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _UserService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlocked",
+			Handler:    _UserService_ListBlocked_Handler,
+		},
+		{
+			MethodName: "CheckBlock",
+			Handler:    _UserService_CheckBlock_Handler,
+		},
+		{
+			MethodName: "GetPrivacySettings",
+			Handler:    _UserService_GetPrivacySettings_Handler,
+		},
+		{
+			MethodName: "UpdatePrivacySettings",
+			Handler:    _UserService_UpdatePrivacySettings_Handler,
+		},
+		{
+			MethodName: "CheckPrivacy",
+			Handler:    _UserService_CheckPrivacy_Handler,
+		},
+		{
+			MethodName: "RequestAccountDeletion",
+			Handler:    _UserService_RequestAccountDeletion_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _UserService_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "GetAccountDeletion",
+			Handler:    _UserService_GetAccountDeletion_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _UserService_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _UserService_GetDataExport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamProfileAudience",
+			Handler:       _UserService_StreamProfileAudience_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadDataExport",
+			Handler:       _UserService_DownloadDataExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/user.proto",
 }
@@ -14,29 +14,78 @@ import (
 )
 
 type (
-	AddContactRequest    = pb.AddContactRequest
-	AddContactResponse   = pb.AddContactResponse
-	BatchGetUsersRequest = pb.BatchGetUsersRequest
-	BatchGetUsersResponse = pb.BatchGetUsersResponse
-	GetUserRequest       = pb.GetUserRequest
-	GetUserResponse      = pb.GetUserResponse
-	GetUserByUsernameRequest  = pb.GetUserByUsernameRequest
-	ListContactsRequest  = pb.ListContactsRequest
-	ListContactsResponse = pb.ListContactsResponse
-	RemoveContactRequest  = pb.RemoveContactRequest
-	RemoveContactResponse = pb.RemoveContactResponse
-	UpdateUserRequest     = pb.UpdateUserRequest
-	UpdateUserResponse    = pb.UpdateUserResponse
-	User                  = pb.User
-
-	CreateContactRequestRequest  = pb.CreateContactRequestRequest
-	CreateContactRequestResponse = pb.CreateContactRequestResponse
-	ListContactRequestsRequest   = pb.ListContactRequestsRequest
-	ListContactRequestsResponse = pb.ListContactRequestsResponse
-	AcceptContactRequestRequest  = pb.AcceptContactRequestRequest
-	AcceptContactRequestResponse = pb.AcceptContactRequestResponse
-	DeclineContactRequestRequest = pb.DeclineContactRequestRequest
-	DeclineContactRequestResponse = pb.DeclineContactRequestResponse
+	AddContactRequest        = pb.AddContactRequest
+	AddContactResponse       = pb.AddContactResponse
+	BatchGetUsersRequest     = pb.BatchGetUsersRequest
+	BatchGetUsersResponse    = pb.BatchGetUsersResponse
+	GetUserRequest           = pb.GetUserRequest
+	GetUserResponse          = pb.GetUserResponse
+	GetUserByUsernameRequest = pb.GetUserByUsernameRequest
+	ListContactsRequest      = pb.ListContactsRequest
+	ListContactsResponse     = pb.ListContactsResponse
+	RemoveContactRequest     = pb.RemoveContactRequest
+	RemoveContactResponse    = pb.RemoveContactResponse
+	UpdateUserRequest        = pb.UpdateUserRequest
+	UpdateUserResponse       = pb.UpdateUserResponse
+	User                     = pb.User
+
+	CreateContactRequestRequest    = pb.CreateContactRequestRequest
+	CreateContactRequestResponse   = pb.CreateContactRequestResponse
+	ListContactRequestsRequest     = pb.ListContactRequestsRequest
+	ListContactRequestsResponse    = pb.ListContactRequestsResponse
+	AcceptContactRequestRequest    = pb.AcceptContactRequestRequest
+	AcceptContactRequestResponse   = pb.AcceptContactRequestResponse
+	DeclineContactRequestRequest   = pb.DeclineContactRequestRequest
+	DeclineContactRequestResponse  = pb.DeclineContactRequestResponse
+	BlockUserRequest               = pb.BlockUserRequest
+	BlockUserResponse              = pb.BlockUserResponse
+	UnblockUserRequest             = pb.UnblockUserRequest
+	UnblockUserResponse            = pb.UnblockUserResponse
+	ListBlockedRequest             = pb.ListBlockedRequest
+	ListBlockedResponse            = pb.ListBlockedResponse
+	BlockedUser                    = pb.BlockedUser
+	CheckBlockRequest              = pb.CheckBlockRequest
+	CheckBlockResponse             = pb.CheckBlockResponse
+	SearchUsersRequest             = pb.SearchUsersRequest
+	SearchUsersResponse            = pb.SearchUsersResponse
+	GetPrivacySettingsRequest      = pb.GetPrivacySettingsRequest
+	GetPrivacySettingsResponse     = pb.GetPrivacySettingsResponse
+	UpdatePrivacySettingsRequest   = pb.UpdatePrivacySettingsRequest
+	UpdatePrivacySettingsResponse  = pb.UpdatePrivacySettingsResponse
+	CheckPrivacyRequest            = pb.CheckPrivacyRequest
+	CheckPrivacyResponse           = pb.CheckPrivacyResponse
+	PrivacySettings                = pb.PrivacySettings
+	ContactInfo                    = pb.ContactInfo
+	UpdateContactRequest           = pb.UpdateContactRequest
+	UpdateContactResponse          = pb.UpdateContactResponse
+	SetContactTagsRequest          = pb.SetContactTagsRequest
+	SetContactTagsResponse         = pb.SetContactTagsResponse
+	WithdrawContactRequestRequest  = pb.WithdrawContactRequestRequest
+	WithdrawContactRequestResponse = pb.WithdrawContactRequestResponse
+	SuggestContactsRequest         = pb.SuggestContactsRequest
+	SuggestContactsResponse        = pb.SuggestContactsResponse
+	ContactSuggestion              = pb.ContactSuggestion
+	SetLastSeenRequest             = pb.SetLastSeenRequest
+	SetLastSeenResponse            = pb.SetLastSeenResponse
+	SetCustomStatusRequest         = pb.SetCustomStatusRequest
+	SetCustomStatusResponse        = pb.SetCustomStatusResponse
+	CustomStatus                   = pb.CustomStatus
+	RequestAccountDeletionRequest  = pb.RequestAccountDeletionRequest
+	RequestAccountDeletionResponse = pb.RequestAccountDeletionResponse
+	AccountDeletion                = pb.AccountDeletion
+	CancelAccountDeletionRequest   = pb.CancelAccountDeletionRequest
+	CancelAccountDeletionResponse  = pb.CancelAccountDeletionResponse
+	GetAccountDeletionRequest      = pb.GetAccountDeletionRequest
+	GetAccountDeletionResponse     = pb.GetAccountDeletionResponse
+	RequestDataExportRequest       = pb.RequestDataExportRequest
+	RequestDataExportResponse      = pb.RequestDataExportResponse
+	DataExport                     = pb.DataExport
+	GetDataExportRequest           = pb.GetDataExportRequest
+	GetDataExportResponse          = pb.GetDataExportResponse
+	DownloadDataExportRequest      = pb.DownloadDataExportRequest
+	DataExportChunk                = pb.DataExportChunk
+	StreamProfileAudienceRequest   = pb.StreamProfileAudienceRequest
+	ProfileAudienceChunk           = pb.ProfileAudienceChunk
 
 	UserService interface {
 		GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
@@ -50,6 +99,27 @@ type (
 		ListContactRequests(ctx context.Context, in *ListContactRequestsRequest, opts ...grpc.CallOption) (*ListContactRequestsResponse, error)
 		AcceptContactRequest(ctx context.Context, in *AcceptContactRequestRequest, opts ...grpc.CallOption) (*AcceptContactRequestResponse, error)
 		DeclineContactRequest(ctx context.Context, in *DeclineContactRequestRequest, opts ...grpc.CallOption) (*DeclineContactRequestResponse, error)
+		BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+		UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+		ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error)
+		CheckBlock(ctx context.Context, in *CheckBlockRequest, opts ...grpc.CallOption) (*CheckBlockResponse, error)
+		SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+		GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*GetPrivacySettingsResponse, error)
+		UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingsResponse, error)
+		CheckPrivacy(ctx context.Context, in *CheckPrivacyRequest, opts ...grpc.CallOption) (*CheckPrivacyResponse, error)
+		UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error)
+		SetContactTags(ctx context.Context, in *SetContactTagsRequest, opts ...grpc.CallOption) (*SetContactTagsResponse, error)
+		WithdrawContactRequest(ctx context.Context, in *WithdrawContactRequestRequest, opts ...grpc.CallOption) (*WithdrawContactRequestResponse, error)
+		SuggestContacts(ctx context.Context, in *SuggestContactsRequest, opts ...grpc.CallOption) (*SuggestContactsResponse, error)
+		SetLastSeen(ctx context.Context, in *SetLastSeenRequest, opts ...grpc.CallOption) (*SetLastSeenResponse, error)
+		SetCustomStatus(ctx context.Context, in *SetCustomStatusRequest, opts ...grpc.CallOption) (*SetCustomStatusResponse, error)
+		RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionRequest, opts ...grpc.CallOption) (*RequestAccountDeletionResponse, error)
+		CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...grpc.CallOption) (*CancelAccountDeletionResponse, error)
+		GetAccountDeletion(ctx context.Context, in *GetAccountDeletionRequest, opts ...grpc.CallOption) (*GetAccountDeletionResponse, error)
+		RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error)
+		GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error)
+		DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (pb.UserService_DownloadDataExportClient, error)
+		StreamProfileAudience(ctx context.Context, in *StreamProfileAudienceRequest, opts ...grpc.CallOption) (pb.UserService_StreamProfileAudienceClient, error)
 	}
 
 	defaultUserService struct {
@@ -117,3 +187,108 @@ func (m *defaultUserService) DeclineContactRequest(ctx context.Context, in *Decl
 	client := pb.NewUserServiceClient(m.cli.Conn())
 	return client.DeclineContactRequest(ctx, in, opts...)
 }
+
+func (m *defaultUserService) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.BlockUser(ctx, in, opts...)
+}
+
+func (m *defaultUserService) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.UnblockUser(ctx, in, opts...)
+}
+
+func (m *defaultUserService) ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.ListBlocked(ctx, in, opts...)
+}
+
+func (m *defaultUserService) CheckBlock(ctx context.Context, in *CheckBlockRequest, opts ...grpc.CallOption) (*CheckBlockResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.CheckBlock(ctx, in, opts...)
+}
+
+func (m *defaultUserService) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.SearchUsers(ctx, in, opts...)
+}
+
+func (m *defaultUserService) GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsRequest, opts ...grpc.CallOption) (*GetPrivacySettingsResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetPrivacySettings(ctx, in, opts...)
+}
+
+func (m *defaultUserService) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsRequest, opts ...grpc.CallOption) (*UpdatePrivacySettingsResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.UpdatePrivacySettings(ctx, in, opts...)
+}
+
+func (m *defaultUserService) CheckPrivacy(ctx context.Context, in *CheckPrivacyRequest, opts ...grpc.CallOption) (*CheckPrivacyResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.CheckPrivacy(ctx, in, opts...)
+}
+
+func (m *defaultUserService) UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*UpdateContactResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.UpdateContact(ctx, in, opts...)
+}
+
+func (m *defaultUserService) SetContactTags(ctx context.Context, in *SetContactTagsRequest, opts ...grpc.CallOption) (*SetContactTagsResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.SetContactTags(ctx, in, opts...)
+}
+
+func (m *defaultUserService) WithdrawContactRequest(ctx context.Context, in *WithdrawContactRequestRequest, opts ...grpc.CallOption) (*WithdrawContactRequestResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.WithdrawContactRequest(ctx, in, opts...)
+}
+
+func (m *defaultUserService) SuggestContacts(ctx context.Context, in *SuggestContactsRequest, opts ...grpc.CallOption) (*SuggestContactsResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.SuggestContacts(ctx, in, opts...)
+}
+
+func (m *defaultUserService) SetLastSeen(ctx context.Context, in *SetLastSeenRequest, opts ...grpc.CallOption) (*SetLastSeenResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.SetLastSeen(ctx, in, opts...)
+}
+
+func (m *defaultUserService) SetCustomStatus(ctx context.Context, in *SetCustomStatusRequest, opts ...grpc.CallOption) (*SetCustomStatusResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.SetCustomStatus(ctx, in, opts...)
+}
+
+func (m *defaultUserService) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionRequest, opts ...grpc.CallOption) (*RequestAccountDeletionResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.RequestAccountDeletion(ctx, in, opts...)
+}
+
+func (m *defaultUserService) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionRequest, opts ...grpc.CallOption) (*CancelAccountDeletionResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.CancelAccountDeletion(ctx, in, opts...)
+}
+
+func (m *defaultUserService) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionRequest, opts ...grpc.CallOption) (*GetAccountDeletionResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetAccountDeletion(ctx, in, opts...)
+}
+
+func (m *defaultUserService) RequestDataExport(ctx context.Context, in *RequestDataExportRequest, opts ...grpc.CallOption) (*RequestDataExportResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.RequestDataExport(ctx, in, opts...)
+}
+
+func (m *defaultUserService) GetDataExport(ctx context.Context, in *GetDataExportRequest, opts ...grpc.CallOption) (*GetDataExportResponse, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.GetDataExport(ctx, in, opts...)
+}
+
+func (m *defaultUserService) DownloadDataExport(ctx context.Context, in *DownloadDataExportRequest, opts ...grpc.CallOption) (pb.UserService_DownloadDataExportClient, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.DownloadDataExport(ctx, in, opts...)
+}
+
+func (m *defaultUserService) StreamProfileAudience(ctx context.Context, in *StreamProfileAudienceRequest, opts ...grpc.CallOption) (pb.UserService_StreamProfileAudienceClient, error) {
+	client := pb.NewUserServiceClient(m.cli.Conn())
+	return client.StreamProfileAudience(ctx, in, opts...)
+}